@@ -0,0 +1,7 @@
+ALTER TABLE driver_incentives DROP COLUMN deleted_at;
+ALTER TABLE bookings DROP COLUMN deleted_at;
+ALTER TABLE booking_types DROP COLUMN deleted_at;
+ALTER TABLE drivers DROP COLUMN deleted_at;
+ALTER TABLE cars DROP COLUMN deleted_at;
+ALTER TABLE customers DROP COLUMN deleted_at;
+ALTER TABLE memberships DROP COLUMN deleted_at;
@@ -0,0 +1,15 @@
+ALTER TABLE memberships ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE customers ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE cars ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE drivers ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE booking_types ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE driver_incentives ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_memberships_deleted_at ON memberships(deleted_at);
+CREATE INDEX idx_customers_deleted_at ON customers(deleted_at);
+CREATE INDEX idx_cars_deleted_at ON cars(deleted_at);
+CREATE INDEX idx_drivers_deleted_at ON drivers(deleted_at);
+CREATE INDEX idx_booking_types_deleted_at ON booking_types(deleted_at);
+CREATE INDEX idx_bookings_deleted_at ON bookings(deleted_at);
+CREATE INDEX idx_driver_incentives_deleted_at ON driver_incentives(deleted_at);
@@ -0,0 +1,8 @@
+ALTER TABLE bookings DROP COLUMN deposit;
+
+ALTER TABLE booking_types DROP COLUMN deposit_percentage;
+ALTER TABLE booking_types DROP COLUMN price_multiplier;
+ALTER TABLE booking_types DROP COLUMN max_days;
+ALTER TABLE booking_types DROP COLUMN min_days;
+ALTER TABLE booking_types DROP COLUMN allows_driver;
+ALTER TABLE booking_types DROP COLUMN requires_driver;
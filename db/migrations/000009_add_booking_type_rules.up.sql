@@ -0,0 +1,11 @@
+ALTER TABLE booking_types ADD COLUMN requires_driver BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE booking_types ADD COLUMN allows_driver BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE booking_types ADD COLUMN min_days INT NOT NULL DEFAULT 0;
+ALTER TABLE booking_types ADD COLUMN max_days INT NOT NULL DEFAULT 0;
+ALTER TABLE booking_types ADD COLUMN price_multiplier NUMERIC(5,2) NOT NULL DEFAULT 1;
+ALTER TABLE booking_types ADD COLUMN deposit_percentage INT NOT NULL DEFAULT 0;
+
+UPDATE booking_types SET requires_driver = FALSE, allows_driver = FALSE WHERE booking_type = 'Car Only';
+UPDATE booking_types SET requires_driver = TRUE, allows_driver = TRUE WHERE booking_type = 'Car & Driver';
+
+ALTER TABLE bookings ADD COLUMN deposit INT NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+ALTER TABLE cars ALTER COLUMN stock DROP DEFAULT;
+
+ALTER TABLE bookings DROP COLUMN return_odometer;
+ALTER TABLE bookings DROP COLUMN pickup_odometer;
+ALTER TABLE bookings DROP COLUMN returned_at;
+ALTER TABLE bookings DROP COLUMN picked_up_at;
+ALTER TABLE bookings DROP COLUMN vehicle_id;
+
+DROP TABLE IF EXISTS vehicles;
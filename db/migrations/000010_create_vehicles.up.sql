@@ -0,0 +1,38 @@
+CREATE TABLE vehicles (
+    id SERIAL PRIMARY KEY,
+    car_id INT NOT NULL REFERENCES cars(id),
+    plate_number VARCHAR(20) NOT NULL,
+    vin VARCHAR(17) NOT NULL,
+    color VARCHAR(50),
+    year INT NOT NULL,
+    odometer INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_vehicles_car_id ON vehicles(car_id);
+CREATE INDEX idx_vehicles_deleted_at ON vehicles(deleted_at);
+CREATE UNIQUE INDEX idx_vehicles_plate_number ON vehicles(plate_number) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_vehicles_vin ON vehicles(vin) WHERE deleted_at IS NULL;
+
+ALTER TABLE bookings ADD COLUMN vehicle_id INT REFERENCES vehicles(id);
+ALTER TABLE bookings ADD COLUMN picked_up_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN returned_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN pickup_odometer INT;
+ALTER TABLE bookings ADD COLUMN return_odometer INT;
+
+-- cars.stock now counts the active vehicles of a car. Every unit of the old
+-- stock becomes a vehicle with a placeholder plate and VIN, to be replaced
+-- with the real ones, so cars stay bookable after the upgrade.
+INSERT INTO vehicles (car_id, plate_number, vin, year)
+SELECT cars.id,
+       'UNREG-' || cars.id || '-' || unit,
+       'UNREG' || LPAD(cars.id::TEXT, 6, '0') || LPAD(unit::TEXT, 6, '0'),
+       EXTRACT(YEAR FROM COALESCE(cars.created_at, NOW()))::INT
+FROM cars, generate_series(1, cars.stock) AS unit
+ORDER BY cars.id, unit;
+
+UPDATE cars SET stock = (SELECT COUNT(*) FROM vehicles WHERE vehicles.car_id = cars.id);
+ALTER TABLE cars ALTER COLUMN stock SET DEFAULT 0;
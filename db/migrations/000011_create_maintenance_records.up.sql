@@ -0,0 +1,18 @@
+CREATE TABLE maintenance_records (
+    id SERIAL PRIMARY KEY,
+    vehicle_id INT NOT NULL REFERENCES vehicles(id),
+    type VARCHAR(20) NOT NULL,
+    planned_start TIMESTAMP NOT NULL,
+    planned_end TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP,
+    odometer INT,
+    cost INT NOT NULL DEFAULT 0,
+    workshop VARCHAR(255),
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_maintenance_records_vehicle_id ON maintenance_records(vehicle_id);
+CREATE INDEX idx_maintenance_records_deleted_at ON maintenance_records(deleted_at);
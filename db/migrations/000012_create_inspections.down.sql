@@ -0,0 +1,5 @@
+ALTER TABLE bookings DROP COLUMN damage_charge;
+
+DROP TABLE IF EXISTS inspection_images;
+DROP TABLE IF EXISTS inspection_damages;
+DROP TABLE IF EXISTS inspections;
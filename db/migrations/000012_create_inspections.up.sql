@@ -0,0 +1,46 @@
+CREATE TABLE inspections (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id),
+    vehicle_id INT NOT NULL REFERENCES vehicles(id),
+    kind VARCHAR(10) NOT NULL,
+    checklist JSONB NOT NULL DEFAULT '{}',
+    fuel_level INT NOT NULL,
+    odometer INT NOT NULL,
+    notes TEXT,
+    inspected_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_inspections_booking_id ON inspections(booking_id);
+CREATE INDEX idx_inspections_deleted_at ON inspections(deleted_at);
+CREATE UNIQUE INDEX idx_inspections_booking_kind ON inspections(booking_id, kind) WHERE deleted_at IS NULL;
+
+CREATE TABLE inspection_damages (
+    id SERIAL PRIMARY KEY,
+    inspection_id INT NOT NULL REFERENCES inspections(id),
+    location VARCHAR(100) NOT NULL,
+    severity VARCHAR(10) NOT NULL,
+    description TEXT,
+    repair_cost INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_inspection_damages_inspection_id ON inspection_damages(inspection_id);
+
+CREATE TABLE inspection_images (
+    id SERIAL PRIMARY KEY,
+    inspection_id INT NOT NULL REFERENCES inspections(id),
+    damage_id INT REFERENCES inspection_damages(id),
+    storage_key VARCHAR(255) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_inspection_images_inspection_id ON inspection_images(inspection_id);
+
+ALTER TABLE bookings ADD COLUMN damage_charge INT NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+ALTER TABLE cars DROP COLUMN luggage;
+ALTER TABLE cars DROP COLUMN year;
+ALTER TABLE cars DROP COLUMN seats;
+ALTER TABLE cars DROP COLUMN fuel_type;
+ALTER TABLE cars DROP COLUMN transmission;
+ALTER TABLE cars DROP COLUMN brand;
+ALTER TABLE cars DROP COLUMN category_id;
+
+DROP TABLE IF EXISTS car_categories;
@@ -0,0 +1,26 @@
+CREATE TABLE car_categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_car_categories_deleted_at ON car_categories(deleted_at);
+
+INSERT INTO car_categories (name, description) VALUES
+('City Car', 'Small and easy to park, for getting around town'),
+('MPV', 'Multi-purpose vehicle seating the whole family'),
+('SUV', 'Higher ground clearance for longer trips and rough roads'),
+('Luxury', 'Premium comfort for business and special occasions');
+
+ALTER TABLE cars ADD COLUMN category_id INT REFERENCES car_categories(id);
+ALTER TABLE cars ADD COLUMN brand VARCHAR(100);
+ALTER TABLE cars ADD COLUMN transmission VARCHAR(20);
+ALTER TABLE cars ADD COLUMN fuel_type VARCHAR(20);
+ALTER TABLE cars ADD COLUMN seats INT;
+ALTER TABLE cars ADD COLUMN year INT;
+ALTER TABLE cars ADD COLUMN luggage INT;
+
+CREATE INDEX idx_cars_category_id ON cars(category_id);
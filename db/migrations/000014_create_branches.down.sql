@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS vehicle_transfers;
+
+ALTER TABLE bookings DROP COLUMN one_way_fee;
+ALTER TABLE bookings DROP COLUMN return_branch_id;
+ALTER TABLE bookings DROP COLUMN pickup_branch_id;
+
+ALTER TABLE drivers DROP COLUMN branch_id;
+ALTER TABLE vehicles DROP COLUMN branch_id;
+
+DROP TABLE IF EXISTS branches;
@@ -0,0 +1,58 @@
+CREATE TABLE branches (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    address TEXT,
+    phone VARCHAR(20),
+    one_way_fee INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_branches_deleted_at ON branches(deleted_at);
+
+INSERT INTO branches (name, city) VALUES
+('Jakarta', 'Jakarta'),
+('Bandung', 'Bandung'),
+('Bali', 'Denpasar');
+
+-- Everything that exists so far was run from Jakarta.
+ALTER TABLE vehicles ADD COLUMN branch_id INT REFERENCES branches(id);
+UPDATE vehicles SET branch_id = (SELECT id FROM branches WHERE name = 'Jakarta');
+ALTER TABLE vehicles ALTER COLUMN branch_id SET NOT NULL;
+CREATE INDEX idx_vehicles_branch_id ON vehicles(branch_id);
+
+ALTER TABLE drivers ADD COLUMN branch_id INT REFERENCES branches(id);
+UPDATE drivers SET branch_id = (SELECT id FROM branches WHERE name = 'Jakarta');
+ALTER TABLE drivers ALTER COLUMN branch_id SET NOT NULL;
+CREATE INDEX idx_drivers_branch_id ON drivers(branch_id);
+
+ALTER TABLE bookings ADD COLUMN pickup_branch_id INT REFERENCES branches(id);
+ALTER TABLE bookings ADD COLUMN return_branch_id INT REFERENCES branches(id);
+ALTER TABLE bookings ADD COLUMN one_way_fee INT NOT NULL DEFAULT 0;
+UPDATE bookings SET
+    pickup_branch_id = (SELECT id FROM branches WHERE name = 'Jakarta'),
+    return_branch_id = (SELECT id FROM branches WHERE name = 'Jakarta');
+ALTER TABLE bookings ALTER COLUMN pickup_branch_id SET NOT NULL;
+ALTER TABLE bookings ALTER COLUMN return_branch_id SET NOT NULL;
+
+CREATE TABLE vehicle_transfers (
+    id SERIAL PRIMARY KEY,
+    vehicle_id INT NOT NULL REFERENCES vehicles(id),
+    from_branch_id INT NOT NULL REFERENCES branches(id),
+    to_branch_id INT NOT NULL REFERENCES branches(id),
+    status VARCHAR(20) NOT NULL,
+    notes TEXT,
+    requested_at TIMESTAMP NOT NULL,
+    shipped_at TIMESTAMP,
+    received_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_vehicle_transfers_vehicle_id ON vehicle_transfers(vehicle_id);
+-- A vehicle is moved by one transfer at a time.
+CREATE UNIQUE INDEX idx_vehicle_transfers_open ON vehicle_transfers(vehicle_id)
+    WHERE status IN ('requested', 'in_transit');
@@ -0,0 +1,13 @@
+ALTER TABLE bookings DROP COLUMN mileage_charge;
+ALTER TABLE bookings DROP COLUMN excess_km;
+ALTER TABLE bookings DROP COLUMN km_allowance;
+ALTER TABLE bookings DROP COLUMN fuel_charge;
+ALTER TABLE bookings DROP COLUMN fuel_policy;
+ALTER TABLE bookings DROP COLUMN return_fuel_level;
+ALTER TABLE bookings DROP COLUMN pickup_fuel_level;
+
+ALTER TABLE car_categories DROP COLUMN excess_km_rate;
+ALTER TABLE car_categories DROP COLUMN daily_km_allowance;
+ALTER TABLE car_categories DROP COLUMN prepaid_fuel_price;
+ALTER TABLE car_categories DROP COLUMN refuel_rate;
+ALTER TABLE car_categories DROP COLUMN fuel_policy;
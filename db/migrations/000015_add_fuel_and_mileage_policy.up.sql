@@ -0,0 +1,13 @@
+ALTER TABLE car_categories ADD COLUMN fuel_policy VARCHAR(20) NOT NULL DEFAULT 'full_to_full';
+ALTER TABLE car_categories ADD COLUMN refuel_rate INT NOT NULL DEFAULT 0;
+ALTER TABLE car_categories ADD COLUMN prepaid_fuel_price INT NOT NULL DEFAULT 0;
+ALTER TABLE car_categories ADD COLUMN daily_km_allowance INT NOT NULL DEFAULT 0;
+ALTER TABLE car_categories ADD COLUMN excess_km_rate INT NOT NULL DEFAULT 0;
+
+ALTER TABLE bookings ADD COLUMN pickup_fuel_level INT;
+ALTER TABLE bookings ADD COLUMN return_fuel_level INT;
+ALTER TABLE bookings ADD COLUMN fuel_policy VARCHAR(20);
+ALTER TABLE bookings ADD COLUMN fuel_charge INT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN km_allowance INT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN excess_km INT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN mileage_charge INT NOT NULL DEFAULT 0;
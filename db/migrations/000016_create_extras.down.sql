@@ -0,0 +1,4 @@
+ALTER TABLE bookings DROP COLUMN extras_cost;
+
+DROP TABLE IF EXISTS booking_extras;
+DROP TABLE IF EXISTS extras;
@@ -0,0 +1,35 @@
+CREATE TABLE extras (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    pricing VARCHAR(20) NOT NULL,
+    price INT NOT NULL,
+    stock INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_extras_deleted_at ON extras(deleted_at);
+
+INSERT INTO extras (name, description, pricing, price, stock) VALUES
+('Child Seat', 'Forward facing seat for children from 1 to 4 years', 'per_day', 35000, 12),
+('GPS', 'Portable navigation unit', 'per_day', 25000, 10),
+('Phone Holder', 'Dashboard mount with charging cable', 'per_booking', 20000, 20);
+
+CREATE TABLE booking_extras (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id),
+    extra_id INT NOT NULL REFERENCES extras(id),
+    quantity INT NOT NULL,
+    pricing VARCHAR(20) NOT NULL,
+    unit_price INT NOT NULL,
+    total INT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_booking_extras_booking_id ON booking_extras(booking_id);
+CREATE INDEX idx_booking_extras_extra_id ON booking_extras(extra_id);
+
+ALTER TABLE bookings ADD COLUMN extras_cost INT NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+ALTER TABLE bookings DROP COLUMN insurance_excess;
+ALTER TABLE bookings DROP COLUMN insurance_premium;
+ALTER TABLE bookings DROP COLUMN insurance_plan_id;
+
+ALTER TABLE car_categories DROP COLUMN insurance_plan_id;
+
+DROP TABLE IF EXISTS insurance_plans;
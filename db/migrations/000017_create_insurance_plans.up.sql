@@ -0,0 +1,22 @@
+CREATE TABLE insurance_plans (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    daily_premium INT NOT NULL DEFAULT 0,
+    excess INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_insurance_plans_deleted_at ON insurance_plans(deleted_at);
+
+INSERT INTO insurance_plans (name, description, daily_premium, excess) VALUES
+('Basic Coverage', 'Collision and theft cover, the renter pays damage up to the excess', 50000, 5000000),
+('Full Coverage', 'Collision, theft and third party cover with a low excess', 125000, 500000);
+
+ALTER TABLE car_categories ADD COLUMN insurance_plan_id INT REFERENCES insurance_plans(id);
+
+ALTER TABLE bookings ADD COLUMN insurance_plan_id INT REFERENCES insurance_plans(id);
+ALTER TABLE bookings ADD COLUMN insurance_premium INT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN insurance_excess INT NOT NULL DEFAULT 0;
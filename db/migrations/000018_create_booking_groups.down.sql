@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_bookings_booking_group_id;
+
+ALTER TABLE bookings DROP COLUMN cancelled_at;
+ALTER TABLE bookings DROP COLUMN group_discount;
+ALTER TABLE bookings DROP COLUMN booking_group_id;
+
+DROP TABLE IF EXISTS booking_groups;
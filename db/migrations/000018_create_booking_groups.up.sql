@@ -0,0 +1,18 @@
+CREATE TABLE booking_groups (
+    id SERIAL PRIMARY KEY,
+    customer_id INT NOT NULL REFERENCES customers(id),
+    name VARCHAR(100),
+    discount_percentage INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_booking_groups_customer_id ON booking_groups(customer_id);
+CREATE INDEX idx_booking_groups_deleted_at ON booking_groups(deleted_at);
+
+ALTER TABLE bookings ADD COLUMN booking_group_id INT REFERENCES booking_groups(id);
+ALTER TABLE bookings ADD COLUMN group_discount INT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN cancelled_at TIMESTAMP;
+
+CREATE INDEX idx_bookings_booking_group_id ON bookings(booking_group_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_bookings_company_id;
+
+ALTER TABLE bookings DROP COLUMN paid_at;
+ALTER TABLE bookings DROP COLUMN payment_due_at;
+ALTER TABLE bookings DROP COLUMN company_id;
+
+DROP TABLE IF EXISTS company_rates;
+DROP TABLE IF EXISTS companies;
@@ -0,0 +1,32 @@
+CREATE TABLE companies (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    npwp VARCHAR(16) NOT NULL UNIQUE,
+    address TEXT,
+    billing_contact VARCHAR(100) NOT NULL,
+    billing_email VARCHAR(100) NOT NULL,
+    billing_phone VARCHAR(20),
+    credit_limit INT NOT NULL,
+    payment_terms_days INT NOT NULL DEFAULT 30,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_companies_deleted_at ON companies(deleted_at);
+
+CREATE TABLE company_rates (
+    id SERIAL PRIMARY KEY,
+    company_id INT NOT NULL REFERENCES companies(id),
+    car_id INT NOT NULL REFERENCES cars(id),
+    daily_rent INT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (company_id, car_id)
+);
+
+ALTER TABLE bookings ADD COLUMN company_id INT REFERENCES companies(id);
+ALTER TABLE bookings ADD COLUMN payment_due_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN paid_at TIMESTAMP;
+
+CREATE INDEX idx_bookings_company_id ON bookings(company_id);
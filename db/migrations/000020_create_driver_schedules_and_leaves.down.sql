@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS driver_leaves;
+DROP TABLE IF EXISTS driver_schedules;
@@ -0,0 +1,27 @@
+CREATE TABLE driver_schedules (
+    id SERIAL PRIMARY KEY,
+    driver_id INT NOT NULL REFERENCES drivers(id),
+    weekday INT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_time VARCHAR(5) NOT NULL,
+    end_time VARCHAR(5) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_driver_schedules_deleted_at ON driver_schedules(deleted_at);
+CREATE UNIQUE INDEX idx_driver_schedules_driver_weekday ON driver_schedules(driver_id, weekday) WHERE deleted_at IS NULL;
+
+CREATE TABLE driver_leaves (
+    id SERIAL PRIMARY KEY,
+    driver_id INT NOT NULL REFERENCES drivers(id),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_driver_leaves_driver_id ON driver_leaves(driver_id);
+CREATE INDEX idx_driver_leaves_deleted_at ON driver_leaves(deleted_at);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS driver_documents;
+
+ALTER TABLE drivers
+    DROP COLUMN IF EXISTS license_expiry,
+    DROP COLUMN IF EXISTS license_class,
+    DROP COLUMN IF EXISTS license_number;
@@ -0,0 +1,19 @@
+ALTER TABLE drivers
+    ADD COLUMN license_number VARCHAR(50),
+    ADD COLUMN license_class VARCHAR(10),
+    ADD COLUMN license_expiry DATE;
+
+CREATE TABLE driver_documents (
+    id SERIAL PRIMARY KEY,
+    driver_id INT NOT NULL REFERENCES drivers(id),
+    type VARCHAR(50) NOT NULL,
+    number VARCHAR(100),
+    expires_at DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_driver_documents_driver_id ON driver_documents(driver_id);
+CREATE INDEX idx_driver_documents_expires_at ON driver_documents(expires_at);
+CREATE INDEX idx_driver_documents_deleted_at ON driver_documents(deleted_at);
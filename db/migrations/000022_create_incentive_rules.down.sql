@@ -0,0 +1,5 @@
+ALTER TABLE driver_incentives DROP COLUMN IF EXISTS rule_version;
+ALTER TABLE drivers DROP COLUMN IF EXISTS incentive_tier;
+
+DROP TABLE IF EXISTS incentive_rule_tiers;
+DROP TABLE IF EXISTS incentive_rules;
@@ -0,0 +1,32 @@
+CREATE TABLE incentive_rules (
+    id SERIAL PRIMARY KEY,
+    version INT NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    rent_percentage INT NOT NULL DEFAULT 0,
+    daily_flat INT NOT NULL DEFAULT 0,
+    long_trip_min_days INT NOT NULL DEFAULT 0,
+    long_trip_bonus INT NOT NULL DEFAULT 0,
+    weekend_daily_bonus INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_incentive_rules_deleted_at ON incentive_rules(deleted_at);
+
+CREATE TABLE incentive_rule_tiers (
+    id SERIAL PRIMARY KEY,
+    incentive_rule_id INT NOT NULL REFERENCES incentive_rules(id),
+    tier VARCHAR(30) NOT NULL,
+    rent_percentage INT NOT NULL DEFAULT 0,
+    daily_flat INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (incentive_rule_id, tier)
+);
+
+ALTER TABLE drivers ADD COLUMN incentive_tier VARCHAR(30);
+ALTER TABLE driver_incentives ADD COLUMN rule_version INT;
+
+-- The first version keeps paying what was hard-coded before: 5% of the rent.
+INSERT INTO incentive_rules (version, name, rent_percentage) VALUES (1, 'Standard', 5);
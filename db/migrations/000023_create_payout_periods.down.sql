@@ -0,0 +1,7 @@
+ALTER TABLE driver_incentives
+    DROP COLUMN IF EXISTS paid_at,
+    DROP COLUMN IF EXISTS payout_period_id;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS driver_payout_period_id;
+
+DROP TABLE IF EXISTS payout_periods;
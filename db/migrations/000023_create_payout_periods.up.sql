@@ -0,0 +1,19 @@
+CREATE TABLE payout_periods (
+    id SERIAL PRIMARY KEY,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    settled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_payout_periods_deleted_at ON payout_periods(deleted_at);
+
+ALTER TABLE bookings ADD COLUMN driver_payout_period_id INT REFERENCES payout_periods(id);
+CREATE INDEX idx_bookings_driver_payout_period_id ON bookings(driver_payout_period_id);
+
+ALTER TABLE driver_incentives
+    ADD COLUMN payout_period_id INT REFERENCES payout_periods(id),
+    ADD COLUMN paid_at TIMESTAMP;
+CREATE INDEX idx_driver_incentives_payout_period_id ON driver_incentives(payout_period_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_driver_incentives_booking_id;
+DROP INDEX IF EXISTS idx_bookings_driver_id_end_rent;
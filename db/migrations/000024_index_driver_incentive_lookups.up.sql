@@ -0,0 +1,2 @@
+CREATE INDEX idx_bookings_driver_id_end_rent ON bookings(driver_id, end_rent);
+CREATE INDEX idx_driver_incentives_booking_id ON driver_incentives(booking_id);
@@ -0,0 +1,8 @@
+ALTER TABLE incentive_rules DROP COLUMN IF EXISTS rating_bonus_min_count;
+ALTER TABLE incentive_rules DROP COLUMN IF EXISTS rating_bonus_min;
+ALTER TABLE incentive_rules DROP COLUMN IF EXISTS rating_bonus_percentage;
+ALTER TABLE cars DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE cars DROP COLUMN IF EXISTS rating_average;
+ALTER TABLE drivers DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE drivers DROP COLUMN IF EXISTS rating_average;
+DROP TABLE IF EXISTS booking_ratings;
@@ -0,0 +1,29 @@
+CREATE TABLE booking_ratings (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id),
+    customer_id INT NOT NULL REFERENCES customers(id),
+    car_id INT NOT NULL REFERENCES cars(id),
+    driver_id INT REFERENCES drivers(id),
+    car_rating SMALLINT NOT NULL CHECK (car_rating BETWEEN 1 AND 5),
+    driver_rating SMALLINT CHECK (driver_rating BETWEEN 1 AND 5),
+    overall_rating SMALLINT NOT NULL CHECK (overall_rating BETWEEN 1 AND 5),
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_booking_ratings_deleted_at ON booking_ratings(deleted_at);
+CREATE UNIQUE INDEX idx_booking_ratings_booking_id ON booking_ratings(booking_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_booking_ratings_car_id ON booking_ratings(car_id, created_at);
+CREATE INDEX idx_booking_ratings_driver_id ON booking_ratings(driver_id, created_at);
+
+ALTER TABLE drivers ADD COLUMN rating_average NUMERIC(3,2);
+ALTER TABLE drivers ADD COLUMN rating_count INT NOT NULL DEFAULT 0;
+ALTER TABLE cars ADD COLUMN rating_average NUMERIC(3,2);
+ALTER TABLE cars ADD COLUMN rating_count INT NOT NULL DEFAULT 0;
+
+-- A rule pays no rating bonus unless rating_bonus_percentage is set.
+ALTER TABLE incentive_rules ADD COLUMN rating_bonus_percentage INT NOT NULL DEFAULT 0;
+ALTER TABLE incentive_rules ADD COLUMN rating_bonus_min NUMERIC(3,2) NOT NULL DEFAULT 0;
+ALTER TABLE incentive_rules ADD COLUMN rating_bonus_min_count INT NOT NULL DEFAULT 0;
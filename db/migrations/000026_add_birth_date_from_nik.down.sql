@@ -0,0 +1,4 @@
+ALTER TABLE drivers DROP COLUMN IF EXISTS gender;
+ALTER TABLE drivers DROP COLUMN IF EXISTS birth_date;
+ALTER TABLE customers DROP COLUMN IF EXISTS gender;
+ALTER TABLE customers DROP COLUMN IF EXISTS birth_date;
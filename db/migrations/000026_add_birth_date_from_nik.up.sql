@@ -0,0 +1,59 @@
+ALTER TABLE customers ADD COLUMN birth_date DATE;
+ALTER TABLE customers ADD COLUMN gender VARCHAR(6);
+ALTER TABLE drivers ADD COLUMN birth_date DATE;
+ALTER TABLE drivers ADD COLUMN gender VARCHAR(6);
+
+-- Both are decoded from the NIK when it is saved. Existing rows are decoded
+-- here the way validation.ParseNIK does it; those whose NIK it would reject,
+-- such as the 13-digit seeds, keep them empty and skip the age check at
+-- booking time.
+CREATE FUNCTION pg_temp.nik_birth_date(nik TEXT) RETURNS DATE AS $$
+DECLARE
+    born_day INT;
+    born_month INT;
+    born DATE;
+BEGIN
+    IF nik !~ '^[0-9]{16}$'
+        OR LEFT(nik, 2) NOT IN (
+            '11', '12', '13', '14', '15', '16', '17', '18', '19', '21', '31', '32',
+            '33', '34', '35', '36', '51', '52', '53', '61', '62', '63', '64', '65',
+            '71', '72', '73', '74', '75', '76', '81', '82', '91', '92', '93', '94',
+            '95', '96')
+        OR SUBSTRING(nik, 3, 2) = '00'
+        OR SUBSTRING(nik, 5, 2) = '00'
+        OR RIGHT(nik, 4) = '0000' THEN
+        RETURN NULL;
+    END IF;
+
+    born_day := SUBSTRING(nik, 7, 2)::INT;
+    IF born_day > 40 THEN
+        born_day := born_day - 40;
+    END IF;
+    born_month := SUBSTRING(nik, 9, 2)::INT;
+    IF born_month NOT BETWEEN 1 AND 12 OR born_day NOT BETWEEN 1 AND 31 THEN
+        RETURN NULL;
+    END IF;
+
+    born := make_date(2000 + SUBSTRING(nik, 11, 2)::INT, born_month, 1) + (born_day - 1);
+    IF born > CURRENT_DATE THEN
+        born := make_date(1900 + SUBSTRING(nik, 11, 2)::INT, born_month, 1) + (born_day - 1);
+    END IF;
+    -- A day past the end of the month spills into the next one.
+    IF EXTRACT(MONTH FROM born) <> born_month THEN
+        RETURN NULL;
+    END IF;
+    RETURN born;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE customers SET
+    birth_date = pg_temp.nik_birth_date(nik),
+    gender = CASE WHEN SUBSTRING(nik, 7, 2)::INT > 40 THEN 'female' ELSE 'male' END
+WHERE pg_temp.nik_birth_date(nik) IS NOT NULL;
+
+UPDATE drivers SET
+    birth_date = pg_temp.nik_birth_date(nik),
+    gender = CASE WHEN SUBSTRING(nik, 7, 2)::INT > 40 THEN 'female' ELSE 'male' END
+WHERE pg_temp.nik_birth_date(nik) IS NOT NULL;
+
+DROP FUNCTION pg_temp.nik_birth_date(TEXT);
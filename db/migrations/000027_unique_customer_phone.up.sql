@@ -0,0 +1,3 @@
+-- Phone numbers are now saved in E.164; go run ./phonefix normalizes the
+-- ones saved before. No two active customers may share a number.
+CREATE UNIQUE INDEX idx_customers_phone ON customers(phone) WHERE deleted_at IS NULL;
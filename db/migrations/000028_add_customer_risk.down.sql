@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_bookings_customer_id;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS risk_override_reason;
+ALTER TABLE bookings DROP COLUMN IF EXISTS risk_override_by;
+
+ALTER TABLE customers DROP COLUMN IF EXISTS risk_reviewed_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS risk_expires_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS risk_flagged_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS risk_flagged_by;
+ALTER TABLE customers DROP COLUMN IF EXISTS risk_reason;
+ALTER TABLE customers DROP COLUMN IF EXISTS risk_status;
@@ -0,0 +1,11 @@
+ALTER TABLE customers ADD COLUMN risk_status VARCHAR(20);
+ALTER TABLE customers ADD COLUMN risk_reason VARCHAR(255);
+ALTER TABLE customers ADD COLUMN risk_flagged_by VARCHAR(100);
+ALTER TABLE customers ADD COLUMN risk_flagged_at TIMESTAMP;
+ALTER TABLE customers ADD COLUMN risk_expires_at DATE;
+ALTER TABLE customers ADD COLUMN risk_reviewed_at TIMESTAMP;
+
+ALTER TABLE bookings ADD COLUMN risk_override_by VARCHAR(100);
+ALTER TABLE bookings ADD COLUMN risk_override_reason VARCHAR(255);
+
+CREATE INDEX idx_bookings_customer_id ON bookings(customer_id);
@@ -0,0 +1,4 @@
+-- A vehicle can be out on only one booking at a time, the same rule the
+-- vehicles-out query in the repository applies.
+CREATE UNIQUE INDEX idx_bookings_vehicle_out ON bookings(vehicle_id)
+    WHERE picked_up_at IS NOT NULL AND returned_at IS NULL AND deleted_at IS NULL;
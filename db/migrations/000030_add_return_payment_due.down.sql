@@ -0,0 +1,4 @@
+UPDATE bookings
+SET payment_due_at = NULL,
+    paid_at = NULL
+WHERE company_id IS NULL;
@@ -0,0 +1,7 @@
+-- Bookings a customer pays for themselves are now due ReturnPaymentDays
+-- after return and recorded paid like company bookings. Those returned
+-- before had no way to be recorded paid, so they are taken as settled.
+UPDATE bookings
+SET payment_due_at = returned_at + INTERVAL '7 days',
+    paid_at = returned_at
+WHERE company_id IS NULL AND returned_at IS NOT NULL AND paid_at IS NULL;
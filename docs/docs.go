@@ -24,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/bookings": {
+        "/booking-groups": {
             "get": {
-                "description": "Retrieve a list of all bookings.",
+                "description": "Retrieve all multi-car orders with their bookings and combined totals.",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,20 +34,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-groups"
+                ],
+                "summary": "Retrieve list of booking groups",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Retrieve list of bookings",
                 "responses": {
                     "200": {
-                        "description": "List of bookings",
-                        "schema": {
-                            "$ref": "#/definitions/models.Booking"
-                        }
-                    },
-                    "404": {
-                        "description": "No booking found",
+                        "description": "List of booking groups",
                         "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.BookingGroup"
+                            }
                         }
                     },
                     "500": {
@@ -59,7 +64,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new booking.",
+                "description": "Book several cars, each with an optional driver, for one customer, optionally billed to a company. Either every line is available and booked or none is. Groups of 3, 5 and 10 cars get 5, 10 and 15 percent off the rent.",
                 "consumes": [
                     "application/json"
                 ],
@@ -67,29 +72,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-groups"
                 ],
-                "summary": "Create a new booking",
+                "summary": "Create a booking group",
                 "parameters": [
                     {
-                        "description": "Booking data",
-                        "name": "booking",
+                        "description": "Booking group data",
+                        "name": "group",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputBooking"
+                            "$ref": "#/definitions/models.InputBookingGroup"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Booking details",
+                    "201": {
+                        "description": "Created booking group",
                         "schema": {
-                            "$ref": "#/definitions/models.Booking"
+                            "$ref": "#/definitions/models.BookingGroup"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A line's car or extra is not available, or company over its credit limit",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -103,9 +114,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/bookings/{id}": {
+        "/booking-groups/{id}": {
             "get": {
-                "description": "Retrieve a booking by its ID",
+                "description": "Retrieve a multi-car order with its bookings and combined totals.",
                 "consumes": [
                     "application/json"
                 ],
@@ -113,13 +124,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-groups"
                 ],
-                "summary": "Retrieve booking by ID",
+                "summary": "Retrieve booking group by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Booking ID",
+                        "description": "Booking group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -127,19 +138,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Booking details",
+                        "description": "Booking group details",
                         "schema": {
-                            "$ref": "#/definitions/models.Booking"
+                            "$ref": "#/definitions/models.BookingGroup"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid required param",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Booking not found",
+                        "description": "Booking group not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -151,9 +162,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update information of a booking.",
+            }
+        },
+        "/booking-groups/{id}/bookings/{booking_id}/cancel": {
+            "post": {
+                "description": "Cancel a booking of the group that was not picked up yet, dropping its unpaid driver incentive. The bookings left get the discount of the smaller group.",
                 "consumes": [
                     "application/json"
                 ],
@@ -161,84 +174,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-groups"
                 ],
-                "summary": "Update booking information",
+                "summary": "Cancel one booking of a group",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Booking ID",
+                        "description": "Booking group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Booking data",
-                        "name": "booking",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.InputBooking"
-                        }
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "booking_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Booking details",
+                        "description": "Booking group after the cancellation",
                         "schema": {
-                            "$ref": "#/definitions/models.Booking"
+                            "$ref": "#/definitions/models.BookingGroup"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid required param",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Booking group or booking not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a booking by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "bookings"
-                ],
-                "summary": "Delete booking by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Booking ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Booking details",
-                        "schema": {
-                            "$ref": "#/definitions/models.Booking"
-                        }
                     },
-                    "404": {
-                        "description": "Booking not found",
+                    "409": {
+                        "description": "Booking already cancelled, picked up or finished",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -252,9 +227,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/bookingtypes": {
+        "/booking-ratings": {
             "get": {
-                "description": "Retrieve a list of all bookingTypes.",
+                "description": "Retrieve customers' ratings of finished bookings, latest first, optionally only those of one booking, car or driver.",
                 "consumes": [
                     "application/json"
                 ],
@@ -262,18 +237,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookingTypes"
+                    "booking-ratings"
+                ],
+                "summary": "Retrieve list of booking ratings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only the rating of this booking",
+                        "name": "booking_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only ratings of this car",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only ratings of this driver",
+                        "name": "driver_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Retrieve list of bookingTypes",
                 "responses": {
                     "200": {
-                        "description": "List of bookingTypes",
+                        "description": "List of booking ratings",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingType"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.BookingRating"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "No bookingType found",
+                    "400": {
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -287,7 +291,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new bookingType.",
+                "description": "Record the customer's rating, from 1 to 5, of the car, the driver if the booking had one, and the trip overall. Each booking is rated once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -295,29 +299,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookingTypes"
+                    "booking-ratings"
                 ],
-                "summary": "Create a new bookingType",
+                "summary": "Rate a finished booking",
                 "parameters": [
                     {
-                        "description": "BookingType data",
-                        "name": "bookingType",
+                        "description": "Booking rating data",
+                        "name": "rating",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputBookingType"
+                            "$ref": "#/definitions/models.InputBookingRating"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Created bookingType",
+                    "201": {
+                        "description": "Created booking rating",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingType"
+                            "$ref": "#/definitions/models.BookingRating"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking already rated",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -331,9 +341,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/bookingtypes/{id}": {
+        "/booking-ratings/{id}": {
             "get": {
-                "description": "Retrieve a bookingType by its ID",
+                "description": "Retrieve a customer's rating of a booking by its unique ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -341,13 +351,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookingTypes"
+                    "booking-ratings"
                 ],
-                "summary": "Retrieve bookingType by ID",
+                "summary": "Retrieve booking rating by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "BookingType ID",
+                        "description": "Booking rating ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -355,19 +365,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "BookingType data",
+                        "description": "Booking rating details",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingType"
+                            "$ref": "#/definitions/models.BookingRating"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid required param",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "BookingType not found",
+                        "description": "Booking rating not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -381,7 +391,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update information of a bookingType.",
+                "description": "Change the scores or comment of a rating. It stays with the booking it was given for.",
                 "consumes": [
                     "application/json"
                 ],
@@ -389,42 +399,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookingTypes"
+                    "booking-ratings"
                 ],
-                "summary": "Update bookingType information",
+                "summary": "Update booking rating",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "BookingType ID",
+                        "description": "Booking rating ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "BookingType data",
-                        "name": "bookingType",
+                        "description": "Updated booking rating data",
+                        "name": "rating",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputBookingType"
+                            "$ref": "#/definitions/models.InputBookingRating"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated bookingType",
+                        "description": "Updated booking rating",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingType"
+                            "$ref": "#/definitions/models.BookingRating"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Booking rating not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -438,7 +448,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a bookingType by its ID.",
+                "description": "Remove a rating; the averages of its car and driver no longer count it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -446,13 +456,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookingTypes"
+                    "booking-ratings"
                 ],
-                "summary": "Delete bookingType by ID",
+                "summary": "Delete booking rating by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "BookingType ID",
+                        "description": "Booking rating ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -460,13 +470,20 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Booking rating successfully deleted",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingType"
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "BookingType not found",
+                        "description": "Booking rating not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -480,9 +497,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/cars": {
-            "get": {
-                "description": "Retrieve a list of all available cars.",
+        "/booking-ratings/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted booking rating by its ID, unless the booking was rated again since.",
                 "consumes": [
                     "application/json"
                 ],
@@ -490,21 +507,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cars"
+                    "booking-ratings"
+                ],
+                "summary": "Restore a deleted booking rating",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Booking rating ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Retrieve list of cars",
                 "responses": {
                     "200": {
-                        "description": "List of cars",
+                        "description": "Booking rating successfully restored",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Car"
-                            }
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "No car found",
+                        "description": "Booking rating not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking already rated",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -516,9 +552,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Add a new car to the system.",
+            }
+        },
+        "/bookings": {
+            "get": {
+                "description": "Retrieve a list of all bookings.",
                 "consumes": [
                     "application/json"
                 ],
@@ -526,29 +564,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cars"
+                    "bookings"
                 ],
-                "summary": "Create a new car",
+                "summary": "Retrieve list of bookings",
                 "parameters": [
                     {
-                        "description": "Car data",
-                        "name": "car",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.InputCar"
-                        }
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created car",
+                    "200": {
+                        "description": "List of bookings",
                         "schema": {
-                            "$ref": "#/definitions/models.Car"
+                            "$ref": "#/definitions/models.Booking"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "No booking found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -560,25 +595,81 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/cars/{id}": {
-            "get": {
-                "description": "Retrieve a car by its unique ID.",
-                "consumes": [
-                    "application/json"
+            },
+            "post": {
+                "description": "Create a new booking.",
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "cars"
+                    "bookings"
                 ],
-                "summary": "Retrieve car by ID",
+                "summary": "Create a new booking",
+                "parameters": [
+                    {
+                        "description": "Booking data",
+                        "name": "booking",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputBooking"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Booking details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Booking"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer, car, driver or booking type not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "No unit of the car or not enough of an extra available, or company over its credit limit, or customer blacklisted or flagged without a risk_override",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookings/{id}": {
+            "get": {
+                "description": "Retrieve a booking by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookings"
+                ],
+                "summary": "Retrieve booking by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Car ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -586,19 +677,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Car details",
+                        "description": "Booking details",
                         "schema": {
-                            "$ref": "#/definitions/models.Car"
+                            "$ref": "#/definitions/models.Booking"
                         }
                     },
                     "400": {
-                        "description": "Invalid car ID",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Car not found",
+                        "description": "Booking not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -612,7 +703,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Modify details of an existing car.",
+                "description": "Update information of a booking.",
                 "consumes": [
                     "application/json"
                 ],
@@ -620,42 +711,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cars"
+                    "bookings"
                 ],
-                "summary": "Update car information",
+                "summary": "Update booking information",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Car ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated car data",
-                        "name": "car",
+                        "description": "Booking data",
+                        "name": "booking",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputCar"
+                            "$ref": "#/definitions/models.InputBooking"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated car",
+                        "description": "Booking details",
                         "schema": {
-                            "$ref": "#/definitions/models.Car"
+                            "$ref": "#/definitions/models.Booking"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Car not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -669,7 +760,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove a car from the system using its ID.",
+                "description": "Delete a booking by its ID, together with its driver incentives not paid out yet.",
                 "consumes": [
                     "application/json"
                 ],
@@ -677,13 +768,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cars"
+                    "bookings"
                 ],
-                "summary": "Delete car by ID",
+                "summary": "Delete booking by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Car ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -691,20 +782,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Car successfully deleted",
+                        "description": "Booking details",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Booking"
                         }
                     },
-                    "400": {
-                        "description": "Invalid required param",
+                    "404": {
+                        "description": "Booking not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Car not found",
+                    "409": {
+                        "description": "Driver incentive of the booking already paid out",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -718,9 +808,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers": {
-            "get": {
-                "description": "Retrieve a list of all customers.",
+        "/bookings/{id}/pay": {
+            "post": {
+                "description": "Mark a booking billed to a company as paid, which frees its amount from the company's credit limit, or record that a customer paying for themselves settled the balance left at return.",
                 "consumes": [
                     "application/json"
                 ],
@@ -728,18 +818,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookings"
+                ],
+                "summary": "Record the payment of a booking",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Retrieve list of customers",
                 "responses": {
                     "200": {
-                        "description": "List of customers",
+                        "description": "Paid booking",
                         "schema": {
-                            "$ref": "#/definitions/models.Customer"
+                            "$ref": "#/definitions/models.Booking"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "No customer found",
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking paid by the customer not returned yet, cancelled or already paid",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -751,9 +862,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/bookings/{id}/pickup": {
             "post": {
-                "description": "Create a new customer.",
+                "description": "Assign a vehicle unit to the booking and hand it to the customer. Without vehicle_id a free unit of the booked car is chosen; without fuel_level the tank is taken to be full.",
                 "consumes": [
                     "application/json"
                 ],
@@ -761,29 +874,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookings"
                 ],
-                "summary": "Create a new customer",
+                "summary": "Pick up a booked car",
                 "parameters": [
                     {
-                        "description": "Customer data",
-                        "name": "customer",
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Vehicle to hand over and its fuel level",
+                        "name": "pickup",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputCustomer"
+                            "$ref": "#/definitions/models.InputPickup"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Booking with its vehicle",
                         "schema": {
-                            "$ref": "#/definitions/models.Customer"
+                            "$ref": "#/definitions/models.Booking"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking already picked up or finished, or no vehicle free",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -797,9 +928,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/{id}": {
-            "get": {
-                "description": "Retrieve a customer by its ID",
+        "/bookings/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted booking by its ID, with the driver incentives deleted along with it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -807,13 +938,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookings"
                 ],
-                "summary": "Retrieve customer by ID",
+                "summary": "Restore a deleted booking",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Customer ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -821,19 +952,20 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Customer details",
+                        "description": "Booking successfully restored",
                         "schema": {
-                            "$ref": "#/definitions/models.Customer"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid required param",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Customer not found",
+                        "description": "Booking not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -845,9 +977,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update information of a customer.",
+            }
+        },
+        "/bookings/{id}/return": {
+            "post": {
+                "description": "Take the vehicle back, record its odometer reading and fuel level, charge missing fuel and excess kilometres under the car category's policy and finish the booking.",
                 "consumes": [
                     "application/json"
                 ],
@@ -855,42 +989,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookings"
                 ],
-                "summary": "Update customer information",
+                "summary": "Return a picked up car",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Customer ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Customer data",
-                        "name": "customer",
+                        "description": "Odometer reading and fuel level at return",
+                        "name": "return",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputCustomer"
+                            "$ref": "#/definitions/models.InputReturn"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated customer",
+                        "description": "Finished booking",
                         "schema": {
-                            "$ref": "#/definitions/models.Customer"
+                            "$ref": "#/definitions/models.Booking"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking not picked up or already returned",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -902,9 +1042,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a customer by its ID.",
+            }
+        },
+        "/bookings/{id}/settlement": {
+            "get": {
+                "description": "Break down what the customer owes for a booking, including insurance, fuel, mileage, damage and one-way charges, and the balance left after the deposit.",
                 "consumes": [
                     "application/json"
                 ],
@@ -912,13 +1054,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookings"
                 ],
-                "summary": "Delete customer by ID",
+                "summary": "Settle a booking",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Customer ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -926,13 +1068,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Deleted customer",
+                        "description": "Settlement",
                         "schema": {
-                            "$ref": "#/definitions/models.Customer"
+                            "$ref": "#/definitions/models.BookingSettlement"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Customer not found",
+                        "description": "Booking not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -946,9 +1094,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/{id}/membership": {
-            "put": {
-                "description": "Assign a membership to a customer by providing the membership ID.",
+        "/bookingtypes": {
+            "get": {
+                "description": "Retrieve a list of all bookingTypes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -956,42 +1104,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookingTypes"
                 ],
-                "summary": "Assign membership to a customer",
+                "summary": "Retrieve list of bookingTypes",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Customer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Membership data",
-                        "name": "membership",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.InputMembershipID"
-                        }
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Customer with updated membership",
-                        "schema": {
-                            "$ref": "#/definitions/models.Customer"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "List of bookingTypes",
                         "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
+                            "$ref": "#/definitions/models.BookingType"
                         }
                     },
                     "404": {
-                        "description": "Customer not found",
+                        "description": "No bookingType found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1004,8 +1136,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Remove a membership assigned to a customer by providing the membership ID.",
+            "post": {
+                "description": "Create a new bookingType.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1013,23 +1145,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "bookingTypes"
                 ],
-                "summary": "Remove membership from a customer",
+                "summary": "Create a new bookingType",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Customer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "BookingType data",
+                        "name": "bookingType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputBookingType"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Customer with removed membership",
+                        "description": "Created bookingType",
                         "schema": {
-                            "$ref": "#/definitions/models.Customer"
+                            "$ref": "#/definitions/models.BookingType"
                         }
                     },
                     "400": {
@@ -1038,12 +1172,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Customer not found",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1053,9 +1181,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/driver-incentives": {
+        "/bookingtypes/{id}": {
             "get": {
-                "description": "Retrieve a list of all driver incentives.",
+                "description": "Retrieve a bookingType by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1063,21 +1191,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "driverIncentives"
+                    "bookingTypes"
+                ],
+                "summary": "Retrieve bookingType by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "BookingType ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Retrieve list of driver incentives",
                 "responses": {
                     "200": {
-                        "description": "List of driver incentives",
+                        "description": "BookingType data",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.DriverIncentive"
-                            }
+                            "$ref": "#/definitions/models.BookingType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "No driver incentive found",
+                        "description": "BookingType not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1090,8 +1230,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Add a new driver incentive record.",
+            "put": {
+                "description": "Update information of a bookingType.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1099,25 +1239,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "driverIncentives"
+                    "bookingTypes"
                 ],
-                "summary": "Create a new driver incentive",
+                "summary": "Update bookingType information",
                 "parameters": [
                     {
-                        "description": "Driver Incentive Data",
-                        "name": "driverIncentive",
+                        "type": "integer",
+                        "description": "BookingType ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "BookingType data",
+                        "name": "bookingType",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputDriverIncentive"
+                            "$ref": "#/definitions/models.InputBookingType"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created driver incentive",
+                    "200": {
+                        "description": "Updated bookingType",
                         "schema": {
-                            "$ref": "#/definitions/models.DriverIncentive"
+                            "$ref": "#/definitions/models.BookingType"
                         }
                     },
                     "400": {
@@ -1126,6 +1273,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1133,54 +1286,64 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/driver-incentives/driver/{id}": {
-            "get": {
-                "description": "Retrieve a list of incentives for a given driver ID",
+            },
+            "delete": {
+                "description": "Delete a bookingType by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers Incentive"
+                    "bookingTypes"
                 ],
-                "summary": "Get Driver Incentives List",
+                "summary": "Delete bookingType by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "BookingType ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Set to reassign to move bookings to another bookingType first",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Target BookingType ID when cascade=reassign",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of Incentives",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.DriverIncentive"
-                            }
+                            "$ref": "#/definitions/models.BookingType"
                         }
                     },
-                    "400": {
-                        "description": "Invalid Driver ID",
+                    "404": {
+                        "description": "BookingType not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "No driverIncentive found",
+                    "409": {
+                        "description": "Still referenced by other records",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Driver not found / Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1188,20 +1351,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/driver-incentives/driver/{id}/total": {
-            "get": {
-                "description": "Retrieve the total incentive amount for a given driver ID",
+        "/bookingtypes/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted bookingType by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers Incentive"
+                    "bookingTypes"
                 ],
-                "summary": "Get Total Driver Incentives",
+                "summary": "Restore a deleted bookingType",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "BookingType ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1209,20 +1375,26 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Total Incentive for Driver",
+                        "description": "BookingType successfully restored",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid Driver ID",
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "BookingType not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Driver not found / Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1230,9 +1402,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/driver-incentives/{id}": {
+        "/branches": {
             "get": {
-                "description": "Retrieve a specific driver incentive by its ID.",
+                "description": "Retrieve all branches.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1240,35 +1412,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "driverIncentives"
+                    "branches"
                 ],
-                "summary": "Retrieve a driver incentive by ID",
+                "summary": "Retrieve list of branches",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Driver Incentive ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Driver incentive details",
-                        "schema": {
-                            "$ref": "#/definitions/models.DriverIncentive"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid driver incentive ID",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Driver incentive not found",
+                        "description": "List of branches",
                         "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Branch"
+                            }
                         }
                     },
                     "500": {
@@ -1279,8 +1441,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Modify details of an existing driver incentive.",
+            "post": {
+                "description": "Open a new branch with the fee it charges for one-way rentals.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1288,42 +1450,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "driverIncentives"
+                    "branches"
                 ],
-                "summary": "Update a driver incentive",
+                "summary": "Create a new branch",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Driver Incentive ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated driver incentive data",
-                        "name": "driverIncentive",
+                        "description": "Branch data",
+                        "name": "branch",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputDriverIncentive"
+                            "$ref": "#/definitions/models.InputBranch"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Updated driver incentive",
+                    "201": {
+                        "description": "Created branch",
                         "schema": {
-                            "$ref": "#/definitions/models.DriverIncentive"
+                            "$ref": "#/definitions/models.Branch"
                         }
                     },
                     "400": {
-                        "description": "Invalid required param or request body",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Driver incentive not found",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1335,9 +1484,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove a driver incentive using its ID.",
+            }
+        },
+        "/branches/{id}": {
+            "get": {
+                "description": "Retrieve a branch by its unique ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1345,13 +1496,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "driverIncentives"
+                    "branches"
                 ],
-                "summary": "Delete a driver incentive by ID",
+                "summary": "Retrieve branch by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver Incentive ID",
+                        "description": "Branch ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1359,10 +1510,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful deletion message",
+                        "description": "Branch details",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Branch"
                         }
                     },
                     "400": {
@@ -1372,45 +1522,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Driver incentive not found",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/drivers": {
-            "get": {
-                "description": "Retrieve a list of all drivers.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "drivers"
-                ],
-                "summary": "Retrieve list of drivers",
-                "responses": {
-                    "200": {
-                        "description": "List of drivers",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Driver"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "No driver found",
+                        "description": "Branch not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1423,54 +1535,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Create a new driver.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "drivers"
-                ],
-                "summary": "Create a new driver",
-                "parameters": [
-                    {
-                        "description": "Driver data",
-                        "name": "driver",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.InputDriver"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Driver created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/models.Driver"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/drivers/{id}": {
-            "get": {
-                "description": "Retrieve a driver by its ID",
+            "put": {
+                "description": "Modify the details or one-way fee of a branch.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1478,33 +1544,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "branches"
                 ],
-                "summary": "Retrieve driver by ID",
+                "summary": "Update branch",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "Branch ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Updated branch data",
+                        "name": "branch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputBranch"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Driver data",
+                        "description": "Updated branch",
                         "schema": {
-                            "$ref": "#/definitions/models.Driver"
+                            "$ref": "#/definitions/models.Branch"
                         }
                     },
                     "400": {
-                        "description": "Invalid driver ID",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Driver not found",
+                        "description": "Branch not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1517,8 +1592,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update information of a driver.",
+            "delete": {
+                "description": "Close a branch that keeps no vehicles or drivers and has no open bookings or transfers.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1526,32 +1601,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "branches"
                 ],
-                "summary": "Update driver information",
+                "summary": "Delete branch by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "Branch ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated driver data",
-                        "name": "driver",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.InputDriver"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Driver updated successfully",
+                        "description": "Branch successfully deleted",
                         "schema": {
-                            "$ref": "#/definitions/models.Driver"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -1561,7 +1628,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Driver not found",
+                        "description": "Branch not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still has vehicles, drivers, open bookings or open transfers",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1573,9 +1646,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a driver by its ID.",
+            }
+        },
+        "/branches/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted branch by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1583,13 +1658,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "branches"
                 ],
-                "summary": "Delete driver by ID",
+                "summary": "Restore a deleted branch",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "Branch ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1597,7 +1672,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Driver deleted successfully",
+                        "description": "Branch successfully restored",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1610,7 +1685,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Driver not found",
+                        "description": "Branch not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1624,9 +1699,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/memberships": {
+        "/car-categories": {
             "get": {
-                "description": "Retrieve a list of all memberships.",
+                "description": "Retrieve all car categories.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1634,25 +1709,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "car-categories"
+                ],
+                "summary": "Retrieve list of car categories",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Retrieve list of memberships",
                 "responses": {
                     "200": {
-                        "description": "List of memberships",
+                        "description": "List of car categories",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Membership"
+                                "$ref": "#/definitions/models.CarCategory"
                             }
                         }
                     },
-                    "404": {
-                        "description": "No membership found",
-                        "schema": {
-                            "$ref": "#/definitions/pkg.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1662,7 +1739,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new membership.",
+                "description": "Add a new car category with the fuel policy, mileage allowance and mandatory insurance plan of its cars.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1670,25 +1747,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "car-categories"
                 ],
-                "summary": "Create a new membership",
+                "summary": "Create a new car category",
                 "parameters": [
                     {
-                        "description": "Membership data",
-                        "name": "membership",
+                        "description": "Car category data",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputMembership"
+                            "$ref": "#/definitions/models.InputCarCategory"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created membership data",
+                        "description": "Created car category",
                         "schema": {
-                            "$ref": "#/definitions/models.Membership"
+                            "$ref": "#/definitions/models.CarCategory"
                         }
                     },
                     "400": {
@@ -1706,9 +1783,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/memberships/{id}": {
+        "/car-categories/{id}": {
             "get": {
-                "description": "Retrieve a membership by its ID",
+                "description": "Retrieve a car category by its unique ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1716,13 +1793,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "car-categories"
                 ],
-                "summary": "Retrieve membership by ID",
+                "summary": "Retrieve car category by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Membership ID",
+                        "description": "Car category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1730,19 +1807,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Membership data",
+                        "description": "Car category details",
                         "schema": {
-                            "$ref": "#/definitions/models.Membership"
+                            "$ref": "#/definitions/models.CarCategory"
                         }
                     },
                     "400": {
-                        "description": "Invalid membership ID",
+                        "description": "Invalid required param",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Membership not found",
+                        "description": "Car category not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1756,7 +1833,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update information of a membership.",
+                "description": "Modify a car category, including the fuel policy, mileage allowance and mandatory insurance plan of its cars.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1764,42 +1841,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "car-categories"
                 ],
-                "summary": "Update membership information",
+                "summary": "Update car category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Membership ID",
+                        "description": "Car category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated membership data",
-                        "name": "membership",
+                        "description": "Updated car category data",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.InputMembership"
+                            "$ref": "#/definitions/models.InputCarCategory"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated membership data",
+                        "description": "Updated car category",
                         "schema": {
-                            "$ref": "#/definitions/models.Membership"
+                            "$ref": "#/definitions/models.CarCategory"
                         }
                     },
                     "400": {
-                        "description": "Invalid membership ID or request body",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Membership not found",
+                        "description": "Car category not found",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1813,7 +1890,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a membership by its ID.",
+                "description": "Remove a car category that no car belongs to.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1821,13 +1898,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memberships"
+                    "car-categories"
                 ],
-                "summary": "Delete membership by ID",
+                "summary": "Delete car category by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Membership ID",
+                        "description": "Car category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1835,20 +1912,26 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message and deleted membership data",
+                        "description": "Car category successfully deleted",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid membership ID",
+                        "description": "Invalid required param",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Membership not found",
+                        "description": "Car category not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still referenced by cars",
                         "schema": {
                             "$ref": "#/definitions/pkg.ErrorResponse"
                         }
@@ -1861,156 +1944,8441 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Booking": {
-            "type": "object",
-            "properties": {
-                "book_type_id": {
-                    "type": "integer"
-                },
-                "booking_type": {
-                    "$ref": "#/definitions/models.BookingType"
-                },
-                "car": {
-                    "$ref": "#/definitions/models.Car"
-                },
-                "car_id": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "customer": {
-                    "$ref": "#/definitions/models.Customer"
-                },
-                "customer_id": {
-                    "type": "integer"
-                },
-                "discount": {
-                    "type": "integer"
-                },
-                "driver": {
-                    "$ref": "#/definitions/models.Driver"
-                },
-                "driver_id": {
-                    "type": "integer"
-                },
-                "end_rent": {
-                    "type": "string"
-                },
-                "finished": {
-                    "type": "boolean"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "start_rent": {
-                    "type": "string"
-                },
-                "total_cost": {
-                    "type": "integer"
-                },
-                "total_driver_cost": {
-                    "type": "integer"
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
         },
-        "models.BookingType": {
-            "type": "object",
-            "properties": {
-                "booking_type": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
+        "/car-categories/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted car category by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "car-categories"
+                ],
+                "summary": "Restore a deleted car category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Car category successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Car category not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars": {
+            "get": {
+                "description": "Search cars by category, attributes and price, optionally only those free over a rent period.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cars"
+                ],
+                "summary": "Retrieve list of cars",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only cars of this category",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Brand, case-insensitive",
+                        "name": "brand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "manual or automatic",
+                        "name": "transmission",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "petrol, diesel, electric or hybrid",
+                        "name": "fuel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "At least this many seats",
+                        "name": "min_seats",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Room for at least this many bags",
+                        "name": "min_luggage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Built in this year or later",
+                        "name": "min_year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Lowest daily rent",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Highest daily rent",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "With end_rent, only cars free over the period (dd/mm/yyyy)",
+                        "name": "start_rent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "With start_rent, only cars free over the period (dd/mm/yyyy)",
+                        "name": "end_rent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "With start_rent and end_rent, only cars free at this branch",
+                        "name": "branch_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, daily_rent, seats or year; prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of cars",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Car"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No car found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a new car to the system.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cars"
+                ],
+                "summary": "Create a new car",
+                "parameters": [
+                    {
+                        "description": "Car data",
+                        "name": "car",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCar"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created car",
+                        "schema": {
+                            "$ref": "#/definitions/models.Car"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{id}": {
+            "get": {
+                "description": "Retrieve a car by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cars"
+                ],
+                "summary": "Retrieve car by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Car details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Car"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid car ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Car not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify details of an existing car.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cars"
+                ],
+                "summary": "Update car information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated car data",
+                        "name": "car",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCar"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated car",
+                        "schema": {
+                            "$ref": "#/definitions/models.Car"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Car not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a car from the system using its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cars"
+                ],
+                "summary": "Delete car by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Car successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Car not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still referenced by other records",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted car by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cars"
+                ],
+                "summary": "Restore a deleted car",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Car successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Car not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies": {
+            "get": {
+                "description": "Retrieve all corporate accounts with their rate cards.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Retrieve list of companies",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of companies",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Company"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a corporate account with its NPWP, billing contact, credit limit, payment terms (net 30 unless given) and negotiated daily rents.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Create a new company",
+                "parameters": [
+                    {
+                        "description": "Company data",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCompany"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created company",
+                        "schema": {
+                            "$ref": "#/definitions/models.Company"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "NPWP already registered",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}": {
+            "get": {
+                "description": "Retrieve a company by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Retrieve company by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Company"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify a company and replace its rate card. New rates and terms apply to bookings made or edited from now on.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Update company",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated company data",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCompany"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated company",
+                        "schema": {
+                            "$ref": "#/definitions/models.Company"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "NPWP already registered",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a company once every booking billed to it was paid or cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Delete company by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Company still has unpaid bookings",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted company by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Restore a deleted company",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Company successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/statement": {
+            "get": {
+                "description": "List the bookings billed to a company that start in a month, with their totals, due dates and payments, and what the company owes overall.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Monthly statement of a company",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month as yyyy-mm, the current month by default",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statement",
+                        "schema": {
+                            "$ref": "#/definitions/models.CompanyStatement"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param or month",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers": {
+            "get": {
+                "description": "Retrieve a list of all customers.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Retrieve list of customers",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of customers",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "404": {
+                        "description": "No customer found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new customer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Create a new customer",
+                "parameters": [
+                    {
+                        "description": "Customer data",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCustomer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}": {
+            "get": {
+                "description": "Retrieve a customer by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Retrieve customer by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update information of a customer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Update customer information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Customer data",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCustomer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated customer",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a customer by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Delete customer by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted customer",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still referenced by other records",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/membership": {
+            "put": {
+                "description": "Assign a membership to a customer by providing the membership ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Assign membership to a customer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Membership data",
+                        "name": "membership",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputMembershipID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer with updated membership",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a membership assigned to a customer by providing the membership ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Remove membership from a customer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer with removed membership",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted customer by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Restore a deleted customer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/risk": {
+            "put": {
+                "description": "Flag a customer, so bookings need a manager's approval, or blacklist them so they cannot book at all, until expires_at in dd/mm/yyyy or for good when it is omitted. Customers are also flagged automatically after RISK_LATE_RETURNS late returns or RISK_UNPAID_BOOKINGS bookings unpaid past their due date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Flag or blacklist a customer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Risk status",
+                        "name": "risk",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputCustomerRisk"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer with updated risk status",
+                        "schema": {
+                            "$ref": "#/definitions/models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Lift a customer's risk status. Late returns before now no longer count towards an automatic flag.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Clear a customer's flag or blacklisting",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer risk status cleared",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Customer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Customer is neither flagged nor blacklisted",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-documents": {
+            "get": {
+                "description": "Retrieve the documents of all drivers, such as health certificates, optionally only those of one driver.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-documents"
+                ],
+                "summary": "Retrieve list of driver documents",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only documents of this driver",
+                        "name": "driver_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of driver documents",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverDocument"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid driver_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Record a document a driver has to keep current, such as a health certificate, with its expiry date in dd/mm/yyyy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-documents"
+                ],
+                "summary": "Create a new driver document",
+                "parameters": [
+                    {
+                        "description": "Driver document data",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverDocument"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created driver document",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-documents/{id}": {
+            "get": {
+                "description": "Retrieve a driver's document by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-documents"
+                ],
+                "summary": "Retrieve driver document by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver document details",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver document not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify a driver's document, for instance after it was renewed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-documents"
+                ],
+                "summary": "Update driver document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated driver document data",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverDocument"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated driver document",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver document not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a document from a driver's records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-documents"
+                ],
+                "summary": "Delete driver document by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver document successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver document not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-documents/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted driver document by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-documents"
+                ],
+                "summary": "Restore a deleted driver document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver document successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver document not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-incentives": {
+            "get": {
+                "description": "Retrieve a list of all driver incentives.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driverIncentives"
+                ],
+                "summary": "Retrieve list of driver incentives",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of driver incentives",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverIncentive"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No driver incentive found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a new driver incentive record.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driverIncentives"
+                ],
+                "summary": "Create a new driver incentive",
+                "parameters": [
+                    {
+                        "description": "Driver Incentive Data",
+                        "name": "driverIncentive",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverIncentive"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created driver incentive",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverIncentive"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-incentives/driver/{id}": {
+            "get": {
+                "description": "Retrieve a list of incentives for a given driver ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers Incentive"
+                ],
+                "summary": "Get Driver Incentives List",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest booking end date (DD/MM/YYYY)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest booking end date (DD/MM/YYYY)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Incentives per page (default 50, max 500)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of Incentives",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverIncentive"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Number of incentives matching the filter"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Driver ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No driverIncentive found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Driver not found / Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-incentives/driver/{id}/total": {
+            "get": {
+                "description": "Retrieve the total incentive amount for a given driver ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers Incentive"
+                ],
+                "summary": "Get Total Driver Incentives",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest booking end date (DD/MM/YYYY)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest booking end date (DD/MM/YYYY)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Total Incentive for Driver",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Driver ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Driver not found / Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-incentives/{id}": {
+            "get": {
+                "description": "Retrieve a specific driver incentive by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driverIncentives"
+                ],
+                "summary": "Retrieve a driver incentive by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver Incentive ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver incentive details",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverIncentive"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid driver incentive ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver incentive not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify details of an existing driver incentive.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driverIncentives"
+                ],
+                "summary": "Update a driver incentive",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver Incentive ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated driver incentive data",
+                        "name": "driverIncentive",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverIncentive"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated driver incentive",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverIncentive"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param or request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver incentive not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a driver incentive using its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driverIncentives"
+                ],
+                "summary": "Delete a driver incentive by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver Incentive ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful deletion message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver incentive not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-incentives/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted driverIncentive by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driverIncentives"
+                ],
+                "summary": "Restore a deleted driverIncentive",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "DriverIncentive ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DriverIncentive successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "DriverIncentive not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-leaves": {
+            "get": {
+                "description": "Retrieve the leave of all drivers, optionally only that of one driver.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-leaves"
+                ],
+                "summary": "Retrieve list of driver leave",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only leave of this driver",
+                        "name": "driver_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of driver leave",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverLeave"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid driver_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Put a driver on leave from start_date to end_date, both included and in dd/mm/yyyy. Drivers on leave cannot be booked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-leaves"
+                ],
+                "summary": "Create a new driver leave",
+                "parameters": [
+                    {
+                        "description": "Driver leave data",
+                        "name": "leave",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverLeave"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created driver leave",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverLeave"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-leaves/{id}": {
+            "get": {
+                "description": "Retrieve a driver's leave by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-leaves"
+                ],
+                "summary": "Retrieve driver leave by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver leave ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver leave details",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverLeave"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver leave not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify a driver's leave. Bookings already made are not checked again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-leaves"
+                ],
+                "summary": "Update driver leave",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver leave ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated driver leave data",
+                        "name": "leave",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverLeave"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated driver leave",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverLeave"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver leave not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancel a driver's leave, making the driver bookable on those days again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-leaves"
+                ],
+                "summary": "Delete driver leave by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver leave ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver leave successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver leave not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-leaves/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted driver leave by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-leaves"
+                ],
+                "summary": "Restore a deleted driver leave",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver leave ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver leave successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver leave not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-schedules": {
+            "get": {
+                "description": "Retrieve the weekly shifts of all drivers, optionally only those of one driver.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-schedules"
+                ],
+                "summary": "Retrieve list of driver schedules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only shifts of this driver",
+                        "name": "driver_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of driver schedules",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverSchedule"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid driver_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Give a driver a shift on one day of the week, 0 being Sunday, with times in hh:mm. Once a driver has a shift, the days without one are off.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-schedules"
+                ],
+                "summary": "Create a new driver schedule",
+                "parameters": [
+                    {
+                        "description": "Driver schedule data",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverSchedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created driver schedule",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverSchedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Driver already has a shift that day",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-schedules/{id}": {
+            "get": {
+                "description": "Retrieve a driver's shift by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-schedules"
+                ],
+                "summary": "Retrieve driver schedule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver schedule details",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverSchedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver schedule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify a driver's shift. Bookings already made are not checked again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-schedules"
+                ],
+                "summary": "Update driver schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated driver schedule data",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriverSchedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated driver schedule",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverSchedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver schedule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Driver already has a shift that day",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a driver's shift. A driver left without any shift is available every day.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-schedules"
+                ],
+                "summary": "Delete driver schedule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver schedule successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver schedule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/driver-schedules/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted driver shift by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "driver-schedules"
+                ],
+                "summary": "Restore a deleted driver schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver schedule successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver schedule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers": {
+            "get": {
+                "description": "Retrieve a list of all drivers.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Retrieve list of drivers",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of drivers",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Driver"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No driver found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new driver.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Create a new driver",
+                "parameters": [
+                    {
+                        "description": "Driver data",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriver"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Driver created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Driver"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/expiring": {
+            "get": {
+                "description": "List the driving licenses and other documents, such as health certificates, that have expired or will within the given window, soonest first, after the drivers with no license number or expiry on record, which are marked missing. Drivers whose license runs out before a booking ends cannot be assigned to it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Report driver licenses and documents expiring soon",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Window in days such as 30d or 30, 30 days when omitted",
+                        "name": "within",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expiring licenses and documents",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverExpiry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid within",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/{id}": {
+            "get": {
+                "description": "Retrieve a driver by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Retrieve driver by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver data",
+                        "schema": {
+                            "$ref": "#/definitions/models.Driver"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid driver ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update information of a driver.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Update driver information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated driver data",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputDriver"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Driver"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a driver by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Delete driver by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still referenced by other records",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted driver by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Restore a deleted driver",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/{id}/stats": {
+            "get": {
+                "description": "Report a driver's trips completed, days driven, utilization of the days the driver was available, incentive earned, average rating and cancellations over a range of days, the last 30 days when omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Retrieve a driver's performance stats",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the range (DD/MM/YYYY)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the range (DD/MM/YYYY)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Driver stats",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriverStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid range",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Driver not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/extras": {
+            "get": {
+                "description": "Retrieve the catalogue of extras that can be added to a booking.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "extras"
+                ],
+                "summary": "Retrieve list of extras",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of extras",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Extra"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add an extra priced per day or per booking, with the number the company owns.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "extras"
+                ],
+                "summary": "Create a new extra",
+                "parameters": [
+                    {
+                        "description": "Extra data",
+                        "name": "extra",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputExtra"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created extra",
+                        "schema": {
+                            "$ref": "#/definitions/models.Extra"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/extras/{id}": {
+            "get": {
+                "description": "Retrieve an extra by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "extras"
+                ],
+                "summary": "Retrieve extra by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Extra ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Extra details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Extra"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Extra not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify an extra. New prices apply to bookings made or edited from now on.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "extras"
+                ],
+                "summary": "Update extra",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Extra ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated extra data",
+                        "name": "extra",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputExtra"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated extra",
+                        "schema": {
+                            "$ref": "#/definitions/models.Extra"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Extra not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an extra from the catalogue once no open booking includes it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "extras"
+                ],
+                "summary": "Delete extra by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Extra ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Extra successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Extra not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still on open bookings",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/extras/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted extra by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "extras"
+                ],
+                "summary": "Restore a deleted extra",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Extra ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Extra successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Extra not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/incentive-rules": {
+            "get": {
+                "description": "Retrieve every version of the driver incentive rules, latest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incentive-rules"
+                ],
+                "summary": "Retrieve list of incentive rules",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include retired versions",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of incentive rules",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.IncentiveRule"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Publish a new version of the driver incentive rules: a percentage of the rent, a flat amount per day, a bonus for trips of at least long_trip_min_days days, an extra amount per weekend day, and per-tier overrides of the percentage and daily amount. Rules cannot be edited; publish a new version instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incentive-rules"
+                ],
+                "summary": "Publish a new incentive rule version",
+                "parameters": [
+                    {
+                        "description": "Incentive rule data",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputIncentiveRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created incentive rule",
+                        "schema": {
+                            "$ref": "#/definitions/models.IncentiveRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/incentive-rules/current": {
+            "get": {
+                "description": "Retrieve the version of the incentive rules new bookings with a driver are rewarded under.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incentive-rules"
+                ],
+                "summary": "Retrieve the current incentive rule",
+                "responses": {
+                    "200": {
+                        "description": "Current incentive rule",
+                        "schema": {
+                            "$ref": "#/definitions/models.IncentiveRule"
+                        }
+                    },
+                    "404": {
+                        "description": "No incentive rule in force",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/incentive-rules/{id}": {
+            "get": {
+                "description": "Retrieve one version of the incentive rules by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incentive-rules"
+                ],
+                "summary": "Retrieve incentive rule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Incentive rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Incentive rule details",
+                        "schema": {
+                            "$ref": "#/definitions/models.IncentiveRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Incentive rule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Retire a version of the incentive rules. When it was the current one, the latest version left takes over; with none left, bookings earn no incentive.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incentive-rules"
+                ],
+                "summary": "Retire incentive rule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Incentive rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Incentive rule successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Incentive rule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/incentive-rules/{id}/restore": {
+            "post": {
+                "description": "Bring back a retired version of the incentive rules. It is in force again if no later version is.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incentive-rules"
+                ],
+                "summary": "Restore a retired incentive rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Incentive rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Incentive rule successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Incentive rule not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections": {
+            "get": {
+                "description": "Retrieve all vehicle inspections, optionally only those of one booking.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Retrieve list of inspections",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only inspections of this booking",
+                        "name": "booking_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of inspections",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Inspection"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid booking_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Record the checkout inspection of a picked up booking, or its checkin inspection at return.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Record an inspection",
+                "parameters": [
+                    {
+                        "description": "Inspection data",
+                        "name": "inspection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputInspection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created inspection",
+                        "schema": {
+                            "$ref": "#/definitions/models.Inspection"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Inspection already recorded, or checkout inspection missing",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections/compare/{id}": {
+            "get": {
+                "description": "Show the damage, missing checklist items, distance and fuel change between the checkout and checkin inspections of a booking.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Compare pickup and return of a booking",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comparison",
+                        "schema": {
+                            "$ref": "#/definitions/models.InspectionComparison"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Checkout or checkin inspection missing",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections/compare/{id}/charge": {
+            "post": {
+                "description": "Bill the repair cost of the damage found at return and not present at pickup to the booking, no more than the excess of its insurance plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Charge new damage to the booking",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Booking with its damage charge",
+                        "schema": {
+                            "$ref": "#/definitions/models.Booking"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking cancelled, not returned or already paid, or checkout or checkin inspection missing",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections/{id}": {
+            "get": {
+                "description": "Retrieve an inspection with its damages and images.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Retrieve inspection by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inspection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inspection details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Inspection"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inspection not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an inspection, for example one recorded against the wrong booking.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Delete inspection by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inspection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inspection successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inspection not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections/{id}/images": {
+            "post": {
+                "description": "Upload a JPEG, PNG or WebP photo of up to 10 MB, optionally of one damage of the inspection.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Attach a photo to an inspection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inspection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photo",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Damage the photo shows",
+                        "name": "damage_id",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Stored image",
+                        "schema": {
+                            "$ref": "#/definitions/models.InspectionImage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid upload",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inspection not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections/{id}/images/{image_id}": {
+            "get": {
+                "description": "Return the stored photo file.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/webp"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Download an inspection photo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inspection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Image ID",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Photo",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/inspections/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted inspection by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inspections"
+                ],
+                "summary": "Restore a deleted inspection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inspection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inspection successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Inspection not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking already has a newer inspection of that kind",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/insurance-plans": {
+            "get": {
+                "description": "Retrieve the coverage plans a booking can be insured with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "insurance-plans"
+                ],
+                "summary": "Retrieve list of insurance plans",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of insurance plans",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.InsurancePlan"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a coverage plan with its daily premium and the excess the customer pays of any damage.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "insurance-plans"
+                ],
+                "summary": "Create a new insurance plan",
+                "parameters": [
+                    {
+                        "description": "Insurance plan data",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputInsurancePlan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created insurance plan",
+                        "schema": {
+                            "$ref": "#/definitions/models.InsurancePlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/insurance-plans/{id}": {
+            "get": {
+                "description": "Retrieve an insurance plan by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "insurance-plans"
+                ],
+                "summary": "Retrieve insurance plan by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Insurance plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Insurance plan details",
+                        "schema": {
+                            "$ref": "#/definitions/models.InsurancePlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Insurance plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify an insurance plan. New terms apply to bookings made or edited from now on.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "insurance-plans"
+                ],
+                "summary": "Update insurance plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Insurance plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated insurance plan data",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputInsurancePlan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated insurance plan",
+                        "schema": {
+                            "$ref": "#/definitions/models.InsurancePlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Insurance plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an insurance plan once no car category defaults to it and no open booking is insured with it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "insurance-plans"
+                ],
+                "summary": "Delete insurance plan by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Insurance plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Insurance plan successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Insurance plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still referenced by car categories or open bookings",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/insurance-plans/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted insurance plan by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "insurance-plans"
+                ],
+                "summary": "Restore a deleted insurance plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Insurance plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Insurance plan successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Insurance plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenance": {
+            "get": {
+                "description": "Retrieve all maintenance records, optionally only those of one vehicle.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Retrieve list of maintenance records",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only records of this vehicle",
+                        "name": "vehicle_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of maintenance records",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MaintenanceRecord"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid vehicle_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a maintenance record for a vehicle. Until it is completed the vehicle cannot be booked during the planned window.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Plan or log maintenance",
+                "parameters": [
+                    {
+                        "description": "Maintenance data",
+                        "name": "maintenance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputMaintenanceRecord"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created maintenance record",
+                        "schema": {
+                            "$ref": "#/definitions/models.MaintenanceRecord"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenance/due": {
+            "get": {
+                "description": "List vehicles whose next service (every 10,000 km or 6 months) is due or coming up, overdue ones first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "List vehicles due for service",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Also list services due within this many days (default 14)",
+                        "name": "within_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Also list services due within this many km (default 500)",
+                        "name": "within_km",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vehicles due for service",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MaintenanceDue"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenance/{id}": {
+            "get": {
+                "description": "Retrieve a maintenance record by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Retrieve maintenance record by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Maintenance record details",
+                        "schema": {
+                            "$ref": "#/definitions/models.MaintenanceRecord"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Maintenance record not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify a maintenance record, for example to complete it with the date and odometer reading.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Update maintenance record",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated maintenance data",
+                        "name": "maintenance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputMaintenanceRecord"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated maintenance record",
+                        "schema": {
+                            "$ref": "#/definitions/models.MaintenanceRecord"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Maintenance record not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a maintenance record, which frees its vehicle for the planned window again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Delete maintenance record by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Maintenance record successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Maintenance record not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenance/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted maintenance record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Restore a deleted maintenance record",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Maintenance record successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Maintenance record not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/memberships": {
+            "get": {
+                "description": "Retrieve a list of all memberships.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "memberships"
+                ],
+                "summary": "Retrieve list of memberships",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of memberships",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Membership"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No membership found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new membership.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "memberships"
+                ],
+                "summary": "Create a new membership",
+                "parameters": [
+                    {
+                        "description": "Membership data",
+                        "name": "membership",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputMembership"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created membership data",
+                        "schema": {
+                            "$ref": "#/definitions/models.Membership"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/memberships/{id}": {
+            "get": {
+                "description": "Retrieve a membership by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "memberships"
+                ],
+                "summary": "Retrieve membership by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Membership ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Membership data",
+                        "schema": {
+                            "$ref": "#/definitions/models.Membership"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid membership ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Membership not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update information of a membership.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "memberships"
+                ],
+                "summary": "Update membership information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Membership ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated membership data",
+                        "name": "membership",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputMembership"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated membership data",
+                        "schema": {
+                            "$ref": "#/definitions/models.Membership"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid membership ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Membership not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a membership by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "memberships"
+                ],
+                "summary": "Delete membership by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Membership ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Set to reassign to move customers to another membership first",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Target Membership ID when cascade=reassign",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message and deleted membership data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid membership ID",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Membership not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Still referenced by other records",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/memberships/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted membership by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "memberships"
+                ],
+                "summary": "Restore a deleted membership",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Membership ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Membership successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Membership not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-periods": {
+            "get": {
+                "description": "Retrieve the periods drivers are paid for, latest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Retrieve list of payout periods",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of payout periods",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PayoutPeriod"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Open a period drivers are paid for, from start_date to end_date, both included and in dd/mm/yyyy. Periods may not overlap.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Create a new payout period",
+                "parameters": [
+                    {
+                        "description": "Payout period data",
+                        "name": "period",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputPayoutPeriod"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created payout period",
+                        "schema": {
+                            "$ref": "#/definitions/models.PayoutPeriod"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Overlaps another payout period",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-periods/outstanding": {
+            "get": {
+                "description": "List, per driver, the daily cost and incentives earned on finished bookings that were not paid out yet, largest amount first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Report outstanding driver payouts",
+                "responses": {
+                    "200": {
+                        "description": "Outstanding amounts per driver",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriverOutstanding"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-periods/{id}": {
+            "get": {
+                "description": "Retrieve a payout period by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Retrieve payout period by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payout period ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payout period details",
+                        "schema": {
+                            "$ref": "#/definitions/models.PayoutPeriod"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout period not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a payout period that was not settled yet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Delete payout period by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payout period ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payout period successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout period not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Payout period already settled",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-periods/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted payout period by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Restore a deleted payout period",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payout period ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payout period successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout period not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-periods/{id}/settle": {
+            "post": {
+                "description": "Pay out a period once it has ended. Everything on its statement is marked paid so no other period pays it again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Settle a payout period",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payout period ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statement of what was paid",
+                        "schema": {
+                            "$ref": "#/definitions/models.PayoutStatement"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout period not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Payout period already settled or not ended yet",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-periods/{id}/statement": {
+            "get": {
+                "description": "List what a period pays each driver, booking by booking: the driver's daily cost and the incentives of finished bookings that ended by its end date. Before settlement it shows everything still unpaid, including late items from earlier periods; afterwards, what was paid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payout-periods"
+                ],
+                "summary": "Retrieve the payout statement of a period",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payout period ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payout statement",
+                        "schema": {
+                            "$ref": "#/definitions/models.PayoutStatement"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout period not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers": {
+            "get": {
+                "description": "Retrieve all vehicle transfers between branches, newest first, optionally only those of one vehicle.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Retrieve list of vehicle transfers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only transfers of this vehicle",
+                        "name": "vehicle_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of vehicle transfers",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.VehicleTransfer"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid vehicle_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Plan moving a vehicle from the branch it is at to another branch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Request a vehicle transfer",
+                "parameters": [
+                    {
+                        "description": "Vehicle and destination branch",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputVehicleTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Requested vehicle transfer",
+                        "schema": {
+                            "$ref": "#/definitions/models.VehicleTransfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Vehicle already has an open transfer",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}": {
+            "get": {
+                "description": "Retrieve a vehicle transfer by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Retrieve vehicle transfer by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vehicle transfer details",
+                        "schema": {
+                            "$ref": "#/definitions/models.VehicleTransfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle transfer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/cancel": {
+            "post": {
+                "description": "Call off a transfer that was not received yet; the vehicle stays at its branch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Cancel a vehicle transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cancelled vehicle transfer",
+                        "schema": {
+                            "$ref": "#/definitions/models.VehicleTransfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle transfer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transfer is already completed or cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/receive": {
+            "post": {
+                "description": "Take the vehicle in at the destination branch, where it can be rented from now on.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Receive a vehicle transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Completed vehicle transfer",
+                        "schema": {
+                            "$ref": "#/definitions/models.VehicleTransfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle transfer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transfer is not in transit",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/ship": {
+            "post": {
+                "description": "Send the vehicle on its way. It cannot be rented until it is received.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Ship a vehicle transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vehicle transfer in transit",
+                        "schema": {
+                            "$ref": "#/definitions/models.VehicleTransfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle transfer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transfer is not requested, or vehicle is out on a booking",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles": {
+            "get": {
+                "description": "Retrieve all vehicle units, optionally only those of one car or at one branch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Retrieve list of vehicles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only vehicles of this car",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only vehicles at this branch",
+                        "name": "branch_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include soft-deleted records",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of vehicles",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Vehicle"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid car_id or branch_id",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a physical unit of a car to the fleet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Register a new vehicle",
+                "parameters": [
+                    {
+                        "description": "Vehicle data",
+                        "name": "vehicle",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputVehicle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created vehicle",
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Plate number or VIN already registered",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/{id}": {
+            "get": {
+                "description": "Retrieve a vehicle unit by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Retrieve vehicle by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vehicle details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify details of a vehicle unit, such as grounding it by setting its status to inactive. Its branch changes through a vehicle transfer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Update vehicle information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated vehicle data",
+                        "name": "vehicle",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InputVehicle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated vehicle",
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Plate number or VIN already registered, or vehicle is out on a booking",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a vehicle unit from the fleet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Delete vehicle by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vehicle successfully deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Vehicle is out on a booking or being transferred",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted vehicle by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Restore a deleted vehicle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vehicle successfully restored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid required param",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle not found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.Booking": {
+            "type": "object",
+            "properties": {
+                "book_type_id": {
+                    "type": "integer"
+                },
+                "booking_group_id": {
+                    "type": "integer"
+                },
+                "booking_type": {
+                    "$ref": "#/definitions/models.BookingType"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "car": {
+                    "$ref": "#/definitions/models.Car"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "company": {
+                    "$ref": "#/definitions/models.Company"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer": {
+                    "$ref": "#/definitions/models.Customer"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "damage_charge": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deposit": {
+                    "type": "integer"
+                },
+                "discount": {
+                    "type": "integer"
+                },
+                "driver": {
+                    "$ref": "#/definitions/models.Driver"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "driver_payout_period_id": {
+                    "type": "integer"
+                },
+                "end_rent": {
+                    "type": "string"
+                },
+                "excess_km": {
+                    "type": "integer"
+                },
+                "extras": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingExtra"
+                    }
+                },
+                "extras_cost": {
+                    "type": "integer"
+                },
+                "finished": {
+                    "type": "boolean"
+                },
+                "fuel_charge": {
+                    "type": "integer"
+                },
+                "fuel_policy": {
+                    "type": "string"
+                },
+                "group_discount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "insurance_excess": {
+                    "type": "integer"
+                },
+                "insurance_plan": {
+                    "$ref": "#/definitions/models.InsurancePlan"
+                },
+                "insurance_plan_id": {
+                    "type": "integer"
+                },
+                "insurance_premium": {
+                    "type": "integer"
+                },
+                "km_allowance": {
+                    "type": "integer"
+                },
+                "mileage_charge": {
+                    "type": "integer"
+                },
+                "one_way_fee": {
+                    "type": "integer"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "payment_due_at": {
+                    "type": "string"
+                },
+                "picked_up_at": {
+                    "type": "string"
+                },
+                "pickup_branch": {
+                    "$ref": "#/definitions/models.Branch"
+                },
+                "pickup_branch_id": {
+                    "type": "integer"
+                },
+                "pickup_fuel_level": {
+                    "type": "integer"
+                },
+                "pickup_odometer": {
+                    "type": "integer"
+                },
+                "return_branch": {
+                    "$ref": "#/definitions/models.Branch"
+                },
+                "return_branch_id": {
+                    "type": "integer"
+                },
+                "return_fuel_level": {
+                    "type": "integer"
+                },
+                "return_odometer": {
+                    "type": "integer"
+                },
+                "returned_at": {
+                    "type": "string"
+                },
+                "risk_override_by": {
+                    "type": "string"
+                },
+                "risk_override_reason": {
+                    "type": "string"
+                },
+                "start_rent": {
+                    "type": "string"
+                },
+                "total_cost": {
+                    "type": "integer"
+                },
+                "total_driver_cost": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "vehicle": {
+                    "$ref": "#/definitions/models.Vehicle"
+                },
+                "vehicle_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BookingExtra": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "extra": {
+                    "$ref": "#/definitions/models.Extra"
+                },
+                "extra_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "pricing": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingGroup": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
+                "cars": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer": {
+                    "$ref": "#/definitions/models.Customer"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deposit": {
+                    "type": "integer"
+                },
+                "discount_percentage": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingRating": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "car_rating": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "driver_rating": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "overall_rating": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingSettlement": {
+            "type": "object",
+            "properties": {
+                "balance_due": {
+                    "type": "integer"
+                },
+                "booking_id": {
+                    "type": "integer"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "damage_charge": {
+                    "type": "integer"
+                },
+                "deposit": {
+                    "type": "integer"
+                },
+                "discount": {
+                    "type": "integer"
+                },
+                "driver_cost": {
+                    "type": "integer"
+                },
+                "excess_km": {
+                    "type": "integer"
+                },
+                "extras": {
+                    "type": "integer"
+                },
+                "final": {
+                    "type": "boolean"
+                },
+                "fuel_charge": {
+                    "type": "integer"
+                },
+                "fuel_policy": {
+                    "type": "string"
+                },
+                "group_discount": {
+                    "type": "integer"
+                },
+                "insurance": {
+                    "type": "integer"
+                },
+                "km_allowance": {
+                    "type": "integer"
+                },
+                "km_driven": {
+                    "type": "integer"
+                },
+                "mileage_charge": {
+                    "type": "integer"
+                },
+                "one_way_fee": {
+                    "type": "integer"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "payment_due_at": {
+                    "type": "string"
+                },
+                "rent": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BookingType": {
+            "type": "object",
+            "properties": {
+                "allows_driver": {
+                    "type": "boolean"
+                },
+                "booking_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deposit_percentage": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_days": {
+                    "type": "integer"
+                },
+                "min_days": {
+                    "type": "integer"
+                },
+                "price_multiplier": {
+                    "type": "number"
+                },
+                "requires_driver": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Branch": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "one_way_fee": {
+                    "type": "integer"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Car": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string"
+                },
+                "category": {
+                    "$ref": "#/definitions/models.CarCategory"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_rent": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "fuel_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "luggage": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rating_average": {
+                    "type": "number"
+                },
+                "rating_count": {
+                    "type": "integer"
+                },
+                "seats": {
+                    "type": "integer"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "transmission": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CarCategory": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_km_allowance": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "excess_km_rate": {
+                    "type": "integer"
+                },
+                "fuel_policy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "insurance_plan": {
+                    "$ref": "#/definitions/models.InsurancePlan"
+                },
+                "insurance_plan_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prepaid_fuel_price": {
+                    "type": "integer"
+                },
+                "refuel_rate": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Company": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "billing_contact": {
+                    "type": "string"
+                },
+                "billing_email": {
+                    "type": "string"
+                },
+                "billing_phone": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "npwp": {
+                    "type": "string"
+                },
+                "payment_terms_days": {
+                    "type": "integer"
+                },
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CompanyRate"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CompanyRate": {
+            "type": "object",
+            "properties": {
+                "car": {
+                    "$ref": "#/definitions/models.Car"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_rent": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CompanyStatement": {
+            "type": "object",
+            "properties": {
+                "billing_contact": {
+                    "type": "string"
+                },
+                "billing_email": {
+                    "type": "string"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "credit_limit": {
+                    "type": "integer"
+                },
+                "credit_used": {
+                    "type": "integer"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CompanyStatementLine"
+                    }
+                },
+                "month": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "npwp": {
+                    "type": "string"
+                },
+                "outstanding": {
+                    "type": "integer"
+                },
+                "paid": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CompanyStatementLine": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "car_name": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "end_rent": {
+                    "type": "string"
+                },
+                "final": {
+                    "type": "boolean"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "payment_due_at": {
+                    "type": "string"
+                },
+                "start_rent": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Customer": {
+            "type": "object",
+            "properties": {
+                "birth_date": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "membership": {
+                    "$ref": "#/definitions/models.Membership"
+                },
+                "membership_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nik": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "risk_expires_at": {
+                    "type": "string"
+                },
+                "risk_flagged_at": {
+                    "type": "string"
+                },
+                "risk_flagged_by": {
+                    "type": "string"
+                },
+                "risk_reason": {
+                    "type": "string"
+                },
+                "risk_reviewed_at": {
+                    "type": "string"
+                },
+                "risk_status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Driver": {
+            "type": "object",
+            "properties": {
+                "birth_date": {
+                    "type": "string"
+                },
+                "branch_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_cost": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incentive_tier": {
+                    "type": "string"
+                },
+                "license_class": {
+                    "type": "string"
+                },
+                "license_expiry": {
+                    "type": "string"
+                },
+                "license_number": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nik": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "rating_average": {
+                    "type": "number"
+                },
+                "rating_count": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriverDocument": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "driver": {
+                    "$ref": "#/definitions/models.Driver"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriverExpiry": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "integer"
+                },
+                "days_left": {
+                    "type": "integer"
+                },
+                "document": {
+                    "type": "string"
+                },
+                "document_id": {
+                    "type": "integer"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "driver_name": {
+                    "type": "string"
+                },
+                "expired": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "missing": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriverIncentive": {
+            "type": "object",
+            "properties": {
+                "booking": {
+                    "$ref": "#/definitions/models.Booking"
+                },
+                "booking_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incentive": {
+                    "type": "integer"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "payout_period_id": {
+                    "type": "integer"
+                },
+                "rule_version": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriverLeave": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "driver": {
+                    "$ref": "#/definitions/models.Driver"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriverOutstanding": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "integer"
+                },
+                "driver_earnings": {
+                    "type": "integer"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "driver_name": {
+                    "type": "string"
+                },
+                "incentives": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DriverPayout": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PayoutBooking"
+                    }
+                },
+                "driver_earnings": {
+                    "type": "integer"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "driver_name": {
+                    "type": "string"
+                },
+                "incentives": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DriverSchedule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "driver": {
+                    "$ref": "#/definitions/models.Driver"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DriverStats": {
+            "type": "object",
+            "properties": {
+                "available_days": {
+                    "type": "integer"
+                },
+                "average_rating": {
+                    "type": "number"
+                },
+                "cancellations": {
+                    "type": "integer"
+                },
+                "days_driven": {
+                    "type": "integer"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "rating_count": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_incentive": {
+                    "type": "integer"
+                },
+                "trips_completed": {
+                    "type": "integer"
+                },
+                "utilization_percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Extra": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "pricing": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.IncentiveRule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_flat": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "long_trip_bonus": {
+                    "type": "integer"
+                },
+                "long_trip_min_days": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rating_bonus_min": {
+                    "type": "number"
+                },
+                "rating_bonus_min_count": {
+                    "type": "integer"
+                },
+                "rating_bonus_percentage": {
+                    "type": "integer"
+                },
+                "rent_percentage": {
+                    "type": "integer"
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IncentiveRuleTier"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "weekend_daily_bonus": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.IncentiveRuleTier": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_flat": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incentive_rule_id": {
+                    "type": "integer"
+                },
+                "rent_percentage": {
+                    "type": "integer"
+                },
+                "tier": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputBooking": {
+            "type": "object",
+            "required": [
+                "car_id",
+                "customer_id",
+                "end_rent",
+                "pickup_branch_id",
+                "start_rent"
+            ],
+            "properties": {
+                "book_type_id": {
+                    "type": "integer"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_rent": {
+                    "type": "string"
+                },
+                "extras": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InputBookingExtra"
+                    }
+                },
+                "finished": {
+                    "type": "boolean"
+                },
+                "insurance_plan_id": {
+                    "type": "integer"
+                },
+                "pickup_branch_id": {
+                    "type": "integer"
+                },
+                "return_branch_id": {
+                    "type": "integer"
+                },
+                "risk_override": {
+                    "$ref": "#/definitions/models.InputRiskOverride"
+                },
+                "start_rent": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputBookingExtra": {
+            "type": "object",
+            "required": [
+                "extra_id",
+                "quantity"
+            ],
+            "properties": {
+                "extra_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputBookingGroup": {
+            "type": "object",
+            "required": [
+                "customer_id",
+                "lines"
+            ],
+            "properties": {
+                "company_id": {
+                    "type": "integer"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "lines": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.InputBookingLine"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "models.InputBookingLine": {
+            "type": "object",
+            "required": [
+                "car_id",
+                "end_rent",
+                "pickup_branch_id",
+                "start_rent"
+            ],
+            "properties": {
+                "book_type_id": {
+                    "type": "integer"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_rent": {
+                    "type": "string"
+                },
+                "extras": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InputBookingExtra"
+                    }
+                },
+                "insurance_plan_id": {
+                    "type": "integer"
+                },
+                "pickup_branch_id": {
+                    "type": "integer"
+                },
+                "return_branch_id": {
+                    "type": "integer"
+                },
+                "start_rent": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputBookingRating": {
+            "type": "object",
+            "required": [
+                "booking_id",
+                "car_rating",
+                "overall_rating"
+            ],
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "car_rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "driver_rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "overall_rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "models.InputBookingType": {
+            "type": "object",
+            "required": [
+                "booking_type",
+                "description"
+            ],
+            "properties": {
+                "allows_driver": {
+                    "type": "boolean"
+                },
+                "booking_type": {
+                    "type": "string"
+                },
+                "deposit_percentage": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "description": {
+                    "type": "string"
+                },
+                "max_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "min_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "price_multiplier": {
+                    "type": "number"
+                },
+                "requires_driver": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.InputBranch": {
+            "type": "object",
+            "required": [
+                "city",
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "one_way_fee": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "models.InputCar": {
+            "type": "object",
+            "required": [
+                "daily_rent",
+                "name"
+            ],
+            "properties": {
+                "brand": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "daily_rent": {
+                    "type": "integer"
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "enum": [
+                        "petrol",
+                        "diesel",
+                        "electric",
+                        "hybrid"
+                    ]
+                },
+                "luggage": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string"
+                },
+                "seats": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "transmission": {
+                    "type": "string",
+                    "enum": [
+                        "manual",
+                        "automatic"
+                    ]
+                },
+                "year": {
+                    "type": "integer",
+                    "minimum": 1900
+                }
+            }
+        },
+        "models.InputCarCategory": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "daily_km_allowance": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "description": {
+                    "type": "string"
+                },
+                "excess_km_rate": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "fuel_policy": {
+                    "type": "string",
+                    "enum": [
+                        "full_to_full",
+                        "prepaid"
+                    ]
+                },
+                "insurance_plan_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "prepaid_fuel_price": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "refuel_rate": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "models.InputCompany": {
+            "type": "object",
+            "required": [
+                "billing_contact",
+                "billing_email",
+                "credit_limit",
+                "name",
+                "npwp"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "billing_contact": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "billing_email": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "billing_phone": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "credit_limit": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "npwp": {
+                    "type": "string"
+                },
+                "payment_terms_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InputCompanyRate"
+                    }
+                }
+            }
+        },
+        "models.InputCompanyRate": {
+            "type": "object",
+            "required": [
+                "car_id",
+                "daily_rent"
+            ],
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "daily_rent": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputCustomer": {
+            "type": "object",
+            "required": [
+                "name",
+                "nik",
+                "phone"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "nik": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputCustomerRisk": {
+            "type": "object",
+            "required": [
+                "flagged_by",
+                "reason",
+                "status"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "flagged_by": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "flagged",
+                        "blacklisted"
+                    ]
+                }
+            }
+        },
+        "models.InputDamage": {
+            "type": "object",
+            "required": [
+                "location",
+                "severity"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "repair_cost": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "severity": {
+                    "type": "string",
+                    "enum": [
+                        "minor",
+                        "moderate",
+                        "major"
+                    ]
+                }
+            }
+        },
+        "models.InputDriver": {
+            "type": "object",
+            "required": [
+                "branch_id",
+                "daily_cost",
+                "name",
+                "nik",
+                "phone"
+            ],
+            "properties": {
+                "branch_id": {
+                    "type": "integer"
+                },
+                "daily_cost": {
+                    "type": "integer"
+                },
+                "incentive_tier": {
+                    "type": "string",
+                    "maxLength": 30
+                },
+                "license_class": {
+                    "type": "string",
+                    "enum": [
+                        "A",
+                        "B1",
+                        "B2"
+                    ]
+                },
+                "license_expiry": {
+                    "type": "string"
+                },
+                "license_number": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nik": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputDriverDocument": {
+            "type": "object",
+            "required": [
+                "driver_id",
+                "expires_at",
+                "type"
+            ],
+            "properties": {
+                "driver_id": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "health_certificate",
+                        "police_clearance",
+                        "other"
+                    ]
+                }
+            }
+        },
+        "models.InputDriverIncentive": {
+            "type": "object",
+            "required": [
+                "booking_id",
+                "incentive"
+            ],
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "incentive": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputDriverLeave": {
+            "type": "object",
+            "required": [
+                "driver_id",
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputDriverSchedule": {
+            "type": "object",
+            "required": [
+                "driver_id",
+                "end_time",
+                "start_time",
+                "weekday"
+            ],
+            "properties": {
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0
+                }
+            }
+        },
+        "models.InputExtra": {
+            "type": "object",
+            "required": [
+                "name",
+                "pricing"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "price": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "pricing": {
+                    "type": "string",
+                    "enum": [
+                        "per_day",
+                        "per_booking"
+                    ]
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "models.InputIncentiveRule": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "daily_flat": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "long_trip_bonus": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "long_trip_min_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "rating_bonus_min": {
+                    "type": "number",
+                    "maximum": 5,
+                    "minimum": 0
+                },
+                "rating_bonus_min_count": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "rating_bonus_percentage": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "rent_percentage": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InputIncentiveRuleTier"
+                    }
+                },
+                "weekend_daily_bonus": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "models.InputIncentiveRuleTier": {
+            "type": "object",
+            "required": [
+                "tier"
+            ],
+            "properties": {
+                "daily_flat": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "rent_percentage": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "tier": {
+                    "type": "string",
+                    "maxLength": 30
+                }
+            }
+        },
+        "models.InputInspection": {
+            "type": "object",
+            "required": [
+                "booking_id",
+                "fuel_level",
+                "kind",
+                "odometer"
+            ],
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "checklist": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "damages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InputDamage"
+                    }
+                },
+                "fuel_level": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "checkout",
+                        "checkin"
+                    ]
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "odometer": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputInsurancePlan": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "daily_premium": {
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "description": {
                     "type": "string"
                 },
-                "id": {
+                "excess": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "models.InputMaintenanceRecord": {
+            "type": "object",
+            "required": [
+                "planned_end",
+                "planned_start",
+                "type",
+                "vehicle_id"
+            ],
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "cost": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "odometer": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "planned_end": {
+                    "type": "string"
+                },
+                "planned_start": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "service",
+                        "repair",
+                        "tyres",
+                        "inspection",
+                        "other"
+                    ]
+                },
+                "vehicle_id": {
+                    "type": "integer"
+                },
+                "workshop": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "models.InputMembership": {
+            "type": "object",
+            "required": [
+                "discount",
+                "membership_name"
+            ],
+            "properties": {
+                "discount": {
+                    "type": "integer"
+                },
+                "membership_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputMembershipID": {
+            "type": "object",
+            "required": [
+                "membership_id"
+            ],
+            "properties": {
+                "membership_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputPayoutPeriod": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.InputPickup": {
+            "type": "object",
+            "properties": {
+                "fuel_level": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "vehicle_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputReturn": {
+            "type": "object",
+            "required": [
+                "fuel_level",
+                "odometer"
+            ],
+            "properties": {
+                "fuel_level": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "odometer": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InputRiskOverride": {
+            "type": "object",
+            "required": [
+                "approved_by",
+                "reason"
+            ],
+            "properties": {
+                "approved_by": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "models.InputVehicle": {
+            "type": "object",
+            "required": [
+                "branch_id",
+                "car_id",
+                "plate_number",
+                "vin",
+                "year"
+            ],
+            "properties": {
+                "branch_id": {
+                    "type": "integer"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "color": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "odometer": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "plate_number": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "inactive",
+                        "retired"
+                    ]
+                },
+                "vin": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer",
+                    "minimum": 1900
+                }
+            }
+        },
+        "models.InputVehicleTransfer": {
+            "type": "object",
+            "required": [
+                "to_branch_id",
+                "vehicle_id"
+            ],
+            "properties": {
+                "notes": {
+                    "type": "string"
+                },
+                "to_branch_id": {
+                    "type": "integer"
+                },
+                "vehicle_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Inspection": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "checklist": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "damages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InspectionDamage"
+                    }
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "fuel_level": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InspectionImage"
+                    }
+                },
+                "inspected_at": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "odometer": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "vehicle_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.InspectionComparison": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "integer"
+                },
+                "checkin": {
+                    "$ref": "#/definitions/models.Inspection"
+                },
+                "checkout": {
+                    "$ref": "#/definitions/models.Inspection"
+                },
+                "damage_charge": {
+                    "type": "integer"
+                },
+                "distance": {
+                    "type": "integer"
+                },
+                "fuel_difference": {
+                    "type": "integer"
+                },
+                "missing_items": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "new_damages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.InspectionDamage"
+                    }
+                },
+                "repair_cost": {
                     "type": "integer"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "models.Car": {
+        "models.InspectionDamage": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "daily_rent": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "inspection_id": {
+                    "type": "integer"
+                },
+                "location": {
                     "type": "string"
                 },
-                "stock": {
+                "repair_cost": {
                     "type": "integer"
                 },
+                "severity": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.Customer": {
+        "models.InspectionImage": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "content_type": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "membership": {
-                    "$ref": "#/definitions/models.Membership"
+                "created_at": {
+                    "type": "string"
                 },
-                "membership_id": {
+                "damage_id": {
                     "type": "integer"
                 },
-                "name": {
+                "file_name": {
                     "type": "string"
                 },
-                "nik": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "phone": {
-                    "type": "string"
+                "inspection_id": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "size": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Driver": {
+        "models.InsurancePlan": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "daily_cost": {
-                    "type": "integer"
-                },
-                "id": {
+                "daily_premium": {
                     "type": "integer"
                 },
-                "name": {
+                "deleted_at": {
                     "type": "string"
                 },
-                "nik": {
+                "description": {
                     "type": "string"
                 },
-                "phone": {
+                "excess": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -2018,211 +10386,295 @@ const docTemplate = `{
                 }
             }
         },
-        "models.DriverIncentive": {
+        "models.MaintenanceDue": {
             "type": "object",
             "properties": {
-                "booking": {
-                    "$ref": "#/definitions/models.Booking"
+                "due_at": {
+                    "type": "string"
                 },
-                "booking_id": {
+                "due_odometer": {
                     "type": "integer"
                 },
-                "created_at": {
+                "last_service_at": {
                     "type": "string"
                 },
-                "id": {
+                "last_service_odometer": {
                     "type": "integer"
                 },
-                "incentive": {
-                    "type": "integer"
+                "overdue": {
+                    "type": "boolean"
                 },
-                "updated_at": {
-                    "type": "string"
+                "scheduled": {
+                    "type": "boolean"
+                },
+                "vehicle": {
+                    "$ref": "#/definitions/models.Vehicle"
                 }
             }
         },
-        "models.InputBooking": {
+        "models.MaintenanceRecord": {
             "type": "object",
-            "required": [
-                "car_id",
-                "customer_id",
-                "end_rent",
-                "start_rent"
-            ],
             "properties": {
-                "book_type_id": {
-                    "type": "integer"
+                "completed_at": {
+                    "type": "string"
                 },
-                "car_id": {
+                "cost": {
                     "type": "integer"
                 },
-                "customer_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "driver_id": {
+                "notes": {
+                    "type": "string"
+                },
+                "odometer": {
                     "type": "integer"
                 },
-                "end_rent": {
+                "planned_end": {
                     "type": "string"
                 },
-                "finished": {
-                    "type": "boolean"
+                "planned_start": {
+                    "type": "string"
                 },
-                "start_rent": {
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "vehicle": {
+                    "$ref": "#/definitions/models.Vehicle"
+                },
+                "vehicle_id": {
+                    "type": "integer"
+                },
+                "workshop": {
                     "type": "string"
                 }
             }
         },
-        "models.InputBookingType": {
+        "models.Membership": {
             "type": "object",
-            "required": [
-                "booking_type",
-                "description"
-            ],
             "properties": {
-                "booking_type": {
+                "created_at": {
                     "type": "string"
                 },
-                "description": {
+                "deleted_at": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "membership_name": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.InputCar": {
+        "models.PayoutBooking": {
             "type": "object",
-            "required": [
-                "daily_rent",
-                "name",
-                "stock"
-            ],
             "properties": {
-                "daily_rent": {
+                "booking_id": {
                     "type": "integer"
                 },
-                "name": {
+                "driver_earnings": {
+                    "type": "integer"
+                },
+                "end_rent": {
                     "type": "string"
                 },
-                "stock": {
+                "incentives": {
                     "type": "integer"
+                },
+                "start_rent": {
+                    "type": "string"
                 }
             }
         },
-        "models.InputCustomer": {
+        "models.PayoutPeriod": {
             "type": "object",
-            "required": [
-                "name",
-                "nik",
-                "phone"
-            ],
             "properties": {
-                "name": {
+                "created_at": {
                     "type": "string"
                 },
-                "nik": {
+                "deleted_at": {
                     "type": "string"
                 },
-                "phone": {
+                "end_date": {
                     "type": "string"
-                }
-            }
-        },
-        "models.InputDriver": {
-            "type": "object",
-            "required": [
-                "daily_cost",
-                "name",
-                "nik",
-                "phone"
-            ],
-            "properties": {
-                "daily_cost": {
+                },
+                "id": {
                     "type": "integer"
                 },
-                "name": {
+                "settled_at": {
                     "type": "string"
                 },
-                "nik": {
+                "start_date": {
                     "type": "string"
                 },
-                "phone": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.InputDriverIncentive": {
+        "models.PayoutStatement": {
             "type": "object",
-            "required": [
-                "booking_id",
-                "incentive"
-            ],
             "properties": {
-                "booking_id": {
+                "driver_earnings": {
                     "type": "integer"
                 },
-                "incentive": {
+                "drivers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DriverPayout"
+                    }
+                },
+                "incentives": {
+                    "type": "integer"
+                },
+                "period": {
+                    "$ref": "#/definitions/models.PayoutPeriod"
+                },
+                "total": {
                     "type": "integer"
                 }
             }
         },
-        "models.InputMembership": {
+        "models.Vehicle": {
             "type": "object",
-            "required": [
-                "discount",
-                "membership_name"
-            ],
             "properties": {
-                "discount": {
+                "branch": {
+                    "$ref": "#/definitions/models.Branch"
+                },
+                "branch_id": {
                     "type": "integer"
                 },
-                "membership_name": {
+                "car": {
+                    "$ref": "#/definitions/models.Car"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "color": {
                     "type": "string"
-                }
-            }
-        },
-        "models.InputMembershipID": {
-            "type": "object",
-            "required": [
-                "membership_id"
-            ],
-            "properties": {
-                "membership_id": {
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "odometer": {
+                    "type": "integer"
+                },
+                "plate_number": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "vin": {
+                    "type": "string"
+                },
+                "year": {
                     "type": "integer"
                 }
             }
         },
-        "models.Membership": {
+        "models.VehicleTransfer": {
             "type": "object",
             "properties": {
+                "cancelled_at": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "discount": {
+                "from_branch": {
+                    "$ref": "#/definitions/models.Branch"
+                },
+                "from_branch_id": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "membership_name": {
+                "notes": {
+                    "type": "string"
+                },
+                "received_at": {
                     "type": "string"
                 },
+                "requested_at": {
+                    "type": "string"
+                },
+                "shipped_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "to_branch": {
+                    "$ref": "#/definitions/models.Branch"
+                },
+                "to_branch_id": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "vehicle": {
+                    "$ref": "#/definitions/models.Vehicle"
+                },
+                "vehicle_id": {
+                    "type": "integer"
                 }
             }
         },
         "pkg.ErrorResponse": {
             "type": "object",
             "properties": {
+                "code": {
+                    "type": "string"
+                },
                 "errors": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pkg.FieldError"
                     }
                 },
                 "message": {
                     "type": "string"
                 }
             }
+        },
+        "pkg.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookingGroupHandler interface {
+	GetBookingGroups(ctx *gin.Context)
+	GetBookingGroupByID(ctx *gin.Context)
+	CreateBookingGroup(ctx *gin.Context)
+	CancelBookingGroupLine(ctx *gin.Context)
+}
+
+type bookingGroupHandlerImpl struct {
+	bookingservice service.Bookingservice
+}
+
+func NewBookingGroupHandler(bookingservice service.Bookingservice) BookingGroupHandler {
+	return &bookingGroupHandlerImpl{bookingservice: bookingservice}
+}
+
+// GetBookingGroups godoc
+// @Summary Retrieve list of booking groups
+// @Description Retrieve all multi-car orders with their bookings and combined totals.
+// @Tags booking-groups
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.BookingGroup "List of booking groups"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-groups [get]
+func (p *bookingGroupHandlerImpl) GetBookingGroups(ctx *gin.Context) {
+	groups, err := p.bookingservice.GetBookingGroups(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(groups) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No booking group found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, groups)
+}
+
+// GetBookingGroupByID godoc
+// @Summary Retrieve booking group by ID
+// @Description Retrieve a multi-car order with its bookings and combined totals.
+// @Tags booking-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking group ID"
+// @Success 200 {object} models.BookingGroup "Booking group details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking group not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-groups/{id} [get]
+func (p *bookingGroupHandlerImpl) GetBookingGroupByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	group, err := p.bookingservice.GetBookingGroupsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, group)
+}
+
+// CreateBookingGroup godoc
+// @Summary Create a booking group
+// @Description Book several cars, each with an optional driver, for one customer, optionally billed to a company. Either every line is available and booked or none is. Groups of 3, 5 and 10 cars get 5, 10 and 15 percent off the rent.
+// @Tags booking-groups
+// @Accept json
+// @Produce json
+// @Param group body models.InputBookingGroup true "Booking group data"
+// @Success 201 {object} models.BookingGroup "Created booking group"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "A line's car or extra is not available, or company over its credit limit"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-groups [post]
+func (p *bookingGroupHandlerImpl) CreateBookingGroup(ctx *gin.Context) {
+	group := models.InputBookingGroup{}
+	if err := bindJSON(ctx, &group); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdGroup, err := p.bookingservice.CreateBookingGroup(ctx, group)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdGroup)
+}
+
+// CancelBookingGroupLine godoc
+// @Summary Cancel one booking of a group
+// @Description Cancel a booking of the group that was not picked up yet, dropping its unpaid driver incentive. The bookings left get the discount of the smaller group.
+// @Tags booking-groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking group ID"
+// @Param booking_id path int true "Booking ID"
+// @Success 200 {object} models.BookingGroup "Booking group after the cancellation"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking group or booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking already cancelled, picked up or finished"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-groups/{id}/bookings/{booking_id}/cancel [post]
+func (p *bookingGroupHandlerImpl) CancelBookingGroupLine(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	bookingID, err := pathParamID(ctx, "booking_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	group, err := p.bookingservice.CancelBookingGroupLine(ctx, id, bookingID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, group)
+}
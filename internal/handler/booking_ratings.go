@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookingRatingHandler interface {
+	GetBookingRatings(ctx *gin.Context)
+	GetBookingRatingByID(ctx *gin.Context)
+	DeleteBookingRatingByID(ctx *gin.Context)
+	CreateBookingRating(ctx *gin.Context)
+	EditBookingRating(ctx *gin.Context)
+	RestoreBookingRatingByID(ctx *gin.Context)
+}
+
+type bookingRatingHandlerImpl struct {
+	bookingRatingservice service.BookingRatingservice
+}
+
+func NewBookingRatingHandler(bookingRatingservice service.BookingRatingservice) BookingRatingHandler {
+	return &bookingRatingHandlerImpl{bookingRatingservice: bookingRatingservice}
+}
+
+// GetBookingRatings godoc
+// @Summary Retrieve list of booking ratings
+// @Description Retrieve customers' ratings of finished bookings, latest first, optionally only those of one booking, car or driver.
+// @Tags booking-ratings
+// @Accept json
+// @Produce json
+// @Param booking_id query int false "Only the rating of this booking"
+// @Param car_id query int false "Only ratings of this car"
+// @Param driver_id query int false "Only ratings of this driver"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.BookingRating "List of booking ratings"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid query parameters"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-ratings [get]
+func (p *bookingRatingHandlerImpl) GetBookingRatings(ctx *gin.Context) {
+	var filter models.BookingRatingFilter
+	if err := bindQuery(ctx, &filter); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ratings, err := p.bookingRatingservice.GetBookingRatings(ctx, filter)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(ratings) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No booking rating found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, ratings)
+}
+
+// GetBookingRatingByID godoc
+// @Summary Retrieve booking rating by ID
+// @Description Retrieve a customer's rating of a booking by its unique ID.
+// @Tags booking-ratings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking rating ID"
+// @Success 200 {object} models.BookingRating "Booking rating details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking rating not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-ratings/{id} [get]
+func (p *bookingRatingHandlerImpl) GetBookingRatingByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	rating, err := p.bookingRatingservice.GetBookingRatingsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating)
+}
+
+// DeleteBookingRatingByID godoc
+// @Summary Delete booking rating by ID
+// @Description Remove a rating; the averages of its car and driver no longer count it.
+// @Tags booking-ratings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking rating ID"
+// @Success 200 {object} map[string]any "Booking rating successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking rating not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-ratings/{id} [delete]
+func (p *bookingRatingHandlerImpl) DeleteBookingRatingByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	rating, err := p.bookingRatingservice.DeleteBookingRating(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"booking_rating": rating,
+		"message":        "Your booking rating has been successfully deleted",
+	})
+}
+
+// CreateBookingRating godoc
+// @Summary Rate a finished booking
+// @Description Record the customer's rating, from 1 to 5, of the car, the driver if the booking had one, and the trip overall. Each booking is rated once.
+// @Tags booking-ratings
+// @Accept json
+// @Produce json
+// @Param rating body models.InputBookingRating true "Booking rating data"
+// @Success 201 {object} models.BookingRating "Created booking rating"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "Booking already rated"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-ratings [post]
+func (p *bookingRatingHandlerImpl) CreateBookingRating(ctx *gin.Context) {
+	rating := models.InputBookingRating{}
+	if err := bindJSON(ctx, &rating); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdRating, err := p.bookingRatingservice.CreateBookingRating(ctx, rating)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdRating)
+}
+
+// EditBookingRating godoc
+// @Summary Update booking rating
+// @Description Change the scores or comment of a rating. It stays with the booking it was given for.
+// @Tags booking-ratings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking rating ID"
+// @Param rating body models.InputBookingRating true "Updated booking rating data"
+// @Success 200 {object} models.BookingRating "Updated booking rating"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Booking rating not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-ratings/{id} [put]
+func (p *bookingRatingHandlerImpl) EditBookingRating(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	rating, err := p.bookingRatingservice.GetBookingRatingsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputRating := models.InputBookingRating{}
+	inputRating.BookingID = rating.BookingID
+	inputRating.CarRating = rating.CarRating
+	inputRating.DriverRating = rating.DriverRating
+	inputRating.OverallRating = rating.OverallRating
+	inputRating.Comment = rating.Comment
+	if err := bindJSON(ctx, &inputRating); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedRating, err := p.bookingRatingservice.EditBookingRating(ctx, id, inputRating)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedRating)
+}
+
+// RestoreBookingRatingByID godoc
+// @Summary Restore a deleted booking rating
+// @Description Bring back a soft-deleted booking rating by its ID, unless the booking was rated again since.
+// @Tags booking-ratings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking rating ID"
+// @Success 200 {object} map[string]any "Booking rating successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking rating not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking already rated"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /booking-ratings/{id}/restore [post]
+func (p *bookingRatingHandlerImpl) RestoreBookingRatingByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	rating, err := p.bookingRatingservice.RestoreBookingRating(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"booking_rating": rating,
+		"message":        "Your booking rating has been successfully restored",
+	})
+}
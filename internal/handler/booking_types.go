@@ -2,11 +2,9 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +15,7 @@ type BookingTypeHandler interface {
 	DeleteBookingTypeByID(ctx *gin.Context)
 	CreateBookingType(ctx *gin.Context)
 	EditBookingType(ctx *gin.Context)
+	RestoreBookingTypeByID(ctx *gin.Context)
 }
 
 type bookingTypeHandlerImpl struct {
@@ -32,14 +31,15 @@ func NewBookingTypeHandler(bookingTypeservice service.BookingTypeservice) Bookin
 // @Tags bookingTypes
 // @Accept json
 // @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success	200	{object} models.BookingType "List of bookingTypes"
 // @Success 404 {object} pkg.ErrorResponse "No bookingType found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /bookingtypes [get]
 func (p *bookingTypeHandlerImpl) GetBookingTypes(ctx *gin.Context) {
-	bookingTypes, err := p.bookingTypeservice.GetBookingTypes(ctx)
+	bookingTypes, err := p.bookingTypeservice.GetBookingTypes(ctx, includeDeleted(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(bookingTypes) == 0 {
@@ -62,19 +62,15 @@ func (p *bookingTypeHandlerImpl) GetBookingTypes(ctx *gin.Context) {
 // @Router /bookingtypes/{id} [get]
 func (p *bookingTypeHandlerImpl) GetBookingTypeByID(ctx *gin.Context) {
 	// get bookingType ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid bookingType ID"})
+		ctx.Error(err)
 		return
 	}
 
 	bookingType, err := p.bookingTypeservice.GetBookingTypesByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if bookingType.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "bookingType not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -87,33 +83,35 @@ func (p *bookingTypeHandlerImpl) GetBookingTypeByID(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "BookingType ID"
+// @Param cascade query string false "Set to reassign to move bookings to another bookingType first" Enums(reassign)
+// @Param to query int false "Target BookingType ID when cascade=reassign"
 // @Success	200	{object} models.BookingType
 // @Failure 404 {object} pkg.ErrorResponse "BookingType not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by other records"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /bookingtypes/{id} [delete]
 func (p *bookingTypeHandlerImpl) DeleteBookingTypeByID(ctx *gin.Context) {
 	// Get bookingType ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	// Delete bookingType by ID
-	bookingType, err := p.bookingTypeservice.DeleteBookingType(ctx, uint64(id))
-
+	reassignTo, err := reassignTarget(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
-	// Check if the bookingType exists
-	if bookingType.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "BookingType not found"})
+	// Delete bookingType by ID
+	bookingType, err := p.bookingTypeservice.DeleteBookingType(ctx, id, reassignTo)
+
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"bookingType":    bookingType,
 		"message": "Your bookingType has been successfully deleted",
@@ -132,14 +130,14 @@ func (p *bookingTypeHandlerImpl) DeleteBookingTypeByID(ctx *gin.Context) {
 // @Router /bookingtypes [post]
 func (p *bookingTypeHandlerImpl) CreateBookingType(ctx *gin.Context) {
 	bookingType := models.InputBookingType{}
-	if err := ctx.BindJSON(&bookingType); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
+	if err := bindJSON(ctx, &bookingType); err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	createdBookingType, err := p.bookingTypeservice.CreateBookingType(ctx, bookingType)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -160,35 +158,61 @@ func (p *bookingTypeHandlerImpl) CreateBookingType(ctx *gin.Context) {
 // @Router /bookingtypes/{id} [put]
 func (p *bookingTypeHandlerImpl) EditBookingType(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	bookingType, err := p.bookingTypeservice.GetBookingTypesByID(ctx, uint64(id))
+	bookingType, err := p.bookingTypeservice.GetBookingTypesByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-        return
-    }
-    if bookingType.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "BookingType not found"})
+        ctx.Error(err)
         return
     }
 
-    
-    if err := ctx.ShouldBindJSON(&bookingType); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+    if err := bindJSON(ctx, &bookingType); err != nil {
+        ctx.Error(err)
         return
     }
 	inputBookingType := models.InputBookingType{}
 	inputBookingType.BookingType = bookingType.BookingType
 	inputBookingType.Description = bookingType.Description
 
-    updatedBookingType, err := p.bookingTypeservice.EditBookingType(ctx, uint64(id), inputBookingType)
+    updatedBookingType, err := p.bookingTypeservice.EditBookingType(ctx, id, inputBookingType)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
     ctx.JSON(http.StatusOK, updatedBookingType)
 }
+
+// RestoreBookingTypeByID godoc
+// @Summary Restore a deleted bookingType
+// @Description Bring back a soft-deleted bookingType by its ID.
+// @Tags bookingTypes
+// @Accept json
+// @Produce json
+// @Param id path int true "BookingType ID"
+// @Success 200 {object} map[string]any "BookingType successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "BookingType not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /bookingtypes/{id}/restore [post]
+func (p *bookingTypeHandlerImpl) RestoreBookingTypeByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	bookingType, err := p.bookingTypeservice.RestoreBookingType(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"bookingType": bookingType,
+		"message": "Your bookingType has been successfully restored",
+	})
+}
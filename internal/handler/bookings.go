@@ -2,12 +2,9 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
-	"time"
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,30 +15,19 @@ type BookingHandler interface {
 	DeleteBookingByID(ctx *gin.Context)
 	CreateBooking(ctx *gin.Context)
 	EditBooking(ctx *gin.Context)
+	RestoreBookingByID(ctx *gin.Context)
+	PickUpBooking(ctx *gin.Context)
+	ReturnBooking(ctx *gin.Context)
+	GetSettlement(ctx *gin.Context)
+	PayBooking(ctx *gin.Context)
 }
 
 type bookingHandlerImpl struct {
 	bookingservice service.Bookingservice
-	customerservice service.CustomerService
-	carservice service.Carservice
-	driverservice service.Driverservice
-	booktypeservice service.BookingTypeservice
 }
 
-func NewBookingHandler(
-    bookingservice service.Bookingservice, 
-    customerservice service.CustomerService, 
-    carservice service.Carservice,
-	driverservice service.Driverservice,
-	booktypeservice service.BookingTypeservice,
-) BookingHandler {
-    return &bookingHandlerImpl{
-        bookingservice: bookingservice,
-        customerservice: customerservice,
-        carservice: carservice,
-		driverservice: driverservice,
-		booktypeservice: booktypeservice,
-    }
+func NewBookingHandler(bookingservice service.Bookingservice) BookingHandler {
+	return &bookingHandlerImpl{bookingservice: bookingservice}
 }
 
 // GetBookings godoc
@@ -50,14 +36,15 @@ func NewBookingHandler(
 // @Tags bookings
 // @Accept json
 // @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success	200	{object} models.Booking "List of bookings"
 // @Success 404 {object} pkg.ErrorResponse "No booking found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /bookings [get]
 func (p *bookingHandlerImpl) GetBookings(ctx *gin.Context) {
-	bookings, err := p.bookingservice.GetBookings(ctx)
+	bookings, err := p.bookingservice.GetBookings(ctx, includeDeleted(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(bookings) == 0 {
@@ -80,19 +67,15 @@ func (p *bookingHandlerImpl) GetBookings(ctx *gin.Context) {
 // @Router /bookings/{id} [get]
 func (p *bookingHandlerImpl) GetBookingByID(ctx *gin.Context) {
 	// get booking ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid booking ID"})
+		ctx.Error(err)
 		return
 	}
 
 	booking, err := p.bookingservice.GetBookingsByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if booking.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "booking not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -100,35 +83,30 @@ func (p *bookingHandlerImpl) GetBookingByID(ctx *gin.Context) {
 }
 // DeleteBookingByID godoc
 // @Summary Delete booking by ID
-// @Description Delete a booking by its ID.
+// @Description Delete a booking by its ID, together with its driver incentives not paid out yet.
 // @Tags bookings
 // @Accept json
 // @Produce json
 // @Param id path int true "Booking ID"
 // @Success	200	{object} models.Booking "Booking details"
 // @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Driver incentive of the booking already paid out"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /bookings/{id} [delete]
 func (p *bookingHandlerImpl) DeleteBookingByID(ctx *gin.Context) {
 	// Get booking ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
-		return
-	}
-
-	booking, err := p.bookingservice.DeleteBooking(ctx, uint64(id))
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
-	if booking.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Booking not found"})
+	booking, err := p.bookingservice.DeleteBooking(ctx, id)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"booking":    booking,
 		"message": "Your booking has been successfully deleted",
@@ -143,72 +121,20 @@ func (p *bookingHandlerImpl) DeleteBookingByID(ctx *gin.Context) {
 // @Param booking body models.InputBooking true "Booking data"
 // @Success	200	{object} models.Booking "Booking details"
 // @Failure 400 {object} pkg.ErrorResponse "Bad request"
+// @Failure 404 {object} pkg.ErrorResponse "Customer, car, driver or booking type not found"
+// @Failure 409 {object} pkg.ErrorResponse "No unit of the car or not enough of an extra available, or company over its credit limit, or customer blacklisted or flagged without a risk_override"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /bookings [post]
 func (p *bookingHandlerImpl) CreateBooking(ctx *gin.Context) {
 	booking := models.InputBooking{}
 
-	if err := ctx.BindJSON(&booking); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	_, err := p.customerservice.GetCustomersByID(ctx, uint64(booking.CustomerID))
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "customer not found"})
-		return
-	}
-	
-	_, err = p.carservice.GetCarsByID(ctx, uint64(booking.CarID))
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "car not found"})
-		return
-	}
-	
-	_, err = time.Parse("02/01/2006", booking.StartRent)
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "start date must be in format dd/mm/yyyy"})
-		return
-	}
-	_, err = time.Parse("02/01/2006", booking.EndRent)
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "end date must be in format dd/mm/yyyy"})
-		return
-	}
-
-	if booking.StartRent > booking.EndRent {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "start date must be before end date"})
+	if err := bindJSON(ctx, &booking); err != nil {
+		ctx.Error(err)
 		return
 	}
-
-	if booking.BookTypeID != nil && *booking.BookTypeID == 2 {
-		if booking.DriverID == nil {
-			ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "driver id should be provided for BookTypeID 2"})
-			return
-		} else {
-			_, err := p.driverservice.GetDriversByID(ctx, uint64(*booking.DriverID))
-			if err != nil {
-				ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "driver not found"})
-				return
-			}
-		}
-	}
-	if booking.BookTypeID != nil && *booking.BookTypeID == 1 {
-		if booking.DriverID != nil {
-			ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "driver id should not be provided for BookTypeID 1"})
-			return
-		}
-	}
-
-	if booking.BookTypeID != nil {
-		_, err := p.booktypeservice.GetBookingTypesByID(ctx, uint64(*booking.BookTypeID))
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "booking type not found"})
-			return
-		}
-	}
 	createdBooking, err := p.bookingservice.CreateBooking(ctx, booking)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -229,21 +155,17 @@ func (p *bookingHandlerImpl) CreateBooking(ctx *gin.Context) {
 // @Router /bookings/{id} [put]
 func (p *bookingHandlerImpl) EditBooking(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	booking, err := p.bookingservice.GetBookingsByID(ctx, uint64(id))
+	booking, err := p.bookingservice.GetBookingsByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
-    if booking.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Booking not found"})
-        return
-    }
 	startDate := booking.StartRent.Format("02/01/2006")
 	endDate := booking.EndRent.Format("02/01/2006")
 	inputBooking := models.InputBooking{}
@@ -251,60 +173,188 @@ func (p *bookingHandlerImpl) EditBooking(ctx *gin.Context) {
 	inputBooking.CarID = booking.CarID
 	inputBooking.StartRent = startDate
 	inputBooking.EndRent = endDate
+	inputBooking.DriverID = booking.DriverID
+	inputBooking.BookTypeID = booking.BookTypeID
+	inputBooking.PickupBranchID = booking.PickupBranchID
+	inputBooking.ReturnBranchID = booking.ReturnBranchID
+	inputBooking.InsurancePlanID = booking.InsurancePlanID
+	inputBooking.CompanyID = booking.CompanyID
+	for _, extra := range booking.Extras {
+		inputBooking.Extras = append(inputBooking.Extras, models.InputBookingExtra{ExtraID: extra.ExtraID, Quantity: extra.Quantity})
+	}
 	inputBooking.Finished = booking.Finished
-	if err := ctx.ShouldBindJSON(&inputBooking); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+	if err := bindJSON(ctx, &inputBooking); err != nil {
+        ctx.Error(err)
+        return
+    }
+
+    updatedBooking, err := p.bookingservice.EditBooking(ctx, id, inputBooking)
+    if err != nil {
+        ctx.Error(err)
         return
     }
-	_, err = time.Parse("02/01/2006", inputBooking.StartRent)
 
+    ctx.JSON(http.StatusOK, updatedBooking)
+}
+
+// RestoreBookingByID godoc
+// @Summary Restore a deleted booking
+// @Description Bring back a soft-deleted booking by its ID, with the driver incentives deleted along with it.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} map[string]any "Booking successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /bookings/{id}/restore [post]
+func (p *bookingHandlerImpl) RestoreBookingByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "start date must be in format dd/mm/yyyy"})
+		ctx.Error(err)
 		return
 	}
-	_, err = time.Parse("02/01/2006", inputBooking.EndRent)
+
+	booking, err := p.bookingservice.RestoreBooking(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "end date must be in format dd/mm/yyyy"})
+		ctx.Error(err)
 		return
 	}
 
-	if inputBooking.StartRent > inputBooking.EndRent {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "start date must be before end date"})
+	ctx.JSON(http.StatusOK, map[string]any{
+		"booking": booking,
+		"message": "Your booking has been successfully restored",
+	})
+}
+
+// PickUpBooking godoc
+// @Summary Pick up a booked car
+// @Description Assign a vehicle unit to the booking and hand it to the customer. Without vehicle_id a free unit of the booked car is chosen; without fuel_level the tank is taken to be full.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Param pickup body models.InputPickup false "Vehicle to hand over and its fuel level"
+// @Success 200 {object} models.Booking "Booking with its vehicle"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking already picked up or finished, or no vehicle free"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /bookings/{id}/pickup [post]
+func (p *bookingHandlerImpl) PickUpBooking(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	if inputBooking.BookTypeID != nil && *inputBooking.BookTypeID == 2 {
-		if inputBooking.DriverID == nil {
-			ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "driver id should be provided for BookTypeID 2"})
+	pickup := models.InputPickup{}
+	if ctx.Request.ContentLength != 0 {
+		if err := bindJSON(ctx, &pickup); err != nil {
+			ctx.Error(err)
 			return
-		} else {
-			_, err := p.driverservice.GetDriversByID(ctx, uint64(*inputBooking.DriverID))
-			if err != nil {
-				ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "driver not found"})
-				return
-			}
 		}
 	}
-	if inputBooking.BookTypeID != nil && *inputBooking.BookTypeID == 1 {
-		if inputBooking.DriverID != nil {
-			ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "driver id should not be provided for BookTypeID 1"})
-			return
-		}
+
+	booking, err := p.bookingservice.PickUpBooking(ctx, id, pickup)
+	if err != nil {
+		ctx.Error(err)
+		return
 	}
 
-	if inputBooking.BookTypeID != nil {
-		_, err := p.booktypeservice.GetBookingTypesByID(ctx, uint64(*booking.BookTypeID))
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "booking type not found"})
-			return
-		}
+	ctx.JSON(http.StatusOK, booking)
+}
+
+// ReturnBooking godoc
+// @Summary Return a picked up car
+// @Description Take the vehicle back, record its odometer reading and fuel level, charge missing fuel and excess kilometres under the car category's policy and finish the booking.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Param return body models.InputReturn true "Odometer reading and fuel level at return"
+// @Success 200 {object} models.Booking "Finished booking"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking not picked up or already returned"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /bookings/{id}/return [post]
+func (p *bookingHandlerImpl) ReturnBooking(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
 	}
 
-    updatedBooking, err := p.bookingservice.EditBooking(ctx, uint64(id), inputBooking)
-    if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-        return
-    }
+	ret := models.InputReturn{}
+	if err := bindJSON(ctx, &ret); err != nil {
+		ctx.Error(err)
+		return
+	}
 
-    ctx.JSON(http.StatusOK, updatedBooking)
+	booking, err := p.bookingservice.ReturnBooking(ctx, id, ret)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, booking)
+}
+
+// GetSettlement godoc
+// @Summary Settle a booking
+// @Description Break down what the customer owes for a booking, including insurance, fuel, mileage, damage and one-way charges, and the balance left after the deposit.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} models.BookingSettlement "Settlement"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /bookings/{id}/settlement [get]
+func (p *bookingHandlerImpl) GetSettlement(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	settlement, err := p.bookingservice.GetSettlement(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, settlement)
+}
+
+// PayBooking godoc
+// @Summary Record the payment of a booking
+// @Description Mark a booking billed to a company as paid, which frees its amount from the company's credit limit, or record that a customer paying for themselves settled the balance left at return.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} models.Booking "Paid booking"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking paid by the customer not returned yet, cancelled or already paid"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /bookings/{id}/pay [post]
+func (p *bookingHandlerImpl) PayBooking(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	booking, err := p.bookingservice.PayBooking(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, booking)
 }
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BranchHandler interface {
+	GetBranches(ctx *gin.Context)
+	GetBranchByID(ctx *gin.Context)
+	DeleteBranchByID(ctx *gin.Context)
+	CreateBranch(ctx *gin.Context)
+	EditBranch(ctx *gin.Context)
+	RestoreBranchByID(ctx *gin.Context)
+}
+
+type branchHandlerImpl struct {
+	branchservice service.Branchservice
+}
+
+func NewBranchHandler(branchservice service.Branchservice) BranchHandler {
+	return &branchHandlerImpl{branchservice: branchservice}
+}
+
+// GetBranches godoc
+// @Summary Retrieve list of branches
+// @Description Retrieve all branches.
+// @Tags branches
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.Branch "List of branches"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /branches [get]
+func (p *branchHandlerImpl) GetBranches(ctx *gin.Context) {
+	branches, err := p.branchservice.GetBranches(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(branches) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No branch found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, branches)
+}
+
+// GetBranchByID godoc
+// @Summary Retrieve branch by ID
+// @Description Retrieve a branch by its unique ID.
+// @Tags branches
+// @Accept json
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Success 200 {object} models.Branch "Branch details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Branch not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /branches/{id} [get]
+func (p *branchHandlerImpl) GetBranchByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	branch, err := p.branchservice.GetBranchesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, branch)
+}
+
+// DeleteBranchByID godoc
+// @Summary Delete branch by ID
+// @Description Close a branch that keeps no vehicles or drivers and has no open bookings or transfers.
+// @Tags branches
+// @Accept json
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Success 200 {object} map[string]any "Branch successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Branch not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still has vehicles, drivers, open bookings or open transfers"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /branches/{id} [delete]
+func (p *branchHandlerImpl) DeleteBranchByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	branch, err := p.branchservice.DeleteBranch(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"branch":  branch,
+		"message": "Your branch has been successfully deleted",
+	})
+}
+
+// CreateBranch godoc
+// @Summary Create a new branch
+// @Description Open a new branch with the fee it charges for one-way rentals.
+// @Tags branches
+// @Accept json
+// @Produce json
+// @Param branch body models.InputBranch true "Branch data"
+// @Success 201 {object} models.Branch "Created branch"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /branches [post]
+func (p *branchHandlerImpl) CreateBranch(ctx *gin.Context) {
+	branch := models.InputBranch{}
+	if err := bindJSON(ctx, &branch); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdBranch, err := p.branchservice.CreateBranch(ctx, branch)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdBranch)
+}
+
+// EditBranch godoc
+// @Summary Update branch
+// @Description Modify the details or one-way fee of a branch.
+// @Tags branches
+// @Accept json
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Param branch body models.InputBranch true "Updated branch data"
+// @Success 200 {object} models.Branch "Updated branch"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Branch not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /branches/{id} [put]
+func (p *branchHandlerImpl) EditBranch(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	branch, err := p.branchservice.GetBranchesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputBranch := models.InputBranch{}
+	inputBranch.Name = branch.Name
+	inputBranch.City = branch.City
+	inputBranch.Address = branch.Address
+	inputBranch.Phone = branch.Phone
+	inputBranch.OneWayFee = branch.OneWayFee
+	if err := bindJSON(ctx, &inputBranch); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedBranch, err := p.branchservice.EditBranch(ctx, id, inputBranch)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedBranch)
+}
+
+// RestoreBranchByID godoc
+// @Summary Restore a deleted branch
+// @Description Bring back a soft-deleted branch by its ID.
+// @Tags branches
+// @Accept json
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Success 200 {object} map[string]any "Branch successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Branch not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /branches/{id}/restore [post]
+func (p *branchHandlerImpl) RestoreBranchByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	branch, err := p.branchservice.RestoreBranch(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"branch":  branch,
+		"message": "Your branch has been successfully restored",
+	})
+}
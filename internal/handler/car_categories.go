@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CarCategoryHandler interface {
+	GetCarCategories(ctx *gin.Context)
+	GetCarCategoryByID(ctx *gin.Context)
+	DeleteCarCategoryByID(ctx *gin.Context)
+	CreateCarCategory(ctx *gin.Context)
+	EditCarCategory(ctx *gin.Context)
+	RestoreCarCategoryByID(ctx *gin.Context)
+}
+
+type carCategoryHandlerImpl struct {
+	carCategoryservice service.CarCategoryservice
+}
+
+func NewCarCategoryHandler(carCategoryservice service.CarCategoryservice) CarCategoryHandler {
+	return &carCategoryHandlerImpl{carCategoryservice: carCategoryservice}
+}
+
+// GetCarCategories godoc
+// @Summary Retrieve list of car categories
+// @Description Retrieve all car categories.
+// @Tags car-categories
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.CarCategory "List of car categories"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /car-categories [get]
+func (p *carCategoryHandlerImpl) GetCarCategories(ctx *gin.Context) {
+	categories, err := p.carCategoryservice.GetCarCategories(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(categories) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No car category found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, categories)
+}
+
+// GetCarCategoryByID godoc
+// @Summary Retrieve car category by ID
+// @Description Retrieve a car category by its unique ID.
+// @Tags car-categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Car category ID"
+// @Success 200 {object} models.CarCategory "Car category details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Car category not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /car-categories/{id} [get]
+func (p *carCategoryHandlerImpl) GetCarCategoryByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	category, err := p.carCategoryservice.GetCarCategoriesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, category)
+}
+
+// DeleteCarCategoryByID godoc
+// @Summary Delete car category by ID
+// @Description Remove a car category that no car belongs to.
+// @Tags car-categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Car category ID"
+// @Success 200 {object} map[string]any "Car category successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Car category not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by cars"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /car-categories/{id} [delete]
+func (p *carCategoryHandlerImpl) DeleteCarCategoryByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	category, err := p.carCategoryservice.DeleteCarCategory(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"car_category": category,
+		"message":      "Your car category has been successfully deleted",
+	})
+}
+
+// CreateCarCategory godoc
+// @Summary Create a new car category
+// @Description Add a new car category with the fuel policy, mileage allowance and mandatory insurance plan of its cars.
+// @Tags car-categories
+// @Accept json
+// @Produce json
+// @Param category body models.InputCarCategory true "Car category data"
+// @Success 201 {object} models.CarCategory "Created car category"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /car-categories [post]
+func (p *carCategoryHandlerImpl) CreateCarCategory(ctx *gin.Context) {
+	category := models.InputCarCategory{}
+	if err := bindJSON(ctx, &category); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdCategory, err := p.carCategoryservice.CreateCarCategory(ctx, category)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdCategory)
+}
+
+// EditCarCategory godoc
+// @Summary Update car category
+// @Description Modify a car category, including the fuel policy, mileage allowance and mandatory insurance plan of its cars.
+// @Tags car-categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Car category ID"
+// @Param category body models.InputCarCategory true "Updated car category data"
+// @Success 200 {object} models.CarCategory "Updated car category"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Car category not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /car-categories/{id} [put]
+func (p *carCategoryHandlerImpl) EditCarCategory(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	category, err := p.carCategoryservice.GetCarCategoriesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputCategory := models.InputCarCategory{}
+	inputCategory.Name = category.Name
+	inputCategory.Description = category.Description
+	inputCategory.FuelPolicy = category.FuelPolicy
+	inputCategory.RefuelRate = category.RefuelRate
+	inputCategory.PrepaidFuelPrice = category.PrepaidFuelPrice
+	inputCategory.DailyKmAllowance = category.DailyKmAllowance
+	inputCategory.ExcessKmRate = category.ExcessKmRate
+	inputCategory.InsurancePlanID = category.InsurancePlanID
+	if err := bindJSON(ctx, &inputCategory); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedCategory, err := p.carCategoryservice.EditCarCategory(ctx, id, inputCategory)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedCategory)
+}
+
+// RestoreCarCategoryByID godoc
+// @Summary Restore a deleted car category
+// @Description Bring back a soft-deleted car category by its ID.
+// @Tags car-categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Car category ID"
+// @Success 200 {object} map[string]any "Car category successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Car category not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /car-categories/{id}/restore [post]
+func (p *carCategoryHandlerImpl) RestoreCarCategoryByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	category, err := p.carCategoryservice.RestoreCarCategory(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"car_category": category,
+		"message":      "Your car category has been successfully restored",
+	})
+}
@@ -2,11 +2,9 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +15,7 @@ type CarHandler interface {
 	DeleteCarByID(ctx *gin.Context)
 	CreateCar(ctx *gin.Context)
 	EditCar(ctx *gin.Context)
+	RestoreCarByID(ctx *gin.Context)
 }
 
 type carHandlerImpl struct {
@@ -28,18 +27,38 @@ func NewCarHandler(carservice service.Carservice) CarHandler {
 }
 // GetCars godoc
 // @Summary Retrieve list of cars
-// @Description Retrieve a list of all available cars.
+// @Description Search cars by category, attributes and price, optionally only those free over a rent period.
 // @Tags cars
 // @Accept json
 // @Produce json
+// @Param category_id query int false "Only cars of this category"
+// @Param brand query string false "Brand, case-insensitive"
+// @Param transmission query string false "manual or automatic"
+// @Param fuel_type query string false "petrol, diesel, electric or hybrid"
+// @Param min_seats query int false "At least this many seats"
+// @Param min_luggage query int false "Room for at least this many bags"
+// @Param min_year query int false "Built in this year or later"
+// @Param min_price query int false "Lowest daily rent"
+// @Param max_price query int false "Highest daily rent"
+// @Param start_rent query string false "With end_rent, only cars free over the period (dd/mm/yyyy)"
+// @Param end_rent query string false "With start_rent, only cars free over the period (dd/mm/yyyy)"
+// @Param branch_id query int false "With start_rent and end_rent, only cars free at this branch"
+// @Param sort query string false "name, daily_rent, seats or year; prefix with - for descending"
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success 200 {array} models.Car "List of cars"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid filter"
 // @Success 404 {object} pkg.ErrorResponse "No car found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /cars [get]
 func (p *carHandlerImpl) GetCars(ctx *gin.Context) {
-	cars, err := p.carservice.GetCars(ctx)
+	filter := models.CarFilter{}
+	if err := bindQuery(ctx, &filter); err != nil {
+		ctx.Error(err)
+		return
+	}
+	cars, err := p.carservice.GetCars(ctx, filter)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(cars) == 0 {
@@ -62,19 +81,15 @@ func (p *carHandlerImpl) GetCars(ctx *gin.Context) {
 // @Router /cars/{id} [get]
 func (p *carHandlerImpl) GetCarByID(ctx *gin.Context) {
 	// get car ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid car ID"})
+		ctx.Error(err)
 		return
 	}
 
 	car, err := p.carservice.GetCarsByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if car.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "car not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -90,31 +105,25 @@ func (p *carHandlerImpl) GetCarByID(ctx *gin.Context) {
 // @Success 200 {object} map[string]any "Car successfully deleted"
 // @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
 // @Failure 404 {object} pkg.ErrorResponse "Car not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by other records"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /cars/{id} [delete]
 func (p *carHandlerImpl) DeleteCarByID(ctx *gin.Context) {
 	// Get car ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	// Delete car by ID
-	car, err := p.carservice.DeleteCar(ctx, uint64(id))
+	car, err := p.carservice.DeleteCar(ctx, id)
 
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-
-	// Check if the car exists
-	if car.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Car not found"})
+		ctx.Error(err)
 		return
 	}
 
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"car":    car,
 		"message": "Your car has been successfully deleted",
@@ -133,14 +142,14 @@ func (p *carHandlerImpl) DeleteCarByID(ctx *gin.Context) {
 // @Router /cars [post]
 func (p *carHandlerImpl) CreateCar(ctx *gin.Context) {
 	car := models.InputCar{}
-	if err := ctx.BindJSON(&car); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
+	if err := bindJSON(ctx, &car); err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	createdCar, err := p.carservice.CreateCar(ctx, car)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -161,35 +170,67 @@ func (p *carHandlerImpl) CreateCar(ctx *gin.Context) {
 // @Router /cars/{id} [put]
 func (p *carHandlerImpl) EditCar(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	car, err := p.carservice.GetCarsByID(ctx, uint64(id))
+	car, err := p.carservice.GetCarsByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-        return
-    }
-    if car.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Car not found"})
+        ctx.Error(err)
         return
     }
 
-    
-    if err := ctx.ShouldBindJSON(&car); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+    if err := bindJSON(ctx, &car); err != nil {
+        ctx.Error(err)
         return
     }
 	inputCar := models.InputCar{}
 	inputCar.Name = car.Name
-	inputCar.Stock = car.Stock
 	inputCar.DailyRent = car.DailyRent
-    updatedCar, err := p.carservice.EditCar(ctx, uint64(id), inputCar)
+	inputCar.CategoryID = car.CategoryID
+	inputCar.Brand = car.Brand
+	inputCar.Transmission = car.Transmission
+	inputCar.FuelType = car.FuelType
+	inputCar.Seats = car.Seats
+	inputCar.Year = car.Year
+	inputCar.Luggage = car.Luggage
+    updatedCar, err := p.carservice.EditCar(ctx, id, inputCar)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
     ctx.JSON(http.StatusOK, updatedCar)
 }
+
+// RestoreCarByID godoc
+// @Summary Restore a deleted car
+// @Description Bring back a soft-deleted car by its ID.
+// @Tags cars
+// @Accept json
+// @Produce json
+// @Param id path int true "Car ID"
+// @Success 200 {object} map[string]any "Car successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Car not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /cars/{id}/restore [post]
+func (p *carHandlerImpl) RestoreCarByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	car, err := p.carservice.RestoreCar(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"car": car,
+		"message": "Your car has been successfully restored",
+	})
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyHandler interface {
+	GetCompanies(ctx *gin.Context)
+	GetCompanyByID(ctx *gin.Context)
+	DeleteCompanyByID(ctx *gin.Context)
+	CreateCompany(ctx *gin.Context)
+	EditCompany(ctx *gin.Context)
+	RestoreCompanyByID(ctx *gin.Context)
+	GetCompanyStatement(ctx *gin.Context)
+}
+
+type companyHandlerImpl struct {
+	companyservice service.Companyservice
+}
+
+func NewCompanyHandler(companyservice service.Companyservice) CompanyHandler {
+	return &companyHandlerImpl{companyservice: companyservice}
+}
+
+// GetCompanies godoc
+// @Summary Retrieve list of companies
+// @Description Retrieve all corporate accounts with their rate cards.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.Company "List of companies"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies [get]
+func (p *companyHandlerImpl) GetCompanies(ctx *gin.Context) {
+	companies, err := p.companyservice.GetCompanies(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(companies) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No company found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, companies)
+}
+
+// GetCompanyByID godoc
+// @Summary Retrieve company by ID
+// @Description Retrieve a company by its unique ID.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param id path int true "Company ID"
+// @Success 200 {object} models.Company "Company details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Company not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies/{id} [get]
+func (p *companyHandlerImpl) GetCompanyByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	company, err := p.companyservice.GetCompaniesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, company)
+}
+
+// DeleteCompanyByID godoc
+// @Summary Delete company by ID
+// @Description Remove a company once every booking billed to it was paid or cancelled.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param id path int true "Company ID"
+// @Success 200 {object} map[string]any "Company successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Company not found"
+// @Failure 409 {object} pkg.ErrorResponse "Company still has unpaid bookings"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies/{id} [delete]
+func (p *companyHandlerImpl) DeleteCompanyByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	company, err := p.companyservice.DeleteCompany(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"company": company,
+		"message": "Your company has been successfully deleted",
+	})
+}
+
+// CreateCompany godoc
+// @Summary Create a new company
+// @Description Add a corporate account with its NPWP, billing contact, credit limit, payment terms (net 30 unless given) and negotiated daily rents.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param company body models.InputCompany true "Company data"
+// @Success 201 {object} models.Company "Created company"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "NPWP already registered"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies [post]
+func (p *companyHandlerImpl) CreateCompany(ctx *gin.Context) {
+	company := models.InputCompany{}
+	if err := bindJSON(ctx, &company); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdCompany, err := p.companyservice.CreateCompany(ctx, company)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdCompany)
+}
+
+// EditCompany godoc
+// @Summary Update company
+// @Description Modify a company and replace its rate card. New rates and terms apply to bookings made or edited from now on.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param id path int true "Company ID"
+// @Param company body models.InputCompany true "Updated company data"
+// @Success 200 {object} models.Company "Updated company"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Company not found"
+// @Failure 409 {object} pkg.ErrorResponse "NPWP already registered"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies/{id} [put]
+func (p *companyHandlerImpl) EditCompany(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	company, err := p.companyservice.GetCompaniesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputCompany := models.InputCompany{}
+	inputCompany.Name = company.Name
+	inputCompany.NPWP = company.NPWP
+	inputCompany.Address = company.Address
+	inputCompany.BillingContact = company.BillingContact
+	inputCompany.BillingEmail = company.BillingEmail
+	inputCompany.BillingPhone = company.BillingPhone
+	inputCompany.CreditLimit = company.CreditLimit
+	inputCompany.PaymentTermsDays = company.PaymentTermsDays
+	for _, rate := range company.Rates {
+		inputCompany.Rates = append(inputCompany.Rates, models.InputCompanyRate{CarID: rate.CarID, DailyRent: rate.DailyRent})
+	}
+	if err := bindJSON(ctx, &inputCompany); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedCompany, err := p.companyservice.EditCompany(ctx, id, inputCompany)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedCompany)
+}
+
+// RestoreCompanyByID godoc
+// @Summary Restore a deleted company
+// @Description Bring back a soft-deleted company by its ID.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param id path int true "Company ID"
+// @Success 200 {object} map[string]any "Company successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Company not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies/{id}/restore [post]
+func (p *companyHandlerImpl) RestoreCompanyByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	company, err := p.companyservice.RestoreCompany(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"company": company,
+		"message": "Your company has been successfully restored",
+	})
+}
+
+// GetCompanyStatement godoc
+// @Summary Monthly statement of a company
+// @Description List the bookings billed to a company that start in a month, with their totals, due dates and payments, and what the company owes overall.
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param id path int true "Company ID"
+// @Param month query string false "Month as yyyy-mm, the current month by default"
+// @Success 200 {object} models.CompanyStatement "Statement"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param or month"
+// @Failure 404 {object} pkg.ErrorResponse "Company not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /companies/{id}/statement [get]
+func (p *companyHandlerImpl) GetCompanyStatement(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	month := time.Now()
+	if raw := ctx.Query("month"); raw != "" {
+		month, err = time.Parse("2006-01", raw)
+		if err != nil {
+			ctx.Error(apperror.Validation("request is invalid", pkg.FieldError{Field: "month", Message: "must be in format yyyy-mm"}))
+			return
+		}
+	}
+
+	statement, err := p.companyservice.GetCompanyStatement(ctx, id, month)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statement)
+}
@@ -3,11 +3,9 @@ package handler
 import (
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -20,6 +18,9 @@ type CustomerHandler interface {
 	EditCustomer(ctx *gin.Context)
 	AssignMembership(ctx *gin.Context)
 	DeleteMembershipByCustomer(ctx *gin.Context)
+	RestoreCustomerByID(ctx *gin.Context)
+	SetCustomerRisk(ctx *gin.Context)
+	ClearCustomerRisk(ctx *gin.Context)
 }
 
 type customerHandlerImpl struct {
@@ -35,14 +36,15 @@ func NewCustomerHandler(customerservice service.CustomerService) CustomerHandler
 // @Tags customers
 // @Accept json
 // @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success	200	{object} models.Customer "List of customers"
 // @Success 404 {object} pkg.ErrorResponse "No customer found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /customers [get]
 func (p *customerHandlerImpl) GetCustomers(ctx *gin.Context) {
-	customers, err := p.customerservice.GetCustomers(ctx)
+	customers, err := p.customerservice.GetCustomers(ctx, includeDeleted(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(customers) == 0 {
@@ -65,19 +67,15 @@ func (p *customerHandlerImpl) GetCustomers(ctx *gin.Context) {
 // @Router /customers/{id} [get]
 func (p *customerHandlerImpl) GetCustomerByID(ctx *gin.Context) {
 	// get customer ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid customer ID"})
+		ctx.Error(err)
 		return
 	}
 
 	customer, err := p.customerservice.GetCustomersByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if customer.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "customer not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -92,30 +90,24 @@ func (p *customerHandlerImpl) GetCustomerByID(ctx *gin.Context) {
 // @Param id path int true "Customer ID"
 // @Success	200	{object} models.Customer "Deleted customer"
 // @Failure 404 {object} pkg.ErrorResponse "Customer not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by other records"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /customers/{id} [delete]
 func (p *customerHandlerImpl) DeleteCustomerByID(ctx *gin.Context) {
 	// Get customer ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	// Delete customer by ID
-	customer, err := p.customerservice.DeleteCustomer(ctx, uint64(id))
+	customer, err := p.customerservice.DeleteCustomer(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
-	// Check if the customer exists
-	if customer.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Customer not found"})
-		return
-	}
-
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"customer":    customer,
 		"message": "Your customer has been successfully deleted",
@@ -134,14 +126,14 @@ func (p *customerHandlerImpl) DeleteCustomerByID(ctx *gin.Context) {
 // @Router /customers [post]
 func (p *customerHandlerImpl) CreateCustomer(ctx *gin.Context) {
 	customer := models.InputCustomer{}
-	if err := ctx.BindJSON(&customer); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
+	if err := bindJSON(ctx, &customer); err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	createdCustomer, err := p.customerservice.CreateCustomer(ctx, customer)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -162,26 +154,19 @@ func (p *customerHandlerImpl) CreateCustomer(ctx *gin.Context) {
 // @Router /customers/{id} [put]
 func (p *customerHandlerImpl) EditCustomer(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	customer, err := p.customerservice.GetCustomersByID(ctx, uint64(id))
+	customer, err := p.customerservice.GetCustomersByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
-    // Check if the customer exists
-    if customer.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Customer not found"})
-        return
-    }
-
-    
-    if err := ctx.ShouldBindJSON(&customer); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+    if err := bindJSON(ctx, &customer); err != nil {
+        ctx.Error(err)
         return
     }
 	inputCustomer := models.InputCustomer{}
@@ -189,9 +174,9 @@ func (p *customerHandlerImpl) EditCustomer(ctx *gin.Context) {
 	inputCustomer.NIK = customer.NIK
 	inputCustomer.Phone = customer.Phone
     // Call service to edit customer data
-    updatedCustomer, err := p.customerservice.EditCustomer(ctx, uint64(id), inputCustomer)
+    updatedCustomer, err := p.customerservice.EditCustomer(ctx, id, inputCustomer)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
@@ -212,28 +197,24 @@ func (p *customerHandlerImpl) EditCustomer(ctx *gin.Context) {
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /customers/{id}/membership [put]
 func (p *customerHandlerImpl) AssignMembership(ctx *gin.Context) {
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
-		return
-	}
-	customer, err := p.customerservice.GetCustomersByID(ctx, uint64(id))
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
-	if customer.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Customer not found"})
+	_, err = p.customerservice.GetCustomersByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 	member := models.InputMembershipID{}
-	if err := ctx.ShouldBindJSON(&member); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+	if err := bindJSON(ctx, &member); err != nil {
+		ctx.Error(err)
 		return
 	}
-	updatedCustomer, err := p.customerservice.AssignMembership(ctx, uint64(id), member)
+	updatedCustomer, err := p.customerservice.AssignMembership(ctx, id, member)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, updatedCustomer)
@@ -251,24 +232,20 @@ func (p *customerHandlerImpl) AssignMembership(ctx *gin.Context) {
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /customers/{id}/membership [delete]
 func (p *customerHandlerImpl) DeleteMembershipByCustomer(ctx *gin.Context) {
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	customer, err := p.customerservice.GetCustomersByID(ctx, uint64(id))
+	customer, err := p.customerservice.GetCustomersByID(ctx, id)
 	fmt.Println(customer)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if customer.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Customer not found"})
+		ctx.Error(err)
 		return
 	}
-	updatedCustomer, err := p.customerservice.DeleteMembershipByCustomer(ctx, uint64(id), customer)
+	updatedCustomer, err := p.customerservice.DeleteMembershipByCustomer(ctx, id, customer)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, map[string]any{
@@ -276,3 +253,98 @@ func (p *customerHandlerImpl) DeleteMembershipByCustomer(ctx *gin.Context) {
 		"message": "Your membership has been successfully deleted",
 	})
 	}
+
+// RestoreCustomerByID godoc
+// @Summary Restore a deleted customer
+// @Description Bring back a soft-deleted customer by its ID.
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} map[string]any "Customer successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Customer not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /customers/{id}/restore [post]
+func (p *customerHandlerImpl) RestoreCustomerByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	customer, err := p.customerservice.RestoreCustomer(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"customer": customer,
+		"message": "Your customer has been successfully restored",
+	})
+}
+
+// SetCustomerRisk godoc
+// @Summary Flag or blacklist a customer
+// @Description Flag a customer, so bookings need a manager's approval, or blacklist them so they cannot book at all, until expires_at in dd/mm/yyyy or for good when it is omitted. Customers are also flagged automatically after RISK_LATE_RETURNS late returns or RISK_UNPAID_BOOKINGS bookings unpaid past their due date.
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param risk body models.InputCustomerRisk true "Risk status"
+// @Success 200 {object} models.Customer "Customer with updated risk status"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Customer not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /customers/{id}/risk [put]
+func (p *customerHandlerImpl) SetCustomerRisk(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	risk := models.InputCustomerRisk{}
+	if err := bindJSON(ctx, &risk); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedCustomer, err := p.customerservice.SetCustomerRisk(ctx, id, risk)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, updatedCustomer)
+}
+
+// ClearCustomerRisk godoc
+// @Summary Clear a customer's flag or blacklisting
+// @Description Lift a customer's risk status. Late returns before now no longer count towards an automatic flag.
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} map[string]any "Customer risk status cleared"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Customer not found"
+// @Failure 409 {object} pkg.ErrorResponse "Customer is neither flagged nor blacklisted"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /customers/{id}/risk [delete]
+func (p *customerHandlerImpl) ClearCustomerRisk(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedCustomer, err := p.customerservice.ClearCustomerRisk(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, map[string]any{
+		"customer": updatedCustomer,
+		"message":  "The customer's risk status has been successfully cleared",
+	})
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverDocumentHandler interface {
+	GetDriverDocuments(ctx *gin.Context)
+	GetDriverDocumentByID(ctx *gin.Context)
+	DeleteDriverDocumentByID(ctx *gin.Context)
+	CreateDriverDocument(ctx *gin.Context)
+	EditDriverDocument(ctx *gin.Context)
+	RestoreDriverDocumentByID(ctx *gin.Context)
+}
+
+type driverDocumentHandlerImpl struct {
+	driverDocumentservice service.DriverDocumentservice
+}
+
+func NewDriverDocumentHandler(driverDocumentservice service.DriverDocumentservice) DriverDocumentHandler {
+	return &driverDocumentHandlerImpl{driverDocumentservice: driverDocumentservice}
+}
+
+// GetDriverDocuments godoc
+// @Summary Retrieve list of driver documents
+// @Description Retrieve the documents of all drivers, such as health certificates, optionally only those of one driver.
+// @Tags driver-documents
+// @Accept json
+// @Produce json
+// @Param driver_id query int false "Only documents of this driver"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.DriverDocument "List of driver documents"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid driver_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-documents [get]
+func (p *driverDocumentHandlerImpl) GetDriverDocuments(ctx *gin.Context) {
+	driverID, err := queryID(ctx, "driver_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	documents, err := p.driverDocumentservice.GetDriverDocuments(ctx, driverID, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(documents) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No driver document found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, documents)
+}
+
+// GetDriverDocumentByID godoc
+// @Summary Retrieve driver document by ID
+// @Description Retrieve a driver's document by its unique ID.
+// @Tags driver-documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver document ID"
+// @Success 200 {object} models.DriverDocument "Driver document details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver document not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-documents/{id} [get]
+func (p *driverDocumentHandlerImpl) GetDriverDocumentByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	document, err := p.driverDocumentservice.GetDriverDocumentsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, document)
+}
+
+// DeleteDriverDocumentByID godoc
+// @Summary Delete driver document by ID
+// @Description Remove a document from a driver's records.
+// @Tags driver-documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver document ID"
+// @Success 200 {object} map[string]any "Driver document successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver document not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-documents/{id} [delete]
+func (p *driverDocumentHandlerImpl) DeleteDriverDocumentByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	document, err := p.driverDocumentservice.DeleteDriverDocument(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver_document": document,
+		"message":         "Your driver document has been successfully deleted",
+	})
+}
+
+// CreateDriverDocument godoc
+// @Summary Create a new driver document
+// @Description Record a document a driver has to keep current, such as a health certificate, with its expiry date in dd/mm/yyyy.
+// @Tags driver-documents
+// @Accept json
+// @Produce json
+// @Param document body models.InputDriverDocument true "Driver document data"
+// @Success 201 {object} models.DriverDocument "Created driver document"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-documents [post]
+func (p *driverDocumentHandlerImpl) CreateDriverDocument(ctx *gin.Context) {
+	document := models.InputDriverDocument{}
+	if err := bindJSON(ctx, &document); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdDocument, err := p.driverDocumentservice.CreateDriverDocument(ctx, document)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdDocument)
+}
+
+// EditDriverDocument godoc
+// @Summary Update driver document
+// @Description Modify a driver's document, for instance after it was renewed.
+// @Tags driver-documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver document ID"
+// @Param document body models.InputDriverDocument true "Updated driver document data"
+// @Success 200 {object} models.DriverDocument "Updated driver document"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Driver document not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-documents/{id} [put]
+func (p *driverDocumentHandlerImpl) EditDriverDocument(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	document, err := p.driverDocumentservice.GetDriverDocumentsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputDocument := models.InputDriverDocument{}
+	inputDocument.DriverID = document.DriverID
+	inputDocument.Type = document.Type
+	inputDocument.Number = document.Number
+	inputDocument.ExpiresAt = document.ExpiresAt.Format(models.DateLayout)
+	if err := bindJSON(ctx, &inputDocument); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedDocument, err := p.driverDocumentservice.EditDriverDocument(ctx, id, inputDocument)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedDocument)
+}
+
+// RestoreDriverDocumentByID godoc
+// @Summary Restore a deleted driver document
+// @Description Bring back a soft-deleted driver document by its ID.
+// @Tags driver-documents
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver document ID"
+// @Success 200 {object} map[string]any "Driver document successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver document not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-documents/{id}/restore [post]
+func (p *driverDocumentHandlerImpl) RestoreDriverDocumentByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	document, err := p.driverDocumentservice.RestoreDriverDocument(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver_document": document,
+		"message":         "Your driver document has been successfully restored",
+	})
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverLeaveHandler interface {
+	GetDriverLeaves(ctx *gin.Context)
+	GetDriverLeaveByID(ctx *gin.Context)
+	DeleteDriverLeaveByID(ctx *gin.Context)
+	CreateDriverLeave(ctx *gin.Context)
+	EditDriverLeave(ctx *gin.Context)
+	RestoreDriverLeaveByID(ctx *gin.Context)
+}
+
+type driverLeaveHandlerImpl struct {
+	driverLeaveservice service.DriverLeaveservice
+}
+
+func NewDriverLeaveHandler(driverLeaveservice service.DriverLeaveservice) DriverLeaveHandler {
+	return &driverLeaveHandlerImpl{driverLeaveservice: driverLeaveservice}
+}
+
+// GetDriverLeaves godoc
+// @Summary Retrieve list of driver leave
+// @Description Retrieve the leave of all drivers, optionally only that of one driver.
+// @Tags driver-leaves
+// @Accept json
+// @Produce json
+// @Param driver_id query int false "Only leave of this driver"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.DriverLeave "List of driver leave"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid driver_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-leaves [get]
+func (p *driverLeaveHandlerImpl) GetDriverLeaves(ctx *gin.Context) {
+	driverID, err := queryID(ctx, "driver_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	leaves, err := p.driverLeaveservice.GetDriverLeaves(ctx, driverID, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(leaves) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No driver leave found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, leaves)
+}
+
+// GetDriverLeaveByID godoc
+// @Summary Retrieve driver leave by ID
+// @Description Retrieve a driver's leave by its unique ID.
+// @Tags driver-leaves
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver leave ID"
+// @Success 200 {object} models.DriverLeave "Driver leave details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver leave not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-leaves/{id} [get]
+func (p *driverLeaveHandlerImpl) GetDriverLeaveByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	leave, err := p.driverLeaveservice.GetDriverLeavesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, leave)
+}
+
+// DeleteDriverLeaveByID godoc
+// @Summary Delete driver leave by ID
+// @Description Cancel a driver's leave, making the driver bookable on those days again.
+// @Tags driver-leaves
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver leave ID"
+// @Success 200 {object} map[string]any "Driver leave successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver leave not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-leaves/{id} [delete]
+func (p *driverLeaveHandlerImpl) DeleteDriverLeaveByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	leave, err := p.driverLeaveservice.DeleteDriverLeave(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver_leave": leave,
+		"message":      "Your driver leave has been successfully deleted",
+	})
+}
+
+// CreateDriverLeave godoc
+// @Summary Create a new driver leave
+// @Description Put a driver on leave from start_date to end_date, both included and in dd/mm/yyyy. Drivers on leave cannot be booked.
+// @Tags driver-leaves
+// @Accept json
+// @Produce json
+// @Param leave body models.InputDriverLeave true "Driver leave data"
+// @Success 201 {object} models.DriverLeave "Created driver leave"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-leaves [post]
+func (p *driverLeaveHandlerImpl) CreateDriverLeave(ctx *gin.Context) {
+	leave := models.InputDriverLeave{}
+	if err := bindJSON(ctx, &leave); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdLeave, err := p.driverLeaveservice.CreateDriverLeave(ctx, leave)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdLeave)
+}
+
+// EditDriverLeave godoc
+// @Summary Update driver leave
+// @Description Modify a driver's leave. Bookings already made are not checked again.
+// @Tags driver-leaves
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver leave ID"
+// @Param leave body models.InputDriverLeave true "Updated driver leave data"
+// @Success 200 {object} models.DriverLeave "Updated driver leave"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Driver leave not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-leaves/{id} [put]
+func (p *driverLeaveHandlerImpl) EditDriverLeave(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	leave, err := p.driverLeaveservice.GetDriverLeavesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputLeave := models.InputDriverLeave{}
+	inputLeave.DriverID = leave.DriverID
+	inputLeave.StartDate = leave.StartDate.Format(models.DateLayout)
+	inputLeave.EndDate = leave.EndDate.Format(models.DateLayout)
+	inputLeave.Reason = leave.Reason
+	if err := bindJSON(ctx, &inputLeave); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedLeave, err := p.driverLeaveservice.EditDriverLeave(ctx, id, inputLeave)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedLeave)
+}
+
+// RestoreDriverLeaveByID godoc
+// @Summary Restore a deleted driver leave
+// @Description Bring back a soft-deleted driver leave by its ID.
+// @Tags driver-leaves
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver leave ID"
+// @Success 200 {object} map[string]any "Driver leave successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver leave not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-leaves/{id}/restore [post]
+func (p *driverLeaveHandlerImpl) RestoreDriverLeaveByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	leave, err := p.driverLeaveservice.RestoreDriverLeave(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver_leave": leave,
+		"message":      "Your driver leave has been successfully restored",
+	})
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverScheduleHandler interface {
+	GetDriverSchedules(ctx *gin.Context)
+	GetDriverScheduleByID(ctx *gin.Context)
+	DeleteDriverScheduleByID(ctx *gin.Context)
+	CreateDriverSchedule(ctx *gin.Context)
+	EditDriverSchedule(ctx *gin.Context)
+	RestoreDriverScheduleByID(ctx *gin.Context)
+}
+
+type driverScheduleHandlerImpl struct {
+	driverScheduleservice service.DriverScheduleservice
+}
+
+func NewDriverScheduleHandler(driverScheduleservice service.DriverScheduleservice) DriverScheduleHandler {
+	return &driverScheduleHandlerImpl{driverScheduleservice: driverScheduleservice}
+}
+
+// GetDriverSchedules godoc
+// @Summary Retrieve list of driver schedules
+// @Description Retrieve the weekly shifts of all drivers, optionally only those of one driver.
+// @Tags driver-schedules
+// @Accept json
+// @Produce json
+// @Param driver_id query int false "Only shifts of this driver"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.DriverSchedule "List of driver schedules"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid driver_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-schedules [get]
+func (p *driverScheduleHandlerImpl) GetDriverSchedules(ctx *gin.Context) {
+	driverID, err := queryID(ctx, "driver_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	schedules, err := p.driverScheduleservice.GetDriverSchedules(ctx, driverID, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(schedules) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No driver schedule found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, schedules)
+}
+
+// GetDriverScheduleByID godoc
+// @Summary Retrieve driver schedule by ID
+// @Description Retrieve a driver's shift by its unique ID.
+// @Tags driver-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver schedule ID"
+// @Success 200 {object} models.DriverSchedule "Driver schedule details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver schedule not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-schedules/{id} [get]
+func (p *driverScheduleHandlerImpl) GetDriverScheduleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	schedule, err := p.driverScheduleservice.GetDriverSchedulesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schedule)
+}
+
+// DeleteDriverScheduleByID godoc
+// @Summary Delete driver schedule by ID
+// @Description Remove a driver's shift. A driver left without any shift is available every day.
+// @Tags driver-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver schedule ID"
+// @Success 200 {object} map[string]any "Driver schedule successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver schedule not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-schedules/{id} [delete]
+func (p *driverScheduleHandlerImpl) DeleteDriverScheduleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	schedule, err := p.driverScheduleservice.DeleteDriverSchedule(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver_schedule": schedule,
+		"message":         "Your driver schedule has been successfully deleted",
+	})
+}
+
+// CreateDriverSchedule godoc
+// @Summary Create a new driver schedule
+// @Description Give a driver a shift on one day of the week, 0 being Sunday, with times in hh:mm. Once a driver has a shift, the days without one are off.
+// @Tags driver-schedules
+// @Accept json
+// @Produce json
+// @Param schedule body models.InputDriverSchedule true "Driver schedule data"
+// @Success 201 {object} models.DriverSchedule "Created driver schedule"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "Driver already has a shift that day"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-schedules [post]
+func (p *driverScheduleHandlerImpl) CreateDriverSchedule(ctx *gin.Context) {
+	schedule := models.InputDriverSchedule{}
+	if err := bindJSON(ctx, &schedule); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdSchedule, err := p.driverScheduleservice.CreateDriverSchedule(ctx, schedule)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdSchedule)
+}
+
+// EditDriverSchedule godoc
+// @Summary Update driver schedule
+// @Description Modify a driver's shift. Bookings already made are not checked again.
+// @Tags driver-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver schedule ID"
+// @Param schedule body models.InputDriverSchedule true "Updated driver schedule data"
+// @Success 200 {object} models.DriverSchedule "Updated driver schedule"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Driver schedule not found"
+// @Failure 409 {object} pkg.ErrorResponse "Driver already has a shift that day"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-schedules/{id} [put]
+func (p *driverScheduleHandlerImpl) EditDriverSchedule(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	schedule, err := p.driverScheduleservice.GetDriverSchedulesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputSchedule := models.InputDriverSchedule{}
+	inputSchedule.DriverID = schedule.DriverID
+	inputSchedule.Weekday = &schedule.Weekday
+	inputSchedule.StartTime = schedule.StartTime
+	inputSchedule.EndTime = schedule.EndTime
+	if err := bindJSON(ctx, &inputSchedule); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedSchedule, err := p.driverScheduleservice.EditDriverSchedule(ctx, id, inputSchedule)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedSchedule)
+}
+
+// RestoreDriverScheduleByID godoc
+// @Summary Restore a deleted driver schedule
+// @Description Bring back a soft-deleted driver shift by its ID.
+// @Tags driver-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver schedule ID"
+// @Success 200 {object} map[string]any "Driver schedule successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver schedule not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-schedules/{id}/restore [post]
+func (p *driverScheduleHandlerImpl) RestoreDriverScheduleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	schedule, err := p.driverScheduleservice.RestoreDriverSchedule(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver_schedule": schedule,
+		"message":         "Your driver schedule has been successfully restored",
+	})
+}
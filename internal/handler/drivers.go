@@ -2,11 +2,9 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +15,9 @@ type DriverHandler interface {
 	DeleteDriverByID(ctx *gin.Context)
 	CreateDriver(ctx *gin.Context)
 	EditDriver(ctx *gin.Context)
+	RestoreDriverByID(ctx *gin.Context)
+	GetExpiringDocuments(ctx *gin.Context)
+	GetDriverStats(ctx *gin.Context)
 }
 
 type driverHandlerImpl struct {
@@ -32,14 +33,15 @@ func NewDriverHandler(driverservice service.Driverservice) DriverHandler {
 // @Tags drivers
 // @Accept json
 // @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success 200 {array} models.Driver "List of drivers"
 // @Success 404 {object} pkg.ErrorResponse "No driver found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /drivers [get]
 func (p *driverHandlerImpl) GetDrivers(ctx *gin.Context) {
-	drivers, err := p.driverservice.GetDrivers(ctx)
+	drivers, err := p.driverservice.GetDrivers(ctx, includeDeleted(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(drivers) == 0 {
@@ -62,19 +64,15 @@ func (p *driverHandlerImpl) GetDrivers(ctx *gin.Context) {
 // @Router /drivers/{id} [get]
 func (p *driverHandlerImpl) GetDriverByID(ctx *gin.Context) {
 	// get driver ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid driver ID"})
+		ctx.Error(err)
 		return
 	}
 
 	driver, err := p.driverservice.GetDriversByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if driver.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "driver not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -90,28 +88,23 @@ func (p *driverHandlerImpl) GetDriverByID(ctx *gin.Context) {
 // @Success 200 {object} map[string]any "Driver deleted successfully"
 // @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
 // @Failure 404 {object} pkg.ErrorResponse "Driver not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by other records"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /drivers/{id} [delete]
 func (p *driverHandlerImpl) DeleteDriverByID(ctx *gin.Context) {
 	// Get driver ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
-		return
-	}
-
-	driver, err := p.driverservice.DeleteDriver(ctx, uint64(id))
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
-	if driver.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Driver not found"})
+	driver, err := p.driverservice.DeleteDriver(ctx, id)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"driver":    driver,
 		"message": "Your driver has been successfully deleted",
@@ -130,14 +123,14 @@ func (p *driverHandlerImpl) DeleteDriverByID(ctx *gin.Context) {
 // @Router /drivers [post]
 func (p *driverHandlerImpl) CreateDriver(ctx *gin.Context) {
 	driver := models.InputDriver{}
-	if err := ctx.BindJSON(&driver); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
+	if err := bindJSON(ctx, &driver); err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	createdDriver, err := p.driverservice.CreateDriver(ctx, driver)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -158,37 +151,135 @@ func (p *driverHandlerImpl) CreateDriver(ctx *gin.Context) {
 // @Router /drivers/{id} [put]
 func (p *driverHandlerImpl) EditDriver(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	driver, err := p.driverservice.GetDriversByID(ctx, uint64(id))
+	driver, err := p.driverservice.GetDriversByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-        return
-    }
-    if driver.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Driver not found"})
+        ctx.Error(err)
         return
     }
 
-    
-    if err := ctx.ShouldBindJSON(&driver); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
-        return
-    }
 	inputDriver := models.InputDriver{}
 	inputDriver.Name = driver.Name
 	inputDriver.NIK = driver.NIK
 	inputDriver.Phone = driver.Phone
 	inputDriver.DailyCost = driver.DailyCost
+	inputDriver.BranchID = driver.BranchID
+	inputDriver.LicenseNumber = driver.LicenseNumber
+	inputDriver.LicenseClass = driver.LicenseClass
+	inputDriver.IncentiveTier = driver.IncentiveTier
+	if driver.LicenseExpiry != nil {
+		inputDriver.LicenseExpiry = driver.LicenseExpiry.Format(models.DateLayout)
+	}
+    if err := bindJSON(ctx, &inputDriver); err != nil {
+        ctx.Error(err)
+        return
+    }
 
-    updatedDriver, err := p.driverservice.EditDriver(ctx, uint64(id), inputDriver)
+    updatedDriver, err := p.driverservice.EditDriver(ctx, id, inputDriver)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
     ctx.JSON(http.StatusOK, updatedDriver)
 }
+
+// RestoreDriverByID godoc
+// @Summary Restore a deleted driver
+// @Description Bring back a soft-deleted driver by its ID.
+// @Tags drivers
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Success 200 {object} map[string]any "Driver successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Driver not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /drivers/{id}/restore [post]
+func (p *driverHandlerImpl) RestoreDriverByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	driver, err := p.driverservice.RestoreDriver(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driver": driver,
+		"message": "Your driver has been successfully restored",
+	})
+}
+
+// GetExpiringDocuments godoc
+// @Summary Report driver licenses and documents expiring soon
+// @Description List the driving licenses and other documents, such as health certificates, that have expired or will within the given window, soonest first, after the drivers with no license number or expiry on record, which are marked missing. Drivers whose license runs out before a booking ends cannot be assigned to it.
+// @Tags drivers
+// @Accept json
+// @Produce json
+// @Param within query string false "Window in days such as 30d or 30, 30 days when omitted"
+// @Success 200 {array} models.DriverExpiry "Expiring licenses and documents"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid within"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /drivers/expiring [get]
+func (p *driverHandlerImpl) GetExpiringDocuments(ctx *gin.Context) {
+	within, err := queryDays(ctx, "within", 30)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	expiring, err := p.driverservice.GetExpiringDocuments(ctx, within)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(expiring) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No expiring driver document found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, expiring)
+}
+
+// GetDriverStats godoc
+// @Summary Retrieve a driver's performance stats
+// @Description Report a driver's trips completed, days driven, utilization of the days the driver was available, incentive earned, average rating and cancellations over a range of days, the last 30 days when omitted.
+// @Tags drivers
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Param from query string false "First day of the range (DD/MM/YYYY)"
+// @Param to query string false "Last day of the range (DD/MM/YYYY)"
+// @Success 200 {object} models.DriverStats "Driver stats"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid range"
+// @Failure 404 {object} pkg.ErrorResponse "Driver not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /drivers/{id}/stats [get]
+func (p *driverHandlerImpl) GetDriverStats(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var filter models.DriverStatsFilter
+	if err := bindQuery(ctx, &filter); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	stats, err := p.driverservice.GetDriverStats(ctx, id, filter)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, stats)
+}
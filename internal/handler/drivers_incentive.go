@@ -6,7 +6,6 @@ import (
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -19,6 +18,7 @@ type DriverIncentiveHandler interface {
 	EditDriverIncentive(ctx *gin.Context)
 	GetTotalDriversIncentiveByDriverID(ctx *gin.Context)
 	GetDriverIncentivesByDriverID(ctx *gin.Context)
+	RestoreDriverIncentiveByID(ctx *gin.Context)
 }
 
 type driverIncentiveHandlerImpl struct {
@@ -36,14 +36,15 @@ func NewDriverIncentiveHandler(driversincentiveervice service.DriversIncentivese
 // @Tags driverIncentives
 // @Accept json
 // @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success 200 {array} models.DriverIncentive "List of driver incentives"
 // @Success 404 {object} pkg.ErrorResponse "No driver incentive found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /driver-incentives [get]
 func (p *driverIncentiveHandlerImpl) GetDriversincentive(ctx *gin.Context) {
-	driversincentive, err := p.driversincentiveervice.GetDriversIncentive(ctx)
+	driversincentive, err := p.driversincentiveervice.GetDriversIncentive(ctx, includeDeleted(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(driversincentive) == 0 {
@@ -66,19 +67,15 @@ func (p *driverIncentiveHandlerImpl) GetDriversincentive(ctx *gin.Context) {
 // @Router /driver-incentives/{id} [get]
 func (p *driverIncentiveHandlerImpl) GetDriverIncentiveByID(ctx *gin.Context) {
 	// get driverIncentive ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid driverIncentive ID"})
+		ctx.Error(err)
 		return
 	}
 
 	driverIncentive, err := p.driversincentiveervice.GetDriversIncentiveByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if driverIncentive.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "driverIncentive not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -98,26 +95,19 @@ func (p *driverIncentiveHandlerImpl) GetDriverIncentiveByID(ctx *gin.Context) {
 // @Router /driver-incentives/{id} [delete]
 func (p *driverIncentiveHandlerImpl) DeleteDriverIncentiveByID(ctx *gin.Context) {
 	// Get driverIncentive ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	// Delete driverIncentive by ID
-	driverIncentive, err := p.driversincentiveervice.DeleteDriverIncentive(ctx, uint64(id))
+	driverIncentive, err := p.driversincentiveervice.DeleteDriverIncentive(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-
-	// Check if the driverIncentive exists
-	if driverIncentive.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "DriverIncentive not found"})
+		ctx.Error(err)
 		return
 	}
 
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"driverIncentive":    driverIncentive,
 		"message": "Your driverIncentive has been successfully deleted",
@@ -136,18 +126,18 @@ func (p *driverIncentiveHandlerImpl) DeleteDriverIncentiveByID(ctx *gin.Context)
 // @Router /driver-incentives [post]
 func (p *driverIncentiveHandlerImpl) CreateDriverIncentive(ctx *gin.Context) {
 	driverIncentive := models.InputDriverIncentive{}
-	if err := ctx.BindJSON(&driverIncentive); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
+	if err := bindJSON(ctx, &driverIncentive); err != nil {
+		ctx.Error(err)
 		return
 	}
 	_, err := p.bookingservice.GetBookingsByID(ctx, uint64(driverIncentive.BookingID))
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Booking not found"})
+		ctx.Error(err)
 		return
 	}
 	createdDriverIncentive, err := p.driversincentiveervice.CreateDriverIncentive(ctx, driverIncentive)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -167,38 +157,32 @@ func (p *driverIncentiveHandlerImpl) CreateDriverIncentive(ctx *gin.Context) {
 // @Router /driver-incentives/{id} [put]
 func (p *driverIncentiveHandlerImpl) EditDriverIncentive(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	driverIncentive, err := p.driversincentiveervice.GetDriversIncentiveByID(ctx, uint64(id))
+	driverIncentive, err := p.driversincentiveervice.GetDriversIncentiveByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-        return
-    }
-
-    if driverIncentive.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "DriverIncentive not found"})
+        ctx.Error(err)
         return
     }
 
-    
-    if err := ctx.ShouldBindJSON(&driverIncentive); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+    if err := bindJSON(ctx, &driverIncentive); err != nil {
+        ctx.Error(err)
         return
     }
 	_, err = p.bookingservice.GetBookingsByID(ctx, uint64(*driverIncentive.BookingID))
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Booking not found"})
+		ctx.Error(err)
 		return
 	}
 	inputDriverIncentive := models.InputDriverIncentive{}
 	inputDriverIncentive.BookingID = *driverIncentive.BookingID
 	inputDriverIncentive.Incentive = driverIncentive.Incentive
-    updatedDriverIncentive, err := p.driversincentiveervice.EditDriverIncentive(ctx, uint64(id), inputDriverIncentive)
+    updatedDriverIncentive, err := p.driversincentiveervice.EditDriverIncentive(ctx, id, inputDriverIncentive)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
@@ -210,30 +194,42 @@ func (p *driverIncentiveHandlerImpl) EditDriverIncentive(ctx *gin.Context) {
 // @Tags Drivers Incentive
 // @Produce json
 // @Param id path int true "Driver ID"
+// @Param from query string false "Earliest booking end date (DD/MM/YYYY)"
+// @Param to query string false "Latest booking end date (DD/MM/YYYY)"
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Incentives per page (default 50, max 500)"
 // @Success 200 {array} models.DriverIncentive "List of Incentives"
+// @Header 200 {integer} X-Total-Count "Number of incentives matching the filter"
 // @Success 404 {object} map[string]string "No driverIncentive found"
 // @Failure 400 {object} pkg.ErrorResponse "Invalid Driver ID"
 // @Failure 500 {object} pkg.ErrorResponse "Driver not found / Internal Server Error"
 // @Router /driver-incentives/driver/{id} [get]
 func (p *driverIncentiveHandlerImpl) GetDriverIncentivesByDriverID(ctx *gin.Context) {
 	
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	_, err = p.driverservice.GetDriversByID(ctx, uint64(id))
+	_, err = p.driverservice.GetDriversByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "Driver not found"})
+		ctx.Error(err)
+		return
+	}
+
+	var filter models.IncentiveFilter
+	if err := bindQuery(ctx, &filter); err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	driverIncentive, err := p.driversincentiveervice.GetDriverIncentivesByDriverID(ctx, uint64(id))
+	driverIncentive, total, err := p.driversincentiveervice.GetDriverIncentivesByDriverID(ctx, id, filter)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
+	ctx.Header("X-Total-Count", strconv.FormatInt(total, 10))
 
 	if len(driverIncentive) == 0 {
         ctx.JSON(http.StatusOK, gin.H{"message": "No driverIncentive found"})
@@ -248,26 +244,35 @@ func (p *driverIncentiveHandlerImpl) GetDriverIncentivesByDriverID(ctx *gin.Cont
 // @Tags Drivers Incentive
 // @Produce json
 // @Param id path int true "Driver ID"
+// @Param from query string false "Earliest booking end date (DD/MM/YYYY)"
+// @Param to query string false "Latest booking end date (DD/MM/YYYY)"
 // @Success 200 {object} map[string]interface{} "Total Incentive for Driver"
 // @Failure 400 {object} pkg.ErrorResponse "Invalid Driver ID"
 // @Failure 500 {object} pkg.ErrorResponse "Driver not found / Internal Server Error"
 // @Router /driver-incentives/driver/{id}/total [get]
 func (p *driverIncentiveHandlerImpl) GetTotalDriversIncentiveByDriverID(ctx *gin.Context) {
 	
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	total, err := p.driversincentiveervice.GetTotalDriversIncentiveByDriverID(ctx, uint64(id))
+	driver, err := p.driverservice.GetDriversByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
+		return
+	}
+
+	var filter models.IncentiveFilter
+	if err := bindQuery(ctx, &filter); err != nil {
+		ctx.Error(err)
 		return
 	}
-	driver, err := p.driverservice.GetDriversByID(ctx, uint64(id))
+
+	total, err := p.driversincentiveervice.GetTotalDriversIncentiveByDriverID(ctx, id, filter)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: "Driver not found"})
+		ctx.Error(err)
 		return
 	}
 	response := map[string]interface{}{
@@ -278,4 +283,35 @@ func (p *driverIncentiveHandlerImpl) GetTotalDriversIncentiveByDriverID(ctx *gin
 	}
 
 	ctx.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+}
+
+// RestoreDriverIncentiveByID godoc
+// @Summary Restore a deleted driverIncentive
+// @Description Bring back a soft-deleted driverIncentive by its ID.
+// @Tags driverIncentives
+// @Accept json
+// @Produce json
+// @Param id path int true "DriverIncentive ID"
+// @Success 200 {object} map[string]any "DriverIncentive successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "DriverIncentive not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /driver-incentives/{id}/restore [post]
+func (p *driverIncentiveHandlerImpl) RestoreDriverIncentiveByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	driverIncentive, err := p.driversincentiveervice.RestoreDriverIncentive(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"driverIncentive": driverIncentive,
+		"message": "Your driverIncentive has been successfully restored",
+	})
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExtraHandler interface {
+	GetExtras(ctx *gin.Context)
+	GetExtraByID(ctx *gin.Context)
+	DeleteExtraByID(ctx *gin.Context)
+	CreateExtra(ctx *gin.Context)
+	EditExtra(ctx *gin.Context)
+	RestoreExtraByID(ctx *gin.Context)
+}
+
+type extraHandlerImpl struct {
+	extraservice service.Extraservice
+}
+
+func NewExtraHandler(extraservice service.Extraservice) ExtraHandler {
+	return &extraHandlerImpl{extraservice: extraservice}
+}
+
+// GetExtras godoc
+// @Summary Retrieve list of extras
+// @Description Retrieve the catalogue of extras that can be added to a booking.
+// @Tags extras
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.Extra "List of extras"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /extras [get]
+func (p *extraHandlerImpl) GetExtras(ctx *gin.Context) {
+	extras, err := p.extraservice.GetExtras(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(extras) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No extra found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, extras)
+}
+
+// GetExtraByID godoc
+// @Summary Retrieve extra by ID
+// @Description Retrieve an extra by its unique ID.
+// @Tags extras
+// @Accept json
+// @Produce json
+// @Param id path int true "Extra ID"
+// @Success 200 {object} models.Extra "Extra details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Extra not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /extras/{id} [get]
+func (p *extraHandlerImpl) GetExtraByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	extra, err := p.extraservice.GetExtrasByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, extra)
+}
+
+// DeleteExtraByID godoc
+// @Summary Delete extra by ID
+// @Description Remove an extra from the catalogue once no open booking includes it.
+// @Tags extras
+// @Accept json
+// @Produce json
+// @Param id path int true "Extra ID"
+// @Success 200 {object} map[string]any "Extra successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Extra not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still on open bookings"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /extras/{id} [delete]
+func (p *extraHandlerImpl) DeleteExtraByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	extra, err := p.extraservice.DeleteExtra(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"extra":   extra,
+		"message": "Your extra has been successfully deleted",
+	})
+}
+
+// CreateExtra godoc
+// @Summary Create a new extra
+// @Description Add an extra priced per day or per booking, with the number the company owns.
+// @Tags extras
+// @Accept json
+// @Produce json
+// @Param extra body models.InputExtra true "Extra data"
+// @Success 201 {object} models.Extra "Created extra"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /extras [post]
+func (p *extraHandlerImpl) CreateExtra(ctx *gin.Context) {
+	extra := models.InputExtra{}
+	if err := bindJSON(ctx, &extra); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdExtra, err := p.extraservice.CreateExtra(ctx, extra)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdExtra)
+}
+
+// EditExtra godoc
+// @Summary Update extra
+// @Description Modify an extra. New prices apply to bookings made or edited from now on.
+// @Tags extras
+// @Accept json
+// @Produce json
+// @Param id path int true "Extra ID"
+// @Param extra body models.InputExtra true "Updated extra data"
+// @Success 200 {object} models.Extra "Updated extra"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Extra not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /extras/{id} [put]
+func (p *extraHandlerImpl) EditExtra(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	extra, err := p.extraservice.GetExtrasByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputExtra := models.InputExtra{}
+	inputExtra.Name = extra.Name
+	inputExtra.Description = extra.Description
+	inputExtra.Pricing = extra.Pricing
+	inputExtra.Price = extra.Price
+	inputExtra.Stock = extra.Stock
+	if err := bindJSON(ctx, &inputExtra); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedExtra, err := p.extraservice.EditExtra(ctx, id, inputExtra)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedExtra)
+}
+
+// RestoreExtraByID godoc
+// @Summary Restore a deleted extra
+// @Description Bring back a soft-deleted extra by its ID.
+// @Tags extras
+// @Accept json
+// @Produce json
+// @Param id path int true "Extra ID"
+// @Success 200 {object} map[string]any "Extra successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Extra not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /extras/{id}/restore [post]
+func (p *extraHandlerImpl) RestoreExtraByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	extra, err := p.extraservice.RestoreExtra(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"extra":   extra,
+		"message": "Your extra has been successfully restored",
+	})
+}
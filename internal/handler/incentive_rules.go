@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IncentiveRuleHandler interface {
+	GetIncentiveRules(ctx *gin.Context)
+	GetIncentiveRuleByID(ctx *gin.Context)
+	GetCurrentIncentiveRule(ctx *gin.Context)
+	DeleteIncentiveRuleByID(ctx *gin.Context)
+	CreateIncentiveRule(ctx *gin.Context)
+	RestoreIncentiveRuleByID(ctx *gin.Context)
+}
+
+type incentiveRuleHandlerImpl struct {
+	incentiveRuleservice service.IncentiveRuleservice
+}
+
+func NewIncentiveRuleHandler(incentiveRuleservice service.IncentiveRuleservice) IncentiveRuleHandler {
+	return &incentiveRuleHandlerImpl{incentiveRuleservice: incentiveRuleservice}
+}
+
+// GetIncentiveRules godoc
+// @Summary Retrieve list of incentive rules
+// @Description Retrieve every version of the driver incentive rules, latest first.
+// @Tags incentive-rules
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include retired versions"
+// @Success 200 {array} models.IncentiveRule "List of incentive rules"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /incentive-rules [get]
+func (p *incentiveRuleHandlerImpl) GetIncentiveRules(ctx *gin.Context) {
+	rules, err := p.incentiveRuleservice.GetIncentiveRules(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(rules) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No incentive rule found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, rules)
+}
+
+// GetIncentiveRuleByID godoc
+// @Summary Retrieve incentive rule by ID
+// @Description Retrieve one version of the incentive rules by its unique ID.
+// @Tags incentive-rules
+// @Accept json
+// @Produce json
+// @Param id path int true "Incentive rule ID"
+// @Success 200 {object} models.IncentiveRule "Incentive rule details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Incentive rule not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /incentive-rules/{id} [get]
+func (p *incentiveRuleHandlerImpl) GetIncentiveRuleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	rule, err := p.incentiveRuleservice.GetIncentiveRulesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rule)
+}
+
+// GetCurrentIncentiveRule godoc
+// @Summary Retrieve the current incentive rule
+// @Description Retrieve the version of the incentive rules new bookings with a driver are rewarded under.
+// @Tags incentive-rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.IncentiveRule "Current incentive rule"
+// @Failure 404 {object} pkg.ErrorResponse "No incentive rule in force"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /incentive-rules/current [get]
+func (p *incentiveRuleHandlerImpl) GetCurrentIncentiveRule(ctx *gin.Context) {
+	rule, err := p.incentiveRuleservice.GetCurrentIncentiveRule(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rule)
+}
+
+// DeleteIncentiveRuleByID godoc
+// @Summary Retire incentive rule by ID
+// @Description Retire a version of the incentive rules. When it was the current one, the latest version left takes over; with none left, bookings earn no incentive.
+// @Tags incentive-rules
+// @Accept json
+// @Produce json
+// @Param id path int true "Incentive rule ID"
+// @Success 200 {object} map[string]any "Incentive rule successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Incentive rule not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /incentive-rules/{id} [delete]
+func (p *incentiveRuleHandlerImpl) DeleteIncentiveRuleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	rule, err := p.incentiveRuleservice.DeleteIncentiveRule(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"incentive_rule": rule,
+		"message":        "Your incentive rule has been successfully deleted",
+	})
+}
+
+// CreateIncentiveRule godoc
+// @Summary Publish a new incentive rule version
+// @Description Publish a new version of the driver incentive rules: a percentage of the rent, a flat amount per day, a bonus for trips of at least long_trip_min_days days, an extra amount per weekend day, and per-tier overrides of the percentage and daily amount. Rules cannot be edited; publish a new version instead.
+// @Tags incentive-rules
+// @Accept json
+// @Produce json
+// @Param rule body models.InputIncentiveRule true "Incentive rule data"
+// @Success 201 {object} models.IncentiveRule "Created incentive rule"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /incentive-rules [post]
+func (p *incentiveRuleHandlerImpl) CreateIncentiveRule(ctx *gin.Context) {
+	rule := models.InputIncentiveRule{}
+	if err := bindJSON(ctx, &rule); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdRule, err := p.incentiveRuleservice.CreateIncentiveRule(ctx, rule)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdRule)
+}
+
+// RestoreIncentiveRuleByID godoc
+// @Summary Restore a retired incentive rule
+// @Description Bring back a retired version of the incentive rules. It is in force again if no later version is.
+// @Tags incentive-rules
+// @Accept json
+// @Produce json
+// @Param id path int true "Incentive rule ID"
+// @Success 200 {object} map[string]any "Incentive rule successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Incentive rule not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /incentive-rules/{id}/restore [post]
+func (p *incentiveRuleHandlerImpl) RestoreIncentiveRuleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	rule, err := p.incentiveRuleservice.RestoreIncentiveRule(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"incentive_rule": rule,
+		"message":        "Your incentive rule has been successfully restored",
+	})
+}
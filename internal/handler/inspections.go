@@ -0,0 +1,327 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InspectionHandler interface {
+	GetInspections(ctx *gin.Context)
+	GetInspectionByID(ctx *gin.Context)
+	DeleteInspectionByID(ctx *gin.Context)
+	CreateInspection(ctx *gin.Context)
+	RestoreInspectionByID(ctx *gin.Context)
+	UploadInspectionImage(ctx *gin.Context)
+	GetInspectionImage(ctx *gin.Context)
+	CompareInspections(ctx *gin.Context)
+	ChargeDamage(ctx *gin.Context)
+}
+
+type inspectionHandlerImpl struct {
+	inspectionservice service.Inspectionservice
+}
+
+func NewInspectionHandler(inspectionservice service.Inspectionservice) InspectionHandler {
+	return &inspectionHandlerImpl{inspectionservice: inspectionservice}
+}
+
+// GetInspections godoc
+// @Summary Retrieve list of inspections
+// @Description Retrieve all vehicle inspections, optionally only those of one booking.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param booking_id query int false "Only inspections of this booking"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.Inspection "List of inspections"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid booking_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections [get]
+func (p *inspectionHandlerImpl) GetInspections(ctx *gin.Context) {
+	bookingID, err := queryID(ctx, "booking_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inspections, err := p.inspectionservice.GetInspections(ctx, bookingID, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(inspections) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No inspection found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, inspections)
+}
+
+// GetInspectionByID godoc
+// @Summary Retrieve inspection by ID
+// @Description Retrieve an inspection with its damages and images.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param id path int true "Inspection ID"
+// @Success 200 {object} models.Inspection "Inspection details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Inspection not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/{id} [get]
+func (p *inspectionHandlerImpl) GetInspectionByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inspection, err := p.inspectionservice.GetInspectionsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, inspection)
+}
+
+// DeleteInspectionByID godoc
+// @Summary Delete inspection by ID
+// @Description Remove an inspection, for example one recorded against the wrong booking.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param id path int true "Inspection ID"
+// @Success 200 {object} map[string]any "Inspection successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Inspection not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/{id} [delete]
+func (p *inspectionHandlerImpl) DeleteInspectionByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inspection, err := p.inspectionservice.DeleteInspection(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"inspection": inspection,
+		"message":    "Your inspection has been successfully deleted",
+	})
+}
+
+// CreateInspection godoc
+// @Summary Record an inspection
+// @Description Record the checkout inspection of a picked up booking, or its checkin inspection at return.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param inspection body models.InputInspection true "Inspection data"
+// @Success 201 {object} models.Inspection "Created inspection"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "Inspection already recorded, or checkout inspection missing"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections [post]
+func (p *inspectionHandlerImpl) CreateInspection(ctx *gin.Context) {
+	inspection := models.InputInspection{}
+	if err := bindJSON(ctx, &inspection); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdInspection, err := p.inspectionservice.CreateInspection(ctx, inspection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdInspection)
+}
+
+// RestoreInspectionByID godoc
+// @Summary Restore a deleted inspection
+// @Description Bring back a soft-deleted inspection by its ID.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param id path int true "Inspection ID"
+// @Success 200 {object} map[string]any "Inspection successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Inspection not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking already has a newer inspection of that kind"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/{id}/restore [post]
+func (p *inspectionHandlerImpl) RestoreInspectionByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inspection, err := p.inspectionservice.RestoreInspection(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"inspection": inspection,
+		"message":    "Your inspection has been successfully restored",
+	})
+}
+
+// UploadInspectionImage godoc
+// @Summary Attach a photo to an inspection
+// @Description Upload a JPEG, PNG or WebP photo of up to 10 MB, optionally of one damage of the inspection.
+// @Tags inspections
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Inspection ID"
+// @Param image formData file true "Photo"
+// @Param damage_id formData int false "Damage the photo shows"
+// @Success 201 {object} models.InspectionImage "Stored image"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid upload"
+// @Failure 404 {object} pkg.ErrorResponse "Inspection not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/{id}/images [post]
+func (p *inspectionHandlerImpl) UploadInspectionImage(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var damageID *uint
+	if raw := ctx.PostForm("damage_id"); raw != "" {
+		parsed, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil || parsed == 0 {
+			ctx.Error(apperror.Validation("request is invalid", pkg.FieldError{Field: "damage_id", Message: "must be a valid ID"}))
+			return
+		}
+		value := uint(parsed)
+		damageID = &value
+	}
+	header, err := ctx.FormFile("image")
+	if err != nil {
+		ctx.Error(apperror.Validation("request is invalid", pkg.FieldError{Field: "image", Message: "is required"}))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	defer file.Close()
+
+	image, err := p.inspectionservice.AddInspectionImage(ctx, id, damageID, header.Filename, header.Size, file)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, image)
+}
+
+// GetInspectionImage godoc
+// @Summary Download an inspection photo
+// @Description Return the stored photo file.
+// @Tags inspections
+// @Produce image/jpeg,image/png,image/webp
+// @Param id path int true "Inspection ID"
+// @Param image_id path int true "Image ID"
+// @Success 200 {file} file "Photo"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Image not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/{id}/images/{image_id} [get]
+func (p *inspectionHandlerImpl) GetInspectionImage(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	imageID, err := pathParamID(ctx, "image_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	image, content, err := p.inspectionservice.OpenInspectionImage(ctx, id, imageID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	defer content.Close()
+
+	ctx.DataFromReader(http.StatusOK, image.Size, image.ContentType, content, nil)
+}
+
+// CompareInspections godoc
+// @Summary Compare pickup and return of a booking
+// @Description Show the damage, missing checklist items, distance and fuel change between the checkout and checkin inspections of a booking.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} models.InspectionComparison "Comparison"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Checkout or checkin inspection missing"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/compare/{id} [get]
+func (p *inspectionHandlerImpl) CompareInspections(ctx *gin.Context) {
+	bookingID, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	comparison, err := p.inspectionservice.CompareInspections(ctx, bookingID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comparison)
+}
+
+// ChargeDamage godoc
+// @Summary Charge new damage to the booking
+// @Description Bill the repair cost of the damage found at return and not present at pickup to the booking, no more than the excess of its insurance plan.
+// @Tags inspections
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} models.Booking "Booking with its damage charge"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Booking not found"
+// @Failure 409 {object} pkg.ErrorResponse "Booking cancelled, not returned or already paid, or checkout or checkin inspection missing"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /inspections/compare/{id}/charge [post]
+func (p *inspectionHandlerImpl) ChargeDamage(ctx *gin.Context) {
+	bookingID, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	booking, err := p.inspectionservice.ChargeDamage(ctx, bookingID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, booking)
+}
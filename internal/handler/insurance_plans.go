@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InsurancePlanHandler interface {
+	GetInsurancePlans(ctx *gin.Context)
+	GetInsurancePlanByID(ctx *gin.Context)
+	DeleteInsurancePlanByID(ctx *gin.Context)
+	CreateInsurancePlan(ctx *gin.Context)
+	EditInsurancePlan(ctx *gin.Context)
+	RestoreInsurancePlanByID(ctx *gin.Context)
+}
+
+type insurancePlanHandlerImpl struct {
+	insurancePlanservice service.InsurancePlanservice
+}
+
+func NewInsurancePlanHandler(insurancePlanservice service.InsurancePlanservice) InsurancePlanHandler {
+	return &insurancePlanHandlerImpl{insurancePlanservice: insurancePlanservice}
+}
+
+// GetInsurancePlans godoc
+// @Summary Retrieve list of insurance plans
+// @Description Retrieve the coverage plans a booking can be insured with.
+// @Tags insurance-plans
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.InsurancePlan "List of insurance plans"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /insurance-plans [get]
+func (p *insurancePlanHandlerImpl) GetInsurancePlans(ctx *gin.Context) {
+	plans, err := p.insurancePlanservice.GetInsurancePlans(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(plans) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No insurance plan found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, plans)
+}
+
+// GetInsurancePlanByID godoc
+// @Summary Retrieve insurance plan by ID
+// @Description Retrieve an insurance plan by its unique ID.
+// @Tags insurance-plans
+// @Accept json
+// @Produce json
+// @Param id path int true "Insurance plan ID"
+// @Success 200 {object} models.InsurancePlan "Insurance plan details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Insurance plan not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /insurance-plans/{id} [get]
+func (p *insurancePlanHandlerImpl) GetInsurancePlanByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	plan, err := p.insurancePlanservice.GetInsurancePlansByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, plan)
+}
+
+// DeleteInsurancePlanByID godoc
+// @Summary Delete insurance plan by ID
+// @Description Remove an insurance plan once no car category defaults to it and no open booking is insured with it.
+// @Tags insurance-plans
+// @Accept json
+// @Produce json
+// @Param id path int true "Insurance plan ID"
+// @Success 200 {object} map[string]any "Insurance plan successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Insurance plan not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by car categories or open bookings"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /insurance-plans/{id} [delete]
+func (p *insurancePlanHandlerImpl) DeleteInsurancePlanByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	plan, err := p.insurancePlanservice.DeleteInsurancePlan(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"insurance_plan": plan,
+		"message":        "Your insurance plan has been successfully deleted",
+	})
+}
+
+// CreateInsurancePlan godoc
+// @Summary Create a new insurance plan
+// @Description Add a coverage plan with its daily premium and the excess the customer pays of any damage.
+// @Tags insurance-plans
+// @Accept json
+// @Produce json
+// @Param plan body models.InputInsurancePlan true "Insurance plan data"
+// @Success 201 {object} models.InsurancePlan "Created insurance plan"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /insurance-plans [post]
+func (p *insurancePlanHandlerImpl) CreateInsurancePlan(ctx *gin.Context) {
+	plan := models.InputInsurancePlan{}
+	if err := bindJSON(ctx, &plan); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdPlan, err := p.insurancePlanservice.CreateInsurancePlan(ctx, plan)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdPlan)
+}
+
+// EditInsurancePlan godoc
+// @Summary Update insurance plan
+// @Description Modify an insurance plan. New terms apply to bookings made or edited from now on.
+// @Tags insurance-plans
+// @Accept json
+// @Produce json
+// @Param id path int true "Insurance plan ID"
+// @Param plan body models.InputInsurancePlan true "Updated insurance plan data"
+// @Success 200 {object} models.InsurancePlan "Updated insurance plan"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Insurance plan not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /insurance-plans/{id} [put]
+func (p *insurancePlanHandlerImpl) EditInsurancePlan(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	plan, err := p.insurancePlanservice.GetInsurancePlansByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputPlan := models.InputInsurancePlan{}
+	inputPlan.Name = plan.Name
+	inputPlan.Description = plan.Description
+	inputPlan.DailyPremium = plan.DailyPremium
+	inputPlan.Excess = plan.Excess
+	if err := bindJSON(ctx, &inputPlan); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedPlan, err := p.insurancePlanservice.EditInsurancePlan(ctx, id, inputPlan)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedPlan)
+}
+
+// RestoreInsurancePlanByID godoc
+// @Summary Restore a deleted insurance plan
+// @Description Bring back a soft-deleted insurance plan by its ID.
+// @Tags insurance-plans
+// @Accept json
+// @Produce json
+// @Param id path int true "Insurance plan ID"
+// @Success 200 {object} map[string]any "Insurance plan successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Insurance plan not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /insurance-plans/{id}/restore [post]
+func (p *insurancePlanHandlerImpl) RestoreInsurancePlanByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	plan, err := p.insurancePlanservice.RestoreInsurancePlan(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"insurance_plan": plan,
+		"message":        "Your insurance plan has been successfully restored",
+	})
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MaintenanceHandler interface {
+	GetMaintenanceRecords(ctx *gin.Context)
+	GetMaintenanceRecordByID(ctx *gin.Context)
+	DeleteMaintenanceRecordByID(ctx *gin.Context)
+	CreateMaintenanceRecord(ctx *gin.Context)
+	EditMaintenanceRecord(ctx *gin.Context)
+	RestoreMaintenanceRecordByID(ctx *gin.Context)
+	GetDueMaintenance(ctx *gin.Context)
+}
+
+type maintenanceHandlerImpl struct {
+	maintenanceservice service.Maintenanceservice
+}
+
+func NewMaintenanceHandler(maintenanceservice service.Maintenanceservice) MaintenanceHandler {
+	return &maintenanceHandlerImpl{maintenanceservice: maintenanceservice}
+}
+
+// GetMaintenanceRecords godoc
+// @Summary Retrieve list of maintenance records
+// @Description Retrieve all maintenance records, optionally only those of one vehicle.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param vehicle_id query int false "Only records of this vehicle"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.MaintenanceRecord "List of maintenance records"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid vehicle_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance [get]
+func (p *maintenanceHandlerImpl) GetMaintenanceRecords(ctx *gin.Context) {
+	vehicleID, err := queryID(ctx, "vehicle_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	records, err := p.maintenanceservice.GetMaintenanceRecords(ctx, vehicleID, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(records) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No maintenance record found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, records)
+}
+
+// GetMaintenanceRecordByID godoc
+// @Summary Retrieve maintenance record by ID
+// @Description Retrieve a maintenance record by its unique ID.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Maintenance record ID"
+// @Success 200 {object} models.MaintenanceRecord "Maintenance record details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Maintenance record not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance/{id} [get]
+func (p *maintenanceHandlerImpl) GetMaintenanceRecordByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	record, err := p.maintenanceservice.GetMaintenanceRecordsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, record)
+}
+
+// DeleteMaintenanceRecordByID godoc
+// @Summary Delete maintenance record by ID
+// @Description Remove a maintenance record, which frees its vehicle for the planned window again.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Maintenance record ID"
+// @Success 200 {object} map[string]any "Maintenance record successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Maintenance record not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance/{id} [delete]
+func (p *maintenanceHandlerImpl) DeleteMaintenanceRecordByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	record, err := p.maintenanceservice.DeleteMaintenanceRecord(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"maintenance_record": record,
+		"message":            "Your maintenance record has been successfully deleted",
+	})
+}
+
+// CreateMaintenanceRecord godoc
+// @Summary Plan or log maintenance
+// @Description Add a maintenance record for a vehicle. Until it is completed the vehicle cannot be booked during the planned window.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param maintenance body models.InputMaintenanceRecord true "Maintenance data"
+// @Success 201 {object} models.MaintenanceRecord "Created maintenance record"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance [post]
+func (p *maintenanceHandlerImpl) CreateMaintenanceRecord(ctx *gin.Context) {
+	record := models.InputMaintenanceRecord{}
+	if err := bindJSON(ctx, &record); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdRecord, err := p.maintenanceservice.CreateMaintenanceRecord(ctx, record)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdRecord)
+}
+
+// EditMaintenanceRecord godoc
+// @Summary Update maintenance record
+// @Description Modify a maintenance record, for example to complete it with the date and odometer reading.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Maintenance record ID"
+// @Param maintenance body models.InputMaintenanceRecord true "Updated maintenance data"
+// @Success 200 {object} models.MaintenanceRecord "Updated maintenance record"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Maintenance record not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance/{id} [put]
+func (p *maintenanceHandlerImpl) EditMaintenanceRecord(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	record, err := p.maintenanceservice.GetMaintenanceRecordsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputRecord := models.InputMaintenanceRecord{}
+	inputRecord.VehicleID = record.VehicleID
+	inputRecord.Type = record.Type
+	inputRecord.PlannedStart = record.PlannedStart.Format(models.DateLayout)
+	inputRecord.PlannedEnd = record.PlannedEnd.Format(models.DateLayout)
+	if record.CompletedAt != nil {
+		inputRecord.CompletedAt = record.CompletedAt.Format(models.DateLayout)
+	}
+	inputRecord.Odometer = record.Odometer
+	inputRecord.Cost = record.Cost
+	inputRecord.Workshop = record.Workshop
+	inputRecord.Notes = record.Notes
+	if err := bindJSON(ctx, &inputRecord); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedRecord, err := p.maintenanceservice.EditMaintenanceRecord(ctx, id, inputRecord)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedRecord)
+}
+
+// RestoreMaintenanceRecordByID godoc
+// @Summary Restore a deleted maintenance record
+// @Description Bring back a soft-deleted maintenance record by its ID.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param id path int true "Maintenance record ID"
+// @Success 200 {object} map[string]any "Maintenance record successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Maintenance record not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance/{id}/restore [post]
+func (p *maintenanceHandlerImpl) RestoreMaintenanceRecordByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	record, err := p.maintenanceservice.RestoreMaintenanceRecord(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"maintenance_record": record,
+		"message":            "Your maintenance record has been successfully restored",
+	})
+}
+
+// GetDueMaintenance godoc
+// @Summary List vehicles due for service
+// @Description List vehicles whose next service (every 10,000 km or 6 months) is due or coming up, overdue ones first.
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Param within_days query int false "Also list services due within this many days (default 14)"
+// @Param within_km query int false "Also list services due within this many km (default 500)"
+// @Success 200 {array} models.MaintenanceDue "Vehicles due for service"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid query parameter"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /maintenance/due [get]
+func (p *maintenanceHandlerImpl) GetDueMaintenance(ctx *gin.Context) {
+	withinDays, err := queryInt(ctx, "within_days", 14)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	withinKm, err := queryInt(ctx, "within_km", 500)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	dues, err := p.maintenanceservice.GetDueMaintenance(ctx, withinDays, withinKm)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dues)
+}
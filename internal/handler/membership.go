@@ -2,11 +2,9 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"car-rental/internal/models"
 	"car-rental/internal/service"
-	"car-rental/pkg"
 
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +15,7 @@ type MembershipHandler interface {
 	DeleteMembershipByID(ctx *gin.Context)
 	CreateMembership(ctx *gin.Context)
 	EditMembership(ctx *gin.Context)
+	RestoreMembershipByID(ctx *gin.Context)
 }
 
 type membershipHandlerImpl struct {
@@ -32,14 +31,15 @@ func NewMembershipHandler(membershipservice service.Membershipservice) Membershi
 // @Tags memberships
 // @Accept json
 // @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
 // @Success 200 {array} models.Membership "List of memberships"
 // @Success 404 {object} pkg.ErrorResponse "No membership found"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /memberships [get]
 func (p *membershipHandlerImpl) GetMemberships(ctx *gin.Context) {
-	memberships, err := p.membershipservice.GetMemberships(ctx)
+	memberships, err := p.membershipservice.GetMemberships(ctx, includeDeleted(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 	if len(memberships) == 0 {
@@ -62,19 +62,15 @@ func (p *membershipHandlerImpl) GetMemberships(ctx *gin.Context) {
 // @Router /memberships/{id} [get]
 func (p *membershipHandlerImpl) GetMembershipByID(ctx *gin.Context) {
 	// get membership ID from path parameter
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := pathID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid membership ID"})
+		ctx.Error(err)
 		return
 	}
 
 	membership, err := p.membershipservice.GetMembershipsByID(ctx, id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-		return
-	}
-	if membership.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "membership not found"})
+		ctx.Error(err)
 		return
 	}
 
@@ -87,33 +83,35 @@ func (p *membershipHandlerImpl) GetMembershipByID(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Membership ID"
+// @Param cascade query string false "Set to reassign to move customers to another membership first" Enums(reassign)
+// @Param to query int false "Target Membership ID when cascade=reassign"
 // @Success 200 {object} map[string]any "Success message and deleted membership data"
 // @Failure 400 {object} pkg.ErrorResponse "Invalid membership ID"
 // @Failure 404 {object} pkg.ErrorResponse "Membership not found"
+// @Failure 409 {object} pkg.ErrorResponse "Still referenced by other records"
 // @Failure 500 {object} pkg.ErrorResponse "Internal server error"
 // @Router /memberships/{id} [delete]
 func (p *membershipHandlerImpl) DeleteMembershipByID(ctx *gin.Context) {
 	// Get membership ID from path parameter
-	id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-	// Delete membership by ID
-	membership, err := p.membershipservice.DeleteMembership(ctx, uint64(id))
+	reassignTo, err := reassignTarget(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
-	// Check if the membership exists
-	if membership.ID == 0 {
-		ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Membership not found"})
+	// Delete membership by ID
+	membership, err := p.membershipservice.DeleteMembership(ctx, id, reassignTo)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
-
 	ctx.JSON(http.StatusOK, map[string]any{
 		"membership":    membership,
 		"message": "Your membership has been successfully deleted",
@@ -132,14 +130,14 @@ func (p *membershipHandlerImpl) DeleteMembershipByID(ctx *gin.Context) {
 // @Router /memberships [post]
 func (p *membershipHandlerImpl) CreateMembership(ctx *gin.Context) {
 	membership := models.InputMembership{}
-	if err := ctx.BindJSON(&membership); err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: err.Error()})
+	if err := bindJSON(ctx, &membership); err != nil {
+		ctx.Error(err)
 		return
 	}
 
 	createdMembership, err := p.membershipservice.CreateMembership(ctx, membership)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+		ctx.Error(err)
 		return
 	}
 
@@ -160,34 +158,60 @@ func (p *membershipHandlerImpl) CreateMembership(ctx *gin.Context) {
 // @Router /memberships/{id} [put]
 func (p *membershipHandlerImpl) EditMembership(ctx *gin.Context) {
 	
-    id, err := strconv.Atoi(ctx.Param("id"))
-	if id == 0 || err != nil {
-		ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "invalid required param"})
+    id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
-	membership, err := p.membershipservice.GetMembershipsByID(ctx, uint64(id))
+	membership, err := p.membershipservice.GetMembershipsByID(ctx, id)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
-        return
-    }
-    if membership.ID == 0 {
-        ctx.JSON(http.StatusNotFound, pkg.ErrorResponse{Message: "Membership not found"})
+        ctx.Error(err)
         return
     }
 
-    
-    if err := ctx.ShouldBindJSON(&membership); err != nil {
-        ctx.JSON(http.StatusBadRequest, pkg.ErrorResponse{Message: "Invalid request body"})
+    if err := bindJSON(ctx, &membership); err != nil {
+        ctx.Error(err)
         return
     }
 	inputMembership := models.InputMembership{}
 	inputMembership.MembershipName = membership.MembershipName
 	inputMembership.Discount = membership.Discount
-    updatedMembership, err := p.membershipservice.EditMembership(ctx, uint64(id), inputMembership)
+    updatedMembership, err := p.membershipservice.EditMembership(ctx, id, inputMembership)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Message: err.Error()})
+        ctx.Error(err)
         return
     }
 
     ctx.JSON(http.StatusOK, updatedMembership)
 }
+
+// RestoreMembershipByID godoc
+// @Summary Restore a deleted membership
+// @Description Bring back a soft-deleted membership by its ID.
+// @Tags memberships
+// @Accept json
+// @Produce json
+// @Param id path int true "Membership ID"
+// @Success 200 {object} map[string]any "Membership successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Membership not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /memberships/{id}/restore [post]
+func (p *membershipHandlerImpl) RestoreMembershipByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	membership, err := p.membershipservice.RestoreMembership(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"membership": membership,
+		"message": "Your membership has been successfully restored",
+	})
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayoutHandler interface {
+	GetPayoutPeriods(ctx *gin.Context)
+	GetPayoutPeriodByID(ctx *gin.Context)
+	DeletePayoutPeriodByID(ctx *gin.Context)
+	CreatePayoutPeriod(ctx *gin.Context)
+	RestorePayoutPeriodByID(ctx *gin.Context)
+	GetPayoutStatement(ctx *gin.Context)
+	SettlePayoutPeriod(ctx *gin.Context)
+	GetOutstandingPayouts(ctx *gin.Context)
+}
+
+type payoutHandlerImpl struct {
+	payoutservice service.Payoutservice
+}
+
+func NewPayoutHandler(payoutservice service.Payoutservice) PayoutHandler {
+	return &payoutHandlerImpl{payoutservice: payoutservice}
+}
+
+// GetPayoutPeriods godoc
+// @Summary Retrieve list of payout periods
+// @Description Retrieve the periods drivers are paid for, latest first.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.PayoutPeriod "List of payout periods"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods [get]
+func (p *payoutHandlerImpl) GetPayoutPeriods(ctx *gin.Context) {
+	periods, err := p.payoutservice.GetPayoutPeriods(ctx, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(periods) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No payout period found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, periods)
+}
+
+// GetPayoutPeriodByID godoc
+// @Summary Retrieve payout period by ID
+// @Description Retrieve a payout period by its unique ID.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param id path int true "Payout period ID"
+// @Success 200 {object} models.PayoutPeriod "Payout period details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Payout period not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods/{id} [get]
+func (p *payoutHandlerImpl) GetPayoutPeriodByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	period, err := p.payoutservice.GetPayoutPeriodsByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, period)
+}
+
+// DeletePayoutPeriodByID godoc
+// @Summary Delete payout period by ID
+// @Description Remove a payout period that was not settled yet.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param id path int true "Payout period ID"
+// @Success 200 {object} map[string]any "Payout period successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Payout period not found"
+// @Failure 409 {object} pkg.ErrorResponse "Payout period already settled"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods/{id} [delete]
+func (p *payoutHandlerImpl) DeletePayoutPeriodByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	period, err := p.payoutservice.DeletePayoutPeriod(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"payout_period": period,
+		"message":       "Your payout period has been successfully deleted",
+	})
+}
+
+// CreatePayoutPeriod godoc
+// @Summary Create a new payout period
+// @Description Open a period drivers are paid for, from start_date to end_date, both included and in dd/mm/yyyy. Periods may not overlap.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param period body models.InputPayoutPeriod true "Payout period data"
+// @Success 201 {object} models.PayoutPeriod "Created payout period"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "Overlaps another payout period"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods [post]
+func (p *payoutHandlerImpl) CreatePayoutPeriod(ctx *gin.Context) {
+	period := models.InputPayoutPeriod{}
+	if err := bindJSON(ctx, &period); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdPeriod, err := p.payoutservice.CreatePayoutPeriod(ctx, period)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdPeriod)
+}
+
+// RestorePayoutPeriodByID godoc
+// @Summary Restore a deleted payout period
+// @Description Bring back a soft-deleted payout period by its ID.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param id path int true "Payout period ID"
+// @Success 200 {object} map[string]any "Payout period successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Payout period not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods/{id}/restore [post]
+func (p *payoutHandlerImpl) RestorePayoutPeriodByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	period, err := p.payoutservice.RestorePayoutPeriod(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"payout_period": period,
+		"message":       "Your payout period has been successfully restored",
+	})
+}
+
+// GetPayoutStatement godoc
+// @Summary Retrieve the payout statement of a period
+// @Description List what a period pays each driver, booking by booking: the driver's daily cost and the incentives of finished bookings that ended by its end date. Before settlement it shows everything still unpaid, including late items from earlier periods; afterwards, what was paid.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param id path int true "Payout period ID"
+// @Success 200 {object} models.PayoutStatement "Payout statement"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Payout period not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods/{id}/statement [get]
+func (p *payoutHandlerImpl) GetPayoutStatement(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	statement, err := p.payoutservice.GetPayoutStatement(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statement)
+}
+
+// SettlePayoutPeriod godoc
+// @Summary Settle a payout period
+// @Description Pay out a period once it has ended. Everything on its statement is marked paid so no other period pays it again.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Param id path int true "Payout period ID"
+// @Success 200 {object} models.PayoutStatement "Statement of what was paid"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Payout period not found"
+// @Failure 409 {object} pkg.ErrorResponse "Payout period already settled or not ended yet"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods/{id}/settle [post]
+func (p *payoutHandlerImpl) SettlePayoutPeriod(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	statement, err := p.payoutservice.SettlePayoutPeriod(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statement)
+}
+
+// GetOutstandingPayouts godoc
+// @Summary Report outstanding driver payouts
+// @Description List, per driver, the daily cost and incentives earned on finished bookings that were not paid out yet, largest amount first.
+// @Tags payout-periods
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.DriverOutstanding "Outstanding amounts per driver"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /payout-periods/outstanding [get]
+func (p *payoutHandlerImpl) GetOutstandingPayouts(ctx *gin.Context) {
+	outstanding, err := p.payoutservice.GetOutstandingPayouts(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(outstanding) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No outstanding payout found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, outstanding)
+}
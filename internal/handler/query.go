@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// pathID reads the :id path parameter.
+func pathID(ctx *gin.Context) (uint64, error) {
+	return pathParamID(ctx, "id")
+}
+
+// pathParamID reads a numeric ID path parameter such as :image_id.
+func pathParamID(ctx *gin.Context, name string) (uint64, error) {
+	id, err := strconv.ParseUint(ctx.Param(name), 10, 64)
+	if id == 0 || err != nil {
+		return 0, apperror.Validation("invalid required param").WithCode("invalid_param")
+	}
+	return id, nil
+}
+
+// bindJSON decodes the request body into obj. Field rules are not checked
+// here: services validate their inputs so that every failure, including
+// those needing a database lookup, is reported in one response.
+func bindJSON(ctx *gin.Context, obj any) error {
+	if ctx.Request.Body == nil {
+		return validation.FromDecode(io.EOF)
+	}
+	if err := json.NewDecoder(ctx.Request.Body).Decode(obj); err != nil {
+		return validation.FromDecode(err)
+	}
+	return nil
+}
+
+// bindQuery decodes the query string into obj through its form tags. As
+// with bindJSON, field rules are left to the services.
+func bindQuery(ctx *gin.Context, obj any) error {
+	if err := binding.MapFormWithTag(obj, ctx.Request.URL.Query(), "form"); err != nil {
+		return apperror.Validation("query parameters are not valid: " + err.Error())
+	}
+	return nil
+}
+
+// includeDeleted reports whether the caller asked for soft-deleted rows
+// through ?include_deleted=true.
+func includeDeleted(ctx *gin.Context) bool {
+	include, _ := strconv.ParseBool(ctx.Query("include_deleted"))
+	return include
+}
+
+// reassignTarget reads ?cascade=reassign&to=<id> on delete endpoints. It
+// returns nil when no cascade was asked for.
+func reassignTarget(ctx *gin.Context) (*uint64, error) {
+	cascade := ctx.Query("cascade")
+	if cascade == "" {
+		return nil, nil
+	}
+	if cascade != "reassign" {
+		return nil, apperror.Validation("cascade must be reassign")
+	}
+	to, err := strconv.ParseUint(ctx.Query("to"), 10, 64)
+	if err != nil || to == 0 {
+		return nil, apperror.Validation("to must be a valid ID when cascade=reassign")
+	}
+	return &to, nil
+}
+
+// queryID reads an optional ID filter such as ?car_id=3. It returns 0 when
+// the parameter is absent.
+func queryID(ctx *gin.Context, name string) (uint64, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil || id == 0 {
+		return 0, apperror.Validation("request is invalid", pkg.FieldError{Field: name, Message: "must be a valid ID"})
+	}
+	return id, nil
+}
+
+// queryInt reads an optional non-negative number such as ?within_days=30,
+// falling back to def when the parameter is absent.
+func queryInt(ctx *gin.Context, name string, def int) (int, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		return 0, apperror.Validation("request is invalid", pkg.FieldError{Field: name, Message: "must be a non-negative number"})
+	}
+	return n, nil
+}
+
+// queryDays reads an optional number of days such as ?within=30d, where the
+// d suffix may be left out, falling back to def when the parameter is absent.
+func queryDays(ctx *gin.Context, name string, def int) (int, error) {
+	raw := ctx.Query(name)
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(raw, "d"))
+	if err != nil || n < 0 {
+		return 0, apperror.Validation("request is invalid", pkg.FieldError{Field: name, Message: "must be a number of days such as 30d"})
+	}
+	return n, nil
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransferHandler interface {
+	GetTransfers(ctx *gin.Context)
+	GetTransferByID(ctx *gin.Context)
+	RequestTransfer(ctx *gin.Context)
+	ShipTransfer(ctx *gin.Context)
+	ReceiveTransfer(ctx *gin.Context)
+	CancelTransfer(ctx *gin.Context)
+}
+
+type transferHandlerImpl struct {
+	transferservice service.Transferservice
+}
+
+func NewTransferHandler(transferservice service.Transferservice) TransferHandler {
+	return &transferHandlerImpl{transferservice: transferservice}
+}
+
+// GetTransfers godoc
+// @Summary Retrieve list of vehicle transfers
+// @Description Retrieve all vehicle transfers between branches, newest first, optionally only those of one vehicle.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param vehicle_id query int false "Only transfers of this vehicle"
+// @Success 200 {array} models.VehicleTransfer "List of vehicle transfers"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid vehicle_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /transfers [get]
+func (p *transferHandlerImpl) GetTransfers(ctx *gin.Context) {
+	vehicleID, err := queryID(ctx, "vehicle_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	transfers, err := p.transferservice.GetTransfers(ctx, vehicleID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(transfers) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No vehicle transfer found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, transfers)
+}
+
+// GetTransferByID godoc
+// @Summary Retrieve vehicle transfer by ID
+// @Description Retrieve a vehicle transfer by its unique ID.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle transfer ID"
+// @Success 200 {object} models.VehicleTransfer "Vehicle transfer details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle transfer not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /transfers/{id} [get]
+func (p *transferHandlerImpl) GetTransferByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	transfer, err := p.transferservice.GetTransfersByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfer)
+}
+
+// RequestTransfer godoc
+// @Summary Request a vehicle transfer
+// @Description Plan moving a vehicle from the branch it is at to another branch.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param transfer body models.InputVehicleTransfer true "Vehicle and destination branch"
+// @Success 201 {object} models.VehicleTransfer "Requested vehicle transfer"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "Vehicle already has an open transfer"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /transfers [post]
+func (p *transferHandlerImpl) RequestTransfer(ctx *gin.Context) {
+	transfer := models.InputVehicleTransfer{}
+	if err := bindJSON(ctx, &transfer); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdTransfer, err := p.transferservice.RequestTransfer(ctx, transfer)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdTransfer)
+}
+
+// ShipTransfer godoc
+// @Summary Ship a vehicle transfer
+// @Description Send the vehicle on its way. It cannot be rented until it is received.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle transfer ID"
+// @Success 200 {object} models.VehicleTransfer "Vehicle transfer in transit"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle transfer not found"
+// @Failure 409 {object} pkg.ErrorResponse "Transfer is not requested, or vehicle is out on a booking"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /transfers/{id}/ship [post]
+func (p *transferHandlerImpl) ShipTransfer(ctx *gin.Context) {
+	p.advance(ctx, p.transferservice.ShipTransfer)
+}
+
+// ReceiveTransfer godoc
+// @Summary Receive a vehicle transfer
+// @Description Take the vehicle in at the destination branch, where it can be rented from now on.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle transfer ID"
+// @Success 200 {object} models.VehicleTransfer "Completed vehicle transfer"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle transfer not found"
+// @Failure 409 {object} pkg.ErrorResponse "Transfer is not in transit"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /transfers/{id}/receive [post]
+func (p *transferHandlerImpl) ReceiveTransfer(ctx *gin.Context) {
+	p.advance(ctx, p.transferservice.ReceiveTransfer)
+}
+
+// CancelTransfer godoc
+// @Summary Cancel a vehicle transfer
+// @Description Call off a transfer that was not received yet; the vehicle stays at its branch.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle transfer ID"
+// @Success 200 {object} models.VehicleTransfer "Cancelled vehicle transfer"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle transfer not found"
+// @Failure 409 {object} pkg.ErrorResponse "Transfer is already completed or cancelled"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /transfers/{id}/cancel [post]
+func (p *transferHandlerImpl) CancelTransfer(ctx *gin.Context) {
+	p.advance(ctx, p.transferservice.CancelTransfer)
+}
+
+// advance moves the transfer in the path to its next status with step.
+func (p *transferHandlerImpl) advance(ctx *gin.Context, step func(ctx context.Context, id uint64) (models.VehicleTransfer, error)) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	transfer, err := step(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfer)
+}
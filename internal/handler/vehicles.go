@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"net/http"
+
+	"car-rental/internal/models"
+	"car-rental/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VehicleHandler interface {
+	GetVehicles(ctx *gin.Context)
+	GetVehicleByID(ctx *gin.Context)
+	DeleteVehicleByID(ctx *gin.Context)
+	CreateVehicle(ctx *gin.Context)
+	EditVehicle(ctx *gin.Context)
+	RestoreVehicleByID(ctx *gin.Context)
+}
+
+type vehicleHandlerImpl struct {
+	vehicleservice service.Vehicleservice
+}
+
+func NewVehicleHandler(vehicleservice service.Vehicleservice) VehicleHandler {
+	return &vehicleHandlerImpl{vehicleservice: vehicleservice}
+}
+
+// GetVehicles godoc
+// @Summary Retrieve list of vehicles
+// @Description Retrieve all vehicle units, optionally only those of one car or at one branch.
+// @Tags vehicles
+// @Accept json
+// @Produce json
+// @Param car_id query int false "Only vehicles of this car"
+// @Param branch_id query int false "Only vehicles at this branch"
+// @Param include_deleted query bool false "Include soft-deleted records"
+// @Success 200 {array} models.Vehicle "List of vehicles"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid car_id or branch_id"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /vehicles [get]
+func (p *vehicleHandlerImpl) GetVehicles(ctx *gin.Context) {
+	carID, err := queryID(ctx, "car_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	branchID, err := queryID(ctx, "branch_id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	vehicles, err := p.vehicleservice.GetVehicles(ctx, carID, branchID, includeDeleted(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(vehicles) == 0 {
+		ctx.JSON(http.StatusOK, gin.H{"message": "No vehicle found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, vehicles)
+}
+
+// GetVehicleByID godoc
+// @Summary Retrieve vehicle by ID
+// @Description Retrieve a vehicle unit by its unique ID.
+// @Tags vehicles
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle ID"
+// @Success 200 {object} models.Vehicle "Vehicle details"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /vehicles/{id} [get]
+func (p *vehicleHandlerImpl) GetVehicleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	vehicle, err := p.vehicleservice.GetVehiclesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, vehicle)
+}
+
+// DeleteVehicleByID godoc
+// @Summary Delete vehicle by ID
+// @Description Remove a vehicle unit from the fleet.
+// @Tags vehicles
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle ID"
+// @Success 200 {object} map[string]any "Vehicle successfully deleted"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle not found"
+// @Failure 409 {object} pkg.ErrorResponse "Vehicle is out on a booking or being transferred"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /vehicles/{id} [delete]
+func (p *vehicleHandlerImpl) DeleteVehicleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	vehicle, err := p.vehicleservice.DeleteVehicle(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"vehicle": vehicle,
+		"message": "Your vehicle has been successfully deleted",
+	})
+}
+
+// CreateVehicle godoc
+// @Summary Register a new vehicle
+// @Description Add a physical unit of a car to the fleet.
+// @Tags vehicles
+// @Accept json
+// @Produce json
+// @Param vehicle body models.InputVehicle true "Vehicle data"
+// @Success 201 {object} models.Vehicle "Created vehicle"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 409 {object} pkg.ErrorResponse "Plate number or VIN already registered"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /vehicles [post]
+func (p *vehicleHandlerImpl) CreateVehicle(ctx *gin.Context) {
+	vehicle := models.InputVehicle{}
+	if err := bindJSON(ctx, &vehicle); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	createdVehicle, err := p.vehicleservice.CreateVehicle(ctx, vehicle)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, createdVehicle)
+}
+
+// EditVehicle godoc
+// @Summary Update vehicle information
+// @Description Modify details of a vehicle unit, such as grounding it by setting its status to inactive. Its branch changes through a vehicle transfer.
+// @Tags vehicles
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle ID"
+// @Param vehicle body models.InputVehicle true "Updated vehicle data"
+// @Success 200 {object} models.Vehicle "Updated vehicle"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid request body"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle not found"
+// @Failure 409 {object} pkg.ErrorResponse "Plate number or VIN already registered, or vehicle is out on a booking"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /vehicles/{id} [put]
+func (p *vehicleHandlerImpl) EditVehicle(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	vehicle, err := p.vehicleservice.GetVehiclesByID(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	inputVehicle := models.InputVehicle{}
+	inputVehicle.CarID = vehicle.CarID
+	inputVehicle.BranchID = vehicle.BranchID
+	inputVehicle.PlateNumber = vehicle.PlateNumber
+	inputVehicle.VIN = vehicle.VIN
+	inputVehicle.Color = vehicle.Color
+	inputVehicle.Year = vehicle.Year
+	inputVehicle.Odometer = vehicle.Odometer
+	inputVehicle.Status = vehicle.Status
+	if err := bindJSON(ctx, &inputVehicle); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	updatedVehicle, err := p.vehicleservice.EditVehicle(ctx, id, inputVehicle)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedVehicle)
+}
+
+// RestoreVehicleByID godoc
+// @Summary Restore a deleted vehicle
+// @Description Bring back a soft-deleted vehicle by its ID.
+// @Tags vehicles
+// @Accept json
+// @Produce json
+// @Param id path int true "Vehicle ID"
+// @Success 200 {object} map[string]any "Vehicle successfully restored"
+// @Failure 400 {object} pkg.ErrorResponse "Invalid required param"
+// @Failure 404 {object} pkg.ErrorResponse "Vehicle not found"
+// @Failure 500 {object} pkg.ErrorResponse "Internal server error"
+// @Router /vehicles/{id}/restore [post]
+func (p *vehicleHandlerImpl) RestoreVehicleByID(ctx *gin.Context) {
+	id, err := pathID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	vehicle, err := p.vehicleservice.RestoreVehicle(ctx, id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, map[string]any{
+		"vehicle": vehicle,
+		"message": "Your vehicle has been successfully restored",
+	})
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileStorage keeps uploaded files, such as inspection photos, and hands
+// back a key to find them again.
+type FileStorage interface {
+	Save(ctx context.Context, name string, content io.Reader) (string, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrInvalidKey is returned for keys that do not point inside the storage.
+var ErrInvalidKey = errors.New("invalid storage key")
+
+type localStorageImpl struct {
+	dir string
+}
+
+// NewLocalStorage stores files on local disk under STORAGE_DIR, "uploads"
+// when unset.
+func NewLocalStorage() FileStorage {
+	dir := os.Getenv("STORAGE_DIR")
+	if dir == "" {
+		dir = "uploads"
+	}
+	return &localStorageImpl{dir: dir}
+}
+
+// Save writes content under a fresh random name, grouped by day, keeping
+// only the extension of name.
+func (l *localStorageImpl) Save(ctx context.Context, name string, content io.Reader) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	key := path.Join(time.Now().Format("2006/01/02"), hex.EncodeToString(random)+strings.ToLower(filepath.Ext(name)))
+
+	full := filepath.Join(l.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		return "", err
+	}
+	file, err := os.OpenFile(full, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		os.Remove(full)
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(full)
+		return "", err
+	}
+	return key, nil
+}
+
+func (l *localStorageImpl) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	full, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(full)
+}
+
+func (l *localStorageImpl) Delete(ctx context.Context, key string) error {
+	full, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(full); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path maps a key to its file, refusing keys that would escape the storage
+// directory.
+func (l *localStorageImpl) path(key string) (string, error) {
+	local := filepath.FromSlash(key)
+	if !filepath.IsLocal(local) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(l.dir, local), nil
+}
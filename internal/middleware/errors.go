@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorHandler answers the last error a handler attached with ctx.Error.
+// Domain errors are mapped to their status code and body; anything else is
+// logged and answered as a plain 500 so internals do not leak to clients.
+func ErrorHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		if len(ctx.Errors) == 0 || ctx.Writer.Written() {
+			return
+		}
+
+		err := ctx.Errors.Last().Err
+		if appErr, ok := apperror.As(err); ok {
+			if appErr.Kind == apperror.KindInternal {
+				log.Printf("%s %s: %v", ctx.Request.Method, ctx.Request.URL.Path, err)
+			}
+			ctx.JSON(appErr.Status(), appErr.Response())
+			return
+		}
+
+		log.Printf("%s %s: %v", ctx.Request.Method, ctx.Request.URL.Path, err)
+		ctx.JSON(http.StatusInternalServerError, pkg.ErrorResponse{Code: "internal", Message: "internal server error"})
+	}
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GroupDiscountTier takes Percentage off the rent of every car of a booking
+// group holding at least MinCars cars.
+type GroupDiscountTier struct {
+	MinCars    int `json:"min_cars"`
+	Percentage int `json:"percentage"`
+}
+
+// GroupDiscountTiers are ordered from the largest group down.
+var GroupDiscountTiers = []GroupDiscountTier{
+	{MinCars: 10, Percentage: 15},
+	{MinCars: 5, Percentage: 10},
+	{MinCars: 3, Percentage: 5},
+}
+
+// GroupDiscountPercentage is the discount a group of cars cars earns.
+func GroupDiscountPercentage(cars int) int {
+	for _, tier := range GroupDiscountTiers {
+		if cars >= tier.MinCars {
+			return tier.Percentage
+		}
+	}
+	return 0
+}
+
+// BookingGroup is one order of several cars for the same customer, such as
+// the cars of a corporate event. Every car is a booking of its own; the
+// group decides their discount. Cars, Total and Deposit add up the bookings
+// that were not cancelled and are not stored.
+type BookingGroup struct {
+	ID                 uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	CustomerID         uint           `json:"customer_id"`
+	Name               string         `json:"name"`
+	DiscountPercentage int            `json:"discount_percentage"`
+	Cars               int            `gorm:"-" json:"cars"`
+	Total              int            `gorm:"-" json:"total"`
+	Deposit            int            `gorm:"-" json:"deposit"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Customer Customer  `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
+	Bookings []Booking `gorm:"foreignKey:BookingGroupID" json:"bookings"`
+}
+
+type InputBookingGroup struct {
+	CustomerID uint               `json:"customer_id" binding:"required"`
+	CompanyID  *uint              `json:"company_id"`
+	Name       string             `json:"name" binding:"max=100"`
+	Lines      []InputBookingLine `json:"lines" binding:"required,min=1,dive"`
+}
+
+// InputBookingLine is one car of a booking group, booked for the group's
+// customer and billed like the rest of the group.
+type InputBookingLine struct {
+	CarID           uint                `json:"car_id" binding:"required"`
+	StartRent       string              `json:"start_rent" binding:"required"`
+	EndRent         string              `json:"end_rent" binding:"required"`
+	DriverID        *uint               `json:"driver_id"`
+	BookTypeID      *uint               `json:"book_type_id"`
+	PickupBranchID  uint                `json:"pickup_branch_id" binding:"required"`
+	ReturnBranchID  uint                `json:"return_branch_id"`
+	Extras          []InputBookingExtra `json:"extras" binding:"dive"`
+	InsurancePlanID *uint               `json:"insurance_plan_id"`
+}
+
+// Booking turns the line into the booking request it stands for.
+func (l InputBookingLine) Booking(group InputBookingGroup) InputBooking {
+	return InputBooking{
+		CustomerID:      group.CustomerID,
+		CompanyID:       group.CompanyID,
+		CarID:           l.CarID,
+		StartRent:       l.StartRent,
+		EndRent:         l.EndRent,
+		DriverID:        l.DriverID,
+		BookTypeID:      l.BookTypeID,
+		PickupBranchID:  l.PickupBranchID,
+		ReturnBranchID:  l.ReturnBranchID,
+		Extras:          l.Extras,
+		InsurancePlanID: l.InsurancePlanID,
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RatingWindow is how many of the latest ratings the averages on drivers
+// and cars are worked out from, so they follow recent service rather than
+// a driver's or car's whole history.
+const RatingWindow = 20
+
+// BookingRating is the customer's feedback on a finished booking, rated
+// from 1 to 5. DriverRating is only given for bookings with a driver.
+type BookingRating struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	BookingID     uint           `json:"booking_id"`
+	CustomerID    uint           `json:"customer_id"`
+	CarID         uint           `json:"car_id"`
+	DriverID      *uint          `json:"driver_id" gorm:"default:null"`
+	CarRating     int            `json:"car_rating"`
+	DriverRating  *int           `json:"driver_rating" gorm:"default:null"`
+	OverallRating int            `json:"overall_rating"`
+	Comment       string         `json:"comment"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+}
+
+type InputBookingRating struct {
+	BookingID     uint   `json:"booking_id" binding:"required"`
+	CarRating     int    `json:"car_rating" binding:"required,gte=1,lte=5"`
+	DriverRating  *int   `json:"driver_rating" binding:"omitempty,gte=1,lte=5"`
+	OverallRating int    `json:"overall_rating" binding:"required,gte=1,lte=5"`
+	Comment       string `json:"comment" binding:"max=1000"`
+}
+
+// BookingRatingFilter narrows GET /booking-ratings to the ratings of one
+// booking, car or driver.
+type BookingRatingFilter struct {
+	BookingID      uint `form:"booking_id" json:"booking_id"`
+	CarID          uint `form:"car_id" json:"car_id"`
+	DriverID       uint `form:"driver_id" json:"driver_id"`
+	IncludeDeleted bool `form:"include_deleted" json:"include_deleted"`
+}
@@ -2,8 +2,19 @@ package models
 
 import (
 	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
 )
 
+// DateLayout is the dd/mm/yyyy format rent dates are sent in.
+const DateLayout = "02/01/2006"
+
+// ReturnPaymentDays is how long a customer paying for themselves has to
+// settle what the deposit does not cover once the car is returned.
+const ReturnPaymentDays = 7
+
 type Booking struct {
     ID              uint       `gorm:"primaryKey;autoIncrement;unique" json:"id"`
     CustomerID      uint       `json:"customer_id"`
@@ -16,13 +27,50 @@ type Booking struct {
     TotalDriverCost int       `json:"total_driver_cost" gorm:"default:null"`
     Finished       bool       `json:"finished"`
     Discount       int        `json:"discount" gorm:"default:null"`
+    Deposit        int        `json:"deposit"`
+    VehicleID      *uint      `json:"vehicle_id" gorm:"default:null"`
+    PickedUpAt     *time.Time `json:"picked_up_at"`
+    ReturnedAt     *time.Time `json:"returned_at"`
+    PickupOdometer *int       `json:"pickup_odometer"`
+    ReturnOdometer *int       `json:"return_odometer"`
+    PickupFuelLevel *int      `json:"pickup_fuel_level"`
+    ReturnFuelLevel *int      `json:"return_fuel_level"`
+    DamageCharge   int        `json:"damage_charge"`
+    FuelPolicy     string     `json:"fuel_policy" gorm:"default:null"`
+    FuelCharge     int        `json:"fuel_charge"`
+    KmAllowance    int        `json:"km_allowance"`
+    ExcessKm       int        `json:"excess_km"`
+    MileageCharge  int        `json:"mileage_charge"`
+    PickupBranchID uint       `json:"pickup_branch_id"`
+    ReturnBranchID uint       `json:"return_branch_id"`
+    OneWayFee      int        `json:"one_way_fee"`
+    ExtrasCost     int        `json:"extras_cost"`
+    InsurancePlanID *uint     `json:"insurance_plan_id" gorm:"default:null"`
+    InsurancePremium int      `json:"insurance_premium"`
+    InsuranceExcess int       `json:"insurance_excess"`
+    BookingGroupID *uint      `json:"booking_group_id" gorm:"default:null"`
+    GroupDiscount  int        `json:"group_discount"`
+    CancelledAt    *time.Time `json:"cancelled_at"`
+    CompanyID      *uint      `json:"company_id" gorm:"default:null"`
+    PaymentDueAt   *time.Time `json:"payment_due_at"`
+    PaidAt         *time.Time `json:"paid_at"`
+    DriverPayoutPeriodID *uint `json:"driver_payout_period_id" gorm:"default:null"`
+    RiskOverrideBy     string `json:"risk_override_by"`
+    RiskOverrideReason string `json:"risk_override_reason"`
     CreatedAt      time.Time  `json:"created_at"`
     UpdatedAt      time.Time  `json:"updated_at"`
+    DeletedAt      gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
 
     Driver         *Driver     `gorm:"foreignKey:DriverID" json:"driver"`
     BookingType    *BookingType `gorm:"foreignKey:BookTypeID" json:"booking_type"`
     Customer       Customer    `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
     Car           Car         `gorm:"foreignKey:CarID" json:"car,omitempty"`
+    Vehicle       *Vehicle    `gorm:"foreignKey:VehicleID" json:"vehicle,omitempty"`
+    PickupBranch  *Branch     `gorm:"foreignKey:PickupBranchID" json:"pickup_branch,omitempty"`
+    ReturnBranch  *Branch     `gorm:"foreignKey:ReturnBranchID" json:"return_branch,omitempty"`
+    Extras        []BookingExtra `gorm:"foreignKey:BookingID" json:"extras"`
+    InsurancePlan *InsurancePlan `gorm:"foreignKey:InsurancePlanID" json:"insurance_plan,omitempty"`
+    Company       *Company    `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
 }
 
 type InputBooking struct {
@@ -32,5 +80,82 @@ type InputBooking struct {
     EndRent     string `json:"end_rent" binding:"required"`
     DriverID       *uint      `json:"driver_id" gorm:"default:null"`
     BookTypeID     *uint      `json:"book_type_id" gorm:"default:null"`
+    PickupBranchID uint       `json:"pickup_branch_id" binding:"required"`
+    ReturnBranchID uint       `json:"return_branch_id"`
+    Extras      []InputBookingExtra `json:"extras" binding:"dive"`
+    InsurancePlanID *uint   `json:"insurance_plan_id"`
+    CompanyID   *uint     `json:"company_id"`
     Finished    bool `json:"finished"`
-}
\ No newline at end of file
+    RiskOverride *InputRiskOverride `json:"risk_override" binding:"omitempty"`
+}
+
+// ReturnBranch is where the car goes back to, the pickup branch unless
+// another one is given.
+func (b InputBooking) ReturnBranch() uint {
+	if b.ReturnBranchID == 0 {
+		return b.PickupBranchID
+	}
+	return b.ReturnBranchID
+}
+
+// Validate checks the rules that need nothing but the request itself.
+// Rules depending on stored records, such as the booking type, are checked
+// by the booking service.
+func (b InputBooking) Validate(errs *validation.Errors) {
+	startRent, startErr := time.Parse(DateLayout, b.StartRent)
+	if b.StartRent != "" && startErr != nil {
+		errs.Add("start_rent", "must be in format dd/mm/yyyy")
+	}
+	endRent, endErr := time.Parse(DateLayout, b.EndRent)
+	if b.EndRent != "" && endErr != nil {
+		errs.Add("end_rent", "must be in format dd/mm/yyyy")
+	}
+	if startErr == nil && endErr == nil && endRent.Before(startRent) {
+		errs.Add("end_rent", "must not be before start_rent")
+	}
+	validateExtras(b.Extras, errs)
+}
+
+// InputPickup hands a booked car over to the customer. Without a vehicle ID
+// a free unit of the booked car is picked; without a fuel level the tank is
+// taken to be full.
+type InputPickup struct {
+	VehicleID *uint `json:"vehicle_id"`
+	FuelLevel *int  `json:"fuel_level" binding:"omitempty,gte=0,lte=100"`
+}
+
+// InputReturn closes a booking when the vehicle comes back. FuelLevel is a
+// percentage of a full tank.
+type InputReturn struct {
+	Odometer  int  `json:"odometer" binding:"required,gt=0"`
+	FuelLevel *int `json:"fuel_level" binding:"required,gte=0,lte=100"`
+}
+
+// BookingSettlement is what a customer owes for a booking. Fuel and mileage
+// charges are only known once the car is returned, when Final turns true.
+// Bookings billed to a company are paid by PaymentDueAt instead of upfront;
+// other bookings get a PaymentDueAt for the balance left when returned.
+type BookingSettlement struct {
+	BookingID     uint       `json:"booking_id"`
+	Rent          int        `json:"rent"`
+	Discount      int        `json:"discount"`
+	GroupDiscount int        `json:"group_discount"`
+	DriverCost    int        `json:"driver_cost"`
+	OneWayFee     int        `json:"one_way_fee"`
+	Extras        int        `json:"extras"`
+	Insurance     int        `json:"insurance"`
+	DamageCharge  int        `json:"damage_charge"`
+	FuelPolicy    string     `json:"fuel_policy"`
+	FuelCharge    int        `json:"fuel_charge"`
+	KmDriven      int        `json:"km_driven"`
+	KmAllowance   int        `json:"km_allowance"`
+	ExcessKm      int        `json:"excess_km"`
+	MileageCharge int        `json:"mileage_charge"`
+	Total         int        `json:"total"`
+	Deposit       int        `json:"deposit"`
+	BalanceDue    int        `json:"balance_due"`
+	Final         bool       `json:"final"`
+	CompanyID     *uint      `json:"company_id"`
+	PaymentDueAt  *time.Time `json:"payment_due_at"`
+	PaidAt        *time.Time `json:"paid_at"`
+}
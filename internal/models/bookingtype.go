@@ -2,17 +2,53 @@ package models
 
 import (
 	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
 )
 
+// BookingType describes how a booking of that type behaves: whether it
+// needs a driver, how long it may last and how it is priced.
+// MaxDays of 0 means there is no upper limit.
 type BookingType struct {
     ID       uint   `gorm:"primaryKey;autoIncrement;unique" json:"id"`
     BookingType     string `json:"booking_type"`
     Description     string  `json:"description"`
+    RequiresDriver    bool    `json:"requires_driver"`
+    AllowsDriver      bool    `json:"allows_driver"`
+    MinDays           int     `json:"min_days"`
+    MaxDays           int     `json:"max_days"`
+    PriceMultiplier   float64 `json:"price_multiplier"`
+    DepositPercentage int     `json:"deposit_percentage"`
     CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
 }
 
 type InputBookingType struct {
     BookingType     string `json:"booking_type" binding:"required"`
     Description     string  `json:"description" binding:"required"`
-}
\ No newline at end of file
+    RequiresDriver    bool     `json:"requires_driver"`
+    AllowsDriver      *bool    `json:"allows_driver"`
+    MinDays           int      `json:"min_days" binding:"gte=0"`
+    MaxDays           int      `json:"max_days" binding:"gte=0"`
+    PriceMultiplier   *float64 `json:"price_multiplier" binding:"omitempty,gt=0"`
+    DepositPercentage int      `json:"deposit_percentage" binding:"gte=0,lte=100"`
+}
+
+// Validate checks that the rules of a booking type do not contradict each
+// other.
+func (b InputBookingType) Validate(errs *validation.Errors) {
+	if b.RequiresDriver && !b.DriverAllowed() {
+		errs.Add("allows_driver", "must be true when requires_driver is true")
+	}
+	if b.MaxDays > 0 && b.MaxDays < b.MinDays {
+		errs.Add("max_days", "must not be less than min_days")
+	}
+}
+
+// DriverAllowed is AllowsDriver, true when it is left out.
+func (b InputBookingType) DriverAllowed() bool {
+	return b.AllowsDriver == nil || *b.AllowsDriver
+}
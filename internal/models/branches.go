@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Branch is an office cars are picked up from and returned to. OneWayFee is
+// charged when a car picked up here is returned to another branch.
+type Branch struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	Name      string         `json:"name"`
+	City      string         `json:"city"`
+	Address   string         `json:"address"`
+	Phone     string         `json:"phone"`
+	OneWayFee int            `json:"one_way_fee"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+}
+
+type InputBranch struct {
+	Name      string `json:"name" binding:"required,max=100"`
+	City      string `json:"city" binding:"required,max=100"`
+	Address   string `json:"address"`
+	Phone     string `json:"phone" binding:"max=20"`
+	OneWayFee int    `json:"one_way_fee" binding:"gte=0"`
+}
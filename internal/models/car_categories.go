@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Fuel policies. Full-to-full cars are handed over full and every
+// percentage point of tank missing at return is billed at the refuel rate.
+// Prepaid cars are billed a fixed fuel price and may come back empty.
+const (
+	FuelPolicyFullToFull = "full_to_full"
+	FuelPolicyPrepaid    = "prepaid"
+)
+
+// CarCategory groups cars the way customers shop for them, such as City Car,
+// MPV, SUV or Luxury. It also holds the fuel and mileage terms of its cars;
+// a DailyKmAllowance of 0 means unlimited kilometres. Cars of a category with
+// an insurance plan are never rented uninsured: bookings choosing no plan get
+// that one.
+type CarCategory struct {
+	ID               uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	Name             string         `json:"name"`
+	Description      string         `json:"description"`
+	FuelPolicy       string         `json:"fuel_policy"`
+	RefuelRate       int            `json:"refuel_rate"`
+	PrepaidFuelPrice int            `json:"prepaid_fuel_price"`
+	DailyKmAllowance int            `json:"daily_km_allowance"`
+	ExcessKmRate     int            `json:"excess_km_rate"`
+	InsurancePlanID  *uint          `json:"insurance_plan_id" gorm:"default:null"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	InsurancePlan *InsurancePlan `gorm:"foreignKey:InsurancePlanID" json:"insurance_plan,omitempty"`
+}
+
+type InputCarCategory struct {
+	Name             string `json:"name" binding:"required,max=100"`
+	Description      string `json:"description"`
+	FuelPolicy       string `json:"fuel_policy" binding:"omitempty,oneof=full_to_full prepaid"`
+	RefuelRate       int    `json:"refuel_rate" binding:"gte=0"`
+	PrepaidFuelPrice int    `json:"prepaid_fuel_price" binding:"gte=0"`
+	DailyKmAllowance int    `json:"daily_km_allowance" binding:"gte=0"`
+	ExcessKmRate     int    `json:"excess_km_rate" binding:"gte=0"`
+	InsurancePlanID  *uint  `json:"insurance_plan_id"`
+}
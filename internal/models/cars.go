@@ -2,18 +2,86 @@ package models
 
 import (
 	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
 )
 
+// Car is a model customers book. Stock counts its active vehicles and is
+// kept up to date by the vehicle service; it cannot be set directly. Nor
+// can RatingAverage and RatingCount, which follow the booking ratings.
 type Car struct {
     ID       uint   `gorm:"primaryKey;autoIncrement;unique" json:"id"`
     Name     string `json:"name"`
     Stock     int    `json:"stock"`
     DailyRent int    `json:"daily_rent"`
+    CategoryID   *uint  `json:"category_id" gorm:"default:null"`
+    Brand        string `json:"brand"`
+    Transmission string `json:"transmission"`
+    FuelType     string `json:"fuel_type"`
+    Seats        int    `json:"seats"`
+    Year         int    `json:"year"`
+    Luggage      int    `json:"luggage"`
+    RatingAverage *float64 `json:"rating_average"`
+    RatingCount   int      `json:"rating_count"`
     CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+    Category *CarCategory `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
 }
 type InputCar struct {
     Name     string `json:"name" binding:"required"`
-    Stock     int    `json:"stock" binding:"required,gt=0"`
     DailyRent int    `json:"daily_rent" binding:"required,gt=0"`
-}
\ No newline at end of file
+    CategoryID   *uint  `json:"category_id"`
+    Brand        string `json:"brand" binding:"max=100"`
+    Transmission string `json:"transmission" binding:"omitempty,oneof=manual automatic"`
+    FuelType     string `json:"fuel_type" binding:"omitempty,oneof=petrol diesel electric hybrid"`
+    Seats        int    `json:"seats" binding:"gte=0"`
+    Year         int    `json:"year" binding:"omitempty,gte=1900"`
+    Luggage      int    `json:"luggage" binding:"gte=0"`
+}
+
+// CarFilter narrows and orders GET /cars. With both rent dates set only cars
+// with a unit free over the whole period are listed, at BranchID when it is
+// given. Sort takes a column, prefixed with "-" for descending order.
+type CarFilter struct {
+	CategoryID     uint   `form:"category_id" json:"category_id"`
+	BranchID       uint   `form:"branch_id" json:"branch_id"`
+	Brand          string `form:"brand" json:"brand"`
+	Transmission   string `form:"transmission" json:"transmission" binding:"omitempty,oneof=manual automatic"`
+	FuelType       string `form:"fuel_type" json:"fuel_type" binding:"omitempty,oneof=petrol diesel electric hybrid"`
+	MinSeats       int    `form:"min_seats" json:"min_seats" binding:"gte=0"`
+	MinLuggage     int    `form:"min_luggage" json:"min_luggage" binding:"gte=0"`
+	MinYear        int    `form:"min_year" json:"min_year" binding:"gte=0"`
+	MinPrice       int    `form:"min_price" json:"min_price" binding:"gte=0"`
+	MaxPrice       int    `form:"max_price" json:"max_price" binding:"gte=0"`
+	StartRent      string `form:"start_rent" json:"start_rent"`
+	EndRent        string `form:"end_rent" json:"end_rent"`
+	Sort           string `form:"sort" json:"sort" binding:"omitempty,oneof=name -name daily_rent -daily_rent seats -seats year -year"`
+	IncludeDeleted bool   `form:"include_deleted" json:"include_deleted"`
+}
+
+func (f CarFilter) Validate(errs *validation.Errors) {
+	if f.MaxPrice > 0 && f.MaxPrice < f.MinPrice {
+		errs.Add("max_price", "must not be less than min_price")
+	}
+	if (f.StartRent == "") != (f.EndRent == "") {
+		errs.Add("end_rent", "start_rent and end_rent go together")
+		return
+	}
+	input := InputBooking{StartRent: f.StartRent, EndRent: f.EndRent}
+	input.Validate(errs)
+}
+
+// Period returns the rent dates asked for, if any. It assumes the filter
+// passed validation.
+func (f CarFilter) Period() (time.Time, time.Time, bool) {
+	if f.StartRent == "" {
+		return time.Time{}, time.Time{}, false
+	}
+	startRent, _ := time.Parse(DateLayout, f.StartRent)
+	endRent, _ := time.Parse(DateLayout, f.EndRent)
+	return startRent, endRent, true
+}
@@ -0,0 +1,119 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// DefaultPaymentTermsDays is how long a company has to pay a booking billed
+// to it, counted from the end of the rent, unless agreed otherwise.
+const DefaultPaymentTermsDays = 30
+
+// Company is a corporate account bookings can be billed to instead of being
+// paid by the customer at the counter. Its unpaid bookings may not add up to
+// more than CreditLimit. Rates are the negotiated daily rents of its cars.
+type Company struct {
+	ID               uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	Name             string         `json:"name"`
+	NPWP             string         `json:"npwp" gorm:"unique"`
+	Address          string         `json:"address"`
+	BillingContact   string         `json:"billing_contact"`
+	BillingEmail     string         `json:"billing_email"`
+	BillingPhone     string         `json:"billing_phone"`
+	CreditLimit      int            `json:"credit_limit"`
+	PaymentTermsDays int            `json:"payment_terms_days"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Rates []CompanyRate `gorm:"foreignKey:CompanyID" json:"rates"`
+}
+
+type InputCompany struct {
+	Name             string             `json:"name" binding:"required,max=100"`
+	NPWP             string             `json:"npwp" binding:"required"`
+	Address          string             `json:"address"`
+	BillingContact   string             `json:"billing_contact" binding:"required,max=100"`
+	BillingEmail     string             `json:"billing_email" binding:"required,email,max=100"`
+	BillingPhone     string             `json:"billing_phone" binding:"max=20"`
+	CreditLimit      int                `json:"credit_limit" binding:"required,gt=0"`
+	PaymentTermsDays int                `json:"payment_terms_days" binding:"gte=0"`
+	Rates            []InputCompanyRate `json:"rates" binding:"dive"`
+}
+
+func (c InputCompany) Validate(errs *validation.Errors) {
+	if c.NPWP != "" && !validation.IsNPWP(c.NPWP) {
+		errs.Add("npwp", "must be 15 or 16 digits")
+	}
+	seen := map[uint]bool{}
+	for i, rate := range c.Rates {
+		if rate.CarID == 0 {
+			continue
+		}
+		if seen[rate.CarID] {
+			errs.Add(fmt.Sprintf("rates[%d].car_id", i), "is listed more than once")
+		}
+		seen[rate.CarID] = true
+	}
+}
+
+// CompanyRate is the daily rent a company negotiated for a car.
+type CompanyRate struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	CompanyID uint      `json:"company_id"`
+	CarID     uint      `json:"car_id"`
+	DailyRent int       `json:"daily_rent"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Car *Car `gorm:"foreignKey:CarID" json:"car,omitempty"`
+}
+
+type InputCompanyRate struct {
+	CarID     uint `json:"car_id" binding:"required"`
+	DailyRent int  `json:"daily_rent" binding:"required,gt=0"`
+}
+
+// DailyRent is the rent the company pays per day for car, its negotiated
+// rate when it has one.
+func (c Company) DailyRent(car Car) int {
+	for _, rate := range c.Rates {
+		if rate.CarID == car.ID {
+			return rate.DailyRent
+		}
+	}
+	return car.DailyRent
+}
+
+// CompanyStatement lists the bookings billed to a company that start in one
+// month. CreditUsed is what the company owes over all months.
+type CompanyStatement struct {
+	CompanyID      uint                   `json:"company_id"`
+	Name           string                 `json:"name"`
+	NPWP           string                 `json:"npwp"`
+	BillingContact string                 `json:"billing_contact"`
+	BillingEmail   string                 `json:"billing_email"`
+	Month          string                 `json:"month"`
+	Lines          []CompanyStatementLine `json:"lines"`
+	Total          int                    `json:"total"`
+	Paid           int                    `json:"paid"`
+	Outstanding    int                    `json:"outstanding"`
+	CreditLimit    int                    `json:"credit_limit"`
+	CreditUsed     int                    `json:"credit_used"`
+}
+
+type CompanyStatementLine struct {
+	BookingID    uint       `json:"booking_id"`
+	CustomerName string     `json:"customer_name"`
+	CarName      string     `json:"car_name"`
+	StartRent    time.Time  `json:"start_rent"`
+	EndRent      time.Time  `json:"end_rent"`
+	Total        int        `json:"total"`
+	Final        bool       `json:"final"`
+	PaymentDueAt *time.Time `json:"payment_due_at"`
+	PaidAt       *time.Time `json:"paid_at"`
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"car-rental/pkg/validation"
+)
+
+// Risk statuses of a customer. Flagged customers can only book with a
+// manager's approval; blacklisted ones cannot book at all.
+const (
+	RiskFlagged     = "flagged"
+	RiskBlacklisted = "blacklisted"
+)
+
+// RiskFlaggedBySystem is who customers flagged automatically are flagged by.
+const RiskFlaggedBySystem = "system"
+
+// InputCustomerRisk flags or blacklists a customer, until ExpiresAt when it
+// is given. FlaggedBy names the member of staff taking the decision.
+type InputCustomerRisk struct {
+	Status    string `json:"status" binding:"required,oneof=flagged blacklisted"`
+	Reason    string `json:"reason" binding:"required,max=255"`
+	FlaggedBy string `json:"flagged_by" binding:"required,max=100"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+func (r InputCustomerRisk) Validate(errs *validation.Errors) {
+	if r.ExpiresAt != "" {
+		if _, err := time.Parse(DateLayout, r.ExpiresAt); err != nil {
+			errs.Add("expires_at", "must be in format dd/mm/yyyy")
+		}
+	}
+}
+
+// InputRiskOverride is a manager's approval to book for a flagged customer.
+type InputRiskOverride struct {
+	ApprovedBy string `json:"approved_by" binding:"required,max=100"`
+	Reason     string `json:"reason" binding:"required,max=255"`
+}
+
+// RiskThresholds are how many late returns, or bookings left unpaid past
+// their due date, get a customer flagged automatically. 0 turns a check off.
+type RiskThresholds struct {
+	LateReturns    int
+	UnpaidBookings int
+}
+
+// RiskOn returns the customer's risk status on day, "" when there is none
+// or it expired before that day.
+func (c Customer) RiskOn(day time.Time) string {
+	if c.RiskExpiresAt != nil && !day.Before(c.RiskExpiresAt.AddDate(0, 0, 1)) {
+		return ""
+	}
+	return c.RiskStatus
+}
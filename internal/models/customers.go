@@ -2,15 +2,42 @@ package models
 
 import (
 	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// Genders as a NIK encodes them.
+const (
+	GenderMale   = "male"
+	GenderFemale = "female"
 )
 
+// MinRenterAge is how old a customer must be on the first day of a rent.
+const MinRenterAge = 21
+
+// Customer is someone who rents cars. Phone is kept in E.164 and no two
+// customers share one. BirthDate and Gender are decoded from the NIK and
+// cannot be set directly. A customer may be flagged or blacklisted, by
+// staff or automatically, until RiskExpiresAt or for good when it is nil;
+// RiskReviewedAt is when staff last set or cleared that status.
 type Customer struct {
     ID      uint   `gorm:"primaryKey;autoIncrement;unique" json:"id"`
     Name    string `json:"name"`
     NIK     string `json:"nik" gorm:"unique"`
     Phone   string `json:"phone"`
+    BirthDate *time.Time `json:"birth_date" gorm:"type:date"`
+    Gender    string     `json:"gender"`
+    RiskStatus     string     `json:"risk_status"`
+    RiskReason     string     `json:"risk_reason"`
+    RiskFlaggedBy  string     `json:"risk_flagged_by"`
+    RiskFlaggedAt  *time.Time `json:"risk_flagged_at"`
+    RiskExpiresAt  *time.Time `json:"risk_expires_at" gorm:"type:date"`
+    RiskReviewedAt *time.Time `json:"risk_reviewed_at"`
     CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
     MembershipID *uint  `json:"membership_id" gorm:"default:null"`
     Membership   *Membership `gorm:"foreignKey:MembershipID"`
 }
@@ -24,3 +51,46 @@ type InputCustomer struct {
 type InputMembershipID struct {
     MembershipID uint `json:"membership_id" binding:"required"`
 }
+
+func (c InputCustomer) Validate(errs *validation.Errors) {
+	if c.NIK != "" {
+		if _, err := validation.ParseNIK(c.NIK, time.Now()); err != nil {
+			errs.Add("nik", err.Error())
+		}
+	}
+	if c.Phone != "" {
+		if _, err := validation.NormalizePhone(c.Phone); err != nil {
+			errs.Add("phone", err.Error())
+		}
+	}
+}
+
+// NIKIdentity returns the birth date and gender nik encodes, or nil and ""
+// when it is not a valid NIK.
+func NIKIdentity(nik string) (*time.Time, string) {
+	parsed, err := validation.ParseNIK(nik, time.Now())
+	if err != nil {
+		return nil, ""
+	}
+	if parsed.Female {
+		return &parsed.BirthDate, GenderFemale
+	}
+	return &parsed.BirthDate, GenderMale
+}
+
+// AgeOn returns how old the customer is on day, going by the birth date on
+// record or else the NIK. It reports false when neither gives one.
+func (c Customer) AgeOn(day time.Time) (int, bool) {
+	birthDate := c.BirthDate
+	if birthDate == nil {
+		birthDate, _ = NIKIdentity(c.NIK)
+	}
+	if birthDate == nil {
+		return 0, false
+	}
+	age := day.Year() - birthDate.Year()
+	if day.Month() < birthDate.Month() || (day.Month() == birthDate.Month() && day.Day() < birthDate.Day()) {
+		age--
+	}
+	return age, true
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// ShiftLayout is the hh:mm format shift times are sent in.
+const ShiftLayout = "15:04"
+
+// DriverSchedule is the shift a driver works on one day of the week, Sunday
+// being 0. A driver with no schedule at all works every day; once a driver
+// has one, the days without a shift are off.
+type DriverSchedule struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	DriverID  uint           `json:"driver_id"`
+	Weekday   int            `json:"weekday"`
+	StartTime string         `json:"start_time"`
+	EndTime   string         `json:"end_time"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Driver *Driver `gorm:"foreignKey:DriverID" json:"driver,omitempty"`
+}
+
+type InputDriverSchedule struct {
+	DriverID  uint   `json:"driver_id" binding:"required"`
+	Weekday   *int   `json:"weekday" binding:"required,gte=0,lte=6"`
+	StartTime string `json:"start_time" binding:"required"`
+	EndTime   string `json:"end_time" binding:"required"`
+}
+
+func (d InputDriverSchedule) Validate(errs *validation.Errors) {
+	startTime, startErr := time.Parse(ShiftLayout, d.StartTime)
+	if d.StartTime != "" && startErr != nil {
+		errs.Add("start_time", "must be in format hh:mm")
+	}
+	endTime, endErr := time.Parse(ShiftLayout, d.EndTime)
+	if d.EndTime != "" && endErr != nil {
+		errs.Add("end_time", "must be in format hh:mm")
+	}
+	if startErr == nil && endErr == nil && !endTime.After(startTime) {
+		errs.Add("end_time", "must be after start_time")
+	}
+}
+
+// DriverLeave keeps a driver off bookings from StartDate to EndDate, both
+// days included.
+type DriverLeave struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	DriverID  uint           `json:"driver_id"`
+	StartDate time.Time      `json:"start_date"`
+	EndDate   time.Time      `json:"end_date"`
+	Reason    string         `json:"reason"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Driver *Driver `gorm:"foreignKey:DriverID" json:"driver,omitempty"`
+}
+
+type InputDriverLeave struct {
+	DriverID  uint   `json:"driver_id" binding:"required"`
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date" binding:"required"`
+	Reason    string `json:"reason" binding:"max=255"`
+}
+
+func (d InputDriverLeave) Validate(errs *validation.Errors) {
+	startDate, startErr := time.Parse(DateLayout, d.StartDate)
+	if d.StartDate != "" && startErr != nil {
+		errs.Add("start_date", "must be in format dd/mm/yyyy")
+	}
+	endDate, endErr := time.Parse(DateLayout, d.EndDate)
+	if d.EndDate != "" && endErr != nil {
+		errs.Add("end_date", "must be in format dd/mm/yyyy")
+	}
+	if startErr == nil && endErr == nil && endDate.Before(startDate) {
+		errs.Add("end_date", "must not be before start_date")
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DriverDocumentHealthCertificate = "health_certificate"
+	DriverDocumentPoliceClearance   = "police_clearance"
+	DriverDocumentOther             = "other"
+)
+
+// DriverDocumentLicense names the driving license in the expiring report,
+// where it is listed next to the documents.
+const DriverDocumentLicense = "license"
+
+// DriverDocument is a certificate a driver has to keep current besides the
+// driving license, such as a health certificate.
+type DriverDocument struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	DriverID  uint           `json:"driver_id"`
+	Type      string         `json:"type"`
+	Number    string         `json:"number"`
+	ExpiresAt time.Time      `json:"expires_at"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Driver *Driver `gorm:"foreignKey:DriverID" json:"driver,omitempty"`
+}
+
+type InputDriverDocument struct {
+	DriverID  uint   `json:"driver_id" binding:"required"`
+	Type      string `json:"type" binding:"required,oneof=health_certificate police_clearance other"`
+	Number    string `json:"number" binding:"max=100"`
+	ExpiresAt string `json:"expires_at" binding:"required"`
+}
+
+func (d InputDriverDocument) Validate(errs *validation.Errors) {
+	if d.ExpiresAt != "" {
+		if _, err := time.Parse(DateLayout, d.ExpiresAt); err != nil {
+			errs.Add("expires_at", "must be in format dd/mm/yyyy")
+		}
+	}
+}
+
+// DriverExpiry is one line of the expiring-soon report: a license or
+// document of a driver that has run out or will within the window asked for,
+// or a license whose number or expiry is missing, which has no ExpiresAt.
+type DriverExpiry struct {
+	DriverID   uint       `json:"driver_id"`
+	DriverName string     `json:"driver_name"`
+	BranchID   uint       `json:"branch_id"`
+	Document   string     `json:"document"`
+	DocumentID *uint      `json:"document_id,omitempty"`
+	Number     string     `json:"number"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	DaysLeft   int        `json:"days_left"`
+	Expired    bool       `json:"expired"`
+	Missing    bool       `json:"missing"`
+}
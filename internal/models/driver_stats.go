@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"car-rental/pkg/validation"
+)
+
+// MaxDriverStatsDays caps the range of a driver's stats at about a year.
+const MaxDriverStatsDays = 366
+
+// DriverStatsFilter is the range of days, both included, a driver's stats
+// cover. Without dates they cover the 30 days up to today.
+type DriverStatsFilter struct {
+	From string `form:"from" json:"from"`
+	To   string `form:"to" json:"to"`
+}
+
+func (f DriverStatsFilter) Validate(errs *validation.Errors) {
+	if (f.From == "") != (f.To == "") {
+		errs.Add("to", "from and to go together")
+		return
+	}
+	if f.From == "" {
+		return
+	}
+	from, fromErr := time.Parse(DateLayout, f.From)
+	if fromErr != nil {
+		errs.Add("from", "must be in format dd/mm/yyyy")
+	}
+	to, toErr := time.Parse(DateLayout, f.To)
+	if toErr != nil {
+		errs.Add("to", "must be in format dd/mm/yyyy")
+	}
+	if fromErr != nil || toErr != nil {
+		return
+	}
+	if to.Before(from) {
+		errs.Add("to", "must not be before from")
+	} else if to.Sub(from).Hours()/24 >= MaxDriverStatsDays {
+		errs.Add("to", "range must not be longer than 366 days")
+	}
+}
+
+// Range returns the days asked for, or the last 30 days up to today. It
+// assumes the filter passed validation.
+func (f DriverStatsFilter) Range(today time.Time) (time.Time, time.Time) {
+	if f.From == "" {
+		return today.AddDate(0, 0, -29), today
+	}
+	from, _ := time.Parse(DateLayout, f.From)
+	to, _ := time.Parse(DateLayout, f.To)
+	return from, to
+}
+
+// DriverStats are a driver's KPIs over a range of days. Trips, ratings and
+// incentives count the bookings finished and ending in the range; days
+// driven only count the days of those trips within it. Utilization sets the
+// days driven against the days the driver was available: on shift, or every
+// day without a schedule, and not on leave.
+type DriverStats struct {
+	DriverID       uint      `json:"driver_id"`
+	From           time.Time `json:"from"`
+	To             time.Time `json:"to"`
+	TripsCompleted int       `json:"trips_completed"`
+	DaysDriven     int       `json:"days_driven"`
+	AvailableDays  int       `json:"available_days"`
+	Utilization    float64   `json:"utilization_percentage"`
+	TotalIncentive int       `json:"total_incentive"`
+	AverageRating  *float64  `json:"average_rating"`
+	RatingCount    int       `json:"rating_count"`
+	Cancellations  int       `json:"cancellations"`
+}
@@ -2,21 +2,77 @@ package models
 
 import (
 	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
 )
 
+// A driver needs a SIM of one of these classes to take out a rental car; the
+// B licenses also cover everything an A license does.
+const (
+	LicenseClassA  = "A"
+	LicenseClassB1 = "B1"
+	LicenseClassB2 = "B2"
+)
 
+// Driver is a driver customers can book along with a car. RatingAverage is
+// worked out from the driver's last RatingWindow ratings and RatingCount
+// counts all of them; both follow the booking ratings and cannot be set.
+// Neither can BirthDate and Gender, which are decoded from the NIK. Phone is
+// kept in E.164.
 type Driver struct {
     ID        uint   `gorm:"primaryKey;autoIncrement;unique" json:"id"`
     Name      string `json:"name"`
     NIK       string `json:"nik" gorm:"unique"`
     Phone     string `json:"phone"`
     DailyCost int    `json:"daily_cost"`
+    BranchID  uint   `json:"branch_id"`
+    LicenseNumber string     `json:"license_number"`
+    LicenseClass  string     `json:"license_class"`
+    LicenseExpiry *time.Time `json:"license_expiry"`
+    IncentiveTier string     `json:"incentive_tier"`
+    BirthDate     *time.Time `json:"birth_date" gorm:"type:date"`
+    Gender        string     `json:"gender"`
+    RatingAverage *float64   `json:"rating_average"`
+    RatingCount   int        `json:"rating_count"`
     CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
 }
 type InputDriver struct {
     Name      string `json:"name" binding:"required"`
     NIK       string `json:"nik" gorm:"unique" binding:"required"`
     Phone     string `json:"phone" binding:"required"`
     DailyCost int    `json:"daily_cost" binding:"required,gt=0"`
-}
\ No newline at end of file
+    BranchID  uint   `json:"branch_id" binding:"required"`
+    LicenseNumber string `json:"license_number" binding:"max=50"`
+    LicenseClass  string `json:"license_class" binding:"omitempty,oneof=A B1 B2"`
+    LicenseExpiry string `json:"license_expiry"`
+    IncentiveTier string `json:"incentive_tier" binding:"max=30"`
+}
+
+func (d InputDriver) Validate(errs *validation.Errors) {
+	if d.NIK != "" {
+		if _, err := validation.ParseNIK(d.NIK, time.Now()); err != nil {
+			errs.Add("nik", err.Error())
+		}
+	}
+	if d.Phone != "" {
+		if _, err := validation.NormalizePhone(d.Phone); err != nil {
+			errs.Add("phone", err.Error())
+		}
+	}
+	if d.LicenseExpiry != "" {
+		if _, err := time.Parse(DateLayout, d.LicenseExpiry); err != nil {
+			errs.Add("license_expiry", "must be in format dd/mm/yyyy")
+		}
+	}
+}
+
+// LicenseExpiresBefore reports whether the driver's license on record runs
+// out before day. A driver without a license on record is not held up here;
+// the expiring documents report lists them instead.
+func (d Driver) LicenseExpiresBefore(day time.Time) bool {
+	return d.LicenseExpiry != nil && d.LicenseExpiry.Before(day)
+}
@@ -2,18 +2,79 @@ package models
 
 import (
 	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
 )
 
 type DriverIncentive struct {
 	ID        uint      `gorm:"primaryKey;autoIncrement;unique" json:"id"`
 	BookingID *uint      `json:"booking_id"`
 	Incentive int       `json:"incentive"`
+	RuleVersion *int    `json:"rule_version"`
+	PayoutPeriodID *uint `json:"payout_period_id"`
+	PaidAt    *time.Time `json:"paid_at"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
 
 	Booking Booking `gorm:"foreignKey:BookingID" json:"booking,omitempty"`
 }
 
+// Pages of a driver's incentives hold DefaultIncentivePageSize incentives
+// unless asked otherwise, and never more than MaxIncentivePageSize.
+const (
+	DefaultIncentivePageSize = 50
+	MaxIncentivePageSize     = 500
+)
+
+// IncentiveFilter narrows a driver's incentives to those of bookings ending
+// from From to To, both optional and included, and picks one page of them.
+type IncentiveFilter struct {
+	From     string `form:"from" json:"from"`
+	To       string `form:"to" json:"to"`
+	Page     int    `form:"page" json:"page" binding:"gte=0"`
+	PageSize int    `form:"page_size" json:"page_size" binding:"gte=0,lte=500"`
+}
+
+func (f IncentiveFilter) Validate(errs *validation.Errors) {
+	from, fromErr := time.Parse(DateLayout, f.From)
+	if f.From != "" && fromErr != nil {
+		errs.Add("from", "must be in format dd/mm/yyyy")
+	}
+	to, toErr := time.Parse(DateLayout, f.To)
+	if f.To != "" && toErr != nil {
+		errs.Add("to", "must be in format dd/mm/yyyy")
+	}
+	if f.From != "" && f.To != "" && fromErr == nil && toErr == nil && to.Before(from) {
+		errs.Add("to", "must not be before from")
+	}
+}
+
+// Range returns the days asked for, zero when left open. It assumes the
+// filter passed validation.
+func (f IncentiveFilter) Range() (time.Time, time.Time) {
+	from, _ := time.Parse(DateLayout, f.From)
+	to, _ := time.Parse(DateLayout, f.To)
+	return from, to
+}
+
+// Limit and Offset select the page asked for, the first one by default.
+func (f IncentiveFilter) Limit() int {
+	if f.PageSize == 0 {
+		return DefaultIncentivePageSize
+	}
+	return f.PageSize
+}
+
+func (f IncentiveFilter) Offset() int {
+	if f.Page <= 1 {
+		return 0
+	}
+	return (f.Page - 1) * f.Limit()
+}
+
 type InputDriverIncentive struct {
 	BookingID uint      `json:"booking_id" binding:"required"`
 	Incentive int       `json:"incentive" binding:"required,gt=0"`
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// Extra pricing. Per-day extras are billed for every day of the rent,
+// per-booking extras once.
+const (
+	ExtraPricingPerDay     = "per_day"
+	ExtraPricingPerBooking = "per_booking"
+)
+
+// Extra is an add-on rented together with a car, such as a child seat or a
+// GPS. Stock is how many the company owns; bookings holding a car over a
+// period also hold their extras.
+type Extra struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Pricing     string         `json:"pricing"`
+	Price       int            `json:"price"`
+	Stock       int            `json:"stock"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+}
+
+type InputExtra struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+	Pricing     string `json:"pricing" binding:"required,oneof=per_day per_booking"`
+	Price       int    `json:"price" binding:"gte=0"`
+	Stock       int    `json:"stock" binding:"gte=0"`
+}
+
+// BookingExtra is an extra on a booking, priced when the booking was made.
+type BookingExtra struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	BookingID uint      `json:"booking_id"`
+	ExtraID   uint      `json:"extra_id"`
+	Quantity  int       `json:"quantity"`
+	Pricing   string    `json:"pricing"`
+	UnitPrice int       `json:"unit_price"`
+	Total     int       `json:"total"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Extra *Extra `gorm:"foreignKey:ExtraID" json:"extra,omitempty"`
+}
+
+type InputBookingExtra struct {
+	ExtraID  uint `json:"extra_id" binding:"required"`
+	Quantity int  `json:"quantity" binding:"required,gt=0"`
+}
+
+// validateExtras rejects an extra listed more than once; its quantity says
+// how many are wanted.
+func validateExtras(extras []InputBookingExtra, errs *validation.Errors) {
+	seen := map[uint]bool{}
+	for i, extra := range extras {
+		if extra.ExtraID == 0 {
+			continue
+		}
+		if seen[extra.ExtraID] {
+			errs.Add(fmt.Sprintf("extras[%d].extra_id", i), "is listed more than once")
+		}
+		seen[extra.ExtraID] = true
+	}
+}
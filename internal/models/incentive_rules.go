@@ -0,0 +1,115 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// IncentiveRule is one version of how drivers earn an incentive on a
+// booking: a percentage of the rent plus a flat amount per day, a bonus for
+// long trips and an extra amount for every Saturday and Sunday driven.
+// Tiers override the percentage and daily amount for drivers of that tier.
+// Drivers rated RatingBonusMin or better over at least RatingBonusMinCount
+// ratings earn RatingBonusPercentage more on top.
+// Rules are never edited; a new version replaces the current one, so the
+// RuleVersion kept on an incentive always explains how it was worked out.
+type IncentiveRule struct {
+	ID                    uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	Version               int            `json:"version"`
+	Name                  string         `json:"name"`
+	RentPercentage        int            `json:"rent_percentage"`
+	DailyFlat             int            `json:"daily_flat"`
+	LongTripMinDays       int            `json:"long_trip_min_days"`
+	LongTripBonus         int            `json:"long_trip_bonus"`
+	WeekendDailyBonus     int            `json:"weekend_daily_bonus"`
+	RatingBonusPercentage int            `json:"rating_bonus_percentage"`
+	RatingBonusMin        float64        `json:"rating_bonus_min"`
+	RatingBonusMinCount   int            `json:"rating_bonus_min_count"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Tiers []IncentiveRuleTier `gorm:"foreignKey:IncentiveRuleID" json:"tiers"`
+}
+
+type InputIncentiveRule struct {
+	Name                  string                   `json:"name" binding:"required,max=100"`
+	RentPercentage        int                      `json:"rent_percentage" binding:"gte=0,lte=100"`
+	DailyFlat             int                      `json:"daily_flat" binding:"gte=0"`
+	LongTripMinDays       int                      `json:"long_trip_min_days" binding:"gte=0"`
+	LongTripBonus         int                      `json:"long_trip_bonus" binding:"gte=0"`
+	WeekendDailyBonus     int                      `json:"weekend_daily_bonus" binding:"gte=0"`
+	RatingBonusPercentage int                      `json:"rating_bonus_percentage" binding:"gte=0,lte=100"`
+	RatingBonusMin        float64                  `json:"rating_bonus_min" binding:"gte=0,lte=5"`
+	RatingBonusMinCount   int                      `json:"rating_bonus_min_count" binding:"gte=0"`
+	Tiers                 []InputIncentiveRuleTier `json:"tiers" binding:"dive"`
+}
+
+func (r InputIncentiveRule) Validate(errs *validation.Errors) {
+	if r.LongTripBonus > 0 && r.LongTripMinDays == 0 {
+		errs.Add("long_trip_min_days", "is required when long_trip_bonus is set")
+	}
+	if r.RatingBonusPercentage > 0 && r.RatingBonusMin == 0 {
+		errs.Add("rating_bonus_min", "is required when rating_bonus_percentage is set")
+	}
+	seen := map[string]bool{}
+	for i, tier := range r.Tiers {
+		if tier.Tier == "" {
+			continue
+		}
+		if seen[tier.Tier] {
+			errs.Add(fmt.Sprintf("tiers[%d].tier", i), "is listed more than once")
+		}
+		seen[tier.Tier] = true
+	}
+}
+
+// IncentiveRuleTier is what drivers of one tier earn instead of the rule's
+// percentage and daily amount.
+type IncentiveRuleTier struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	IncentiveRuleID uint      `json:"incentive_rule_id"`
+	Tier            string    `json:"tier"`
+	RentPercentage  int       `json:"rent_percentage"`
+	DailyFlat       int       `json:"daily_flat"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type InputIncentiveRuleTier struct {
+	Tier           string `json:"tier" binding:"required,max=30"`
+	RentPercentage int    `json:"rent_percentage" binding:"gte=0,lte=100"`
+	DailyFlat      int    `json:"daily_flat" binding:"gte=0"`
+}
+
+// Incentive works out what driver earns on booking under this rule.
+func (r IncentiveRule) Incentive(booking Booking, driver Driver) int {
+	percentage, dailyFlat := r.RentPercentage, r.DailyFlat
+	for _, tier := range r.Tiers {
+		if driver.IncentiveTier != "" && tier.Tier == driver.IncentiveTier {
+			percentage, dailyFlat = tier.RentPercentage, tier.DailyFlat
+		}
+	}
+
+	days, weekendDays := 0, 0
+	for day := booking.StartRent; !day.After(booking.EndRent); day = day.AddDate(0, 0, 1) {
+		days++
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			weekendDays++
+		}
+	}
+
+	incentive := booking.TotalCost*percentage/100 + days*dailyFlat + weekendDays*r.WeekendDailyBonus
+	if r.LongTripMinDays > 0 && days >= r.LongTripMinDays {
+		incentive += r.LongTripBonus
+	}
+	if r.RatingBonusPercentage > 0 && driver.RatingAverage != nil &&
+		*driver.RatingAverage >= r.RatingBonusMin && driver.RatingCount >= r.RatingBonusMinCount {
+		incentive += incentive * r.RatingBonusPercentage / 100
+	}
+	return incentive
+}
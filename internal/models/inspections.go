@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Inspection kinds: the vehicle is checked out to the customer at pickup
+// and checked back in at return.
+const (
+	InspectionCheckout = "checkout"
+	InspectionCheckin  = "checkin"
+)
+
+// Damage severities, from least to most serious.
+const (
+	DamageMinor    = "minor"
+	DamageModerate = "moderate"
+	DamageMajor    = "major"
+)
+
+// Inspection records the state of a vehicle at pickup or return of a
+// booking. Checklist maps items such as "spare_tyre" to whether they were
+// present and working; FuelLevel is a percentage of a full tank.
+type Inspection struct {
+	ID          uint            `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	BookingID   uint            `json:"booking_id"`
+	VehicleID   uint            `json:"vehicle_id"`
+	Kind        string          `json:"kind"`
+	Checklist   map[string]bool `json:"checklist" gorm:"serializer:json"`
+	FuelLevel   int             `json:"fuel_level"`
+	Odometer    int             `json:"odometer"`
+	Notes       string          `json:"notes"`
+	InspectedAt time.Time       `json:"inspected_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt  `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Damages []InspectionDamage `gorm:"foreignKey:InspectionID" json:"damages"`
+	Images  []InspectionImage  `gorm:"foreignKey:InspectionID" json:"images"`
+}
+
+type InspectionDamage struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	InspectionID uint      `json:"inspection_id"`
+	Location     string    `json:"location"`
+	Severity     string    `json:"severity"`
+	Description  string    `json:"description"`
+	RepairCost   int       `json:"repair_cost"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// InspectionImage is a photo of a vehicle, optionally of one damage. The
+// file itself lives in file storage under StorageKey.
+type InspectionImage struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	InspectionID uint      `json:"inspection_id"`
+	DamageID     *uint     `json:"damage_id"`
+	StorageKey   string    `json:"-"`
+	FileName     string    `json:"file_name"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type InputInspection struct {
+	BookingID uint            `json:"booking_id" binding:"required"`
+	Kind      string          `json:"kind" binding:"required,oneof=checkout checkin"`
+	Checklist map[string]bool `json:"checklist"`
+	FuelLevel *int            `json:"fuel_level" binding:"required,gte=0,lte=100"`
+	Odometer  int             `json:"odometer" binding:"required,gt=0"`
+	Notes     string          `json:"notes"`
+	Damages   []InputDamage   `json:"damages" binding:"dive"`
+}
+
+type InputDamage struct {
+	Location    string `json:"location" binding:"required,max=100"`
+	Severity    string `json:"severity" binding:"required,oneof=minor moderate major"`
+	Description string `json:"description"`
+	RepairCost  int    `json:"repair_cost" binding:"gte=0"`
+}
+
+// InspectionComparison sets the return inspection of a booking against its
+// pickup inspection. NewDamages are the damages found at return that were
+// not already there, no worse, at pickup. RepairCost adds up their repairs;
+// DamageCharge is what the customer pays of it, no more than the excess of
+// the booking's insurance plan.
+type InspectionComparison struct {
+	BookingID      uint               `json:"booking_id"`
+	Checkout       Inspection         `json:"checkout"`
+	Checkin        Inspection         `json:"checkin"`
+	NewDamages     []InspectionDamage `json:"new_damages"`
+	MissingItems   []string           `json:"missing_items"`
+	Distance       int                `json:"distance"`
+	FuelDifference int                `json:"fuel_difference"`
+	RepairCost     int                `json:"repair_cost"`
+	DamageCharge   int                `json:"damage_charge"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// InsurancePlan is a coverage a customer can buy with a booking. The daily
+// premium is billed for every day of the rent; damage found at return is
+// charged up to the excess and the plan covers the rest.
+type InsurancePlan struct {
+	ID           uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	DailyPremium int            `json:"daily_premium"`
+	Excess       int            `json:"excess"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+}
+
+type InputInsurancePlan struct {
+	Name         string `json:"name" binding:"required,max=100"`
+	Description  string `json:"description"`
+	DailyPremium int    `json:"daily_premium" binding:"gte=0"`
+	Excess       int    `json:"excess" binding:"gte=0"`
+}
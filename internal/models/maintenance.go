@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// Maintenance types. Only a completed service resets the service interval.
+const (
+	MaintenanceTypeService    = "service"
+	MaintenanceTypeRepair     = "repair"
+	MaintenanceTypeTyres      = "tyres"
+	MaintenanceTypeInspection = "inspection"
+	MaintenanceTypeOther      = "other"
+)
+
+// MaintenanceRecord is a workshop visit of one vehicle. Until it is
+// completed the vehicle cannot be rented during the planned window.
+type MaintenanceRecord struct {
+	ID           uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	VehicleID    uint           `json:"vehicle_id"`
+	Type         string         `json:"type"`
+	PlannedStart time.Time      `json:"planned_start"`
+	PlannedEnd   time.Time      `json:"planned_end"`
+	CompletedAt  *time.Time     `json:"completed_at"`
+	Odometer     *int           `json:"odometer"`
+	Cost         int            `json:"cost"`
+	Workshop     string         `json:"workshop"`
+	Notes        string         `json:"notes"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Vehicle *Vehicle `gorm:"foreignKey:VehicleID" json:"vehicle,omitempty"`
+}
+
+type InputMaintenanceRecord struct {
+	VehicleID    uint   `json:"vehicle_id" binding:"required"`
+	Type         string `json:"type" binding:"required,oneof=service repair tyres inspection other"`
+	PlannedStart string `json:"planned_start" binding:"required"`
+	PlannedEnd   string `json:"planned_end" binding:"required"`
+	CompletedAt  string `json:"completed_at"`
+	Odometer     *int   `json:"odometer" binding:"omitempty,gte=0"`
+	Cost         int    `json:"cost" binding:"gte=0"`
+	Workshop     string `json:"workshop" binding:"max=255"`
+	Notes        string `json:"notes"`
+}
+
+func (m InputMaintenanceRecord) Validate(errs *validation.Errors) {
+	plannedStart, startErr := time.Parse(DateLayout, m.PlannedStart)
+	if m.PlannedStart != "" && startErr != nil {
+		errs.Add("planned_start", "must be in format dd/mm/yyyy")
+	}
+	plannedEnd, endErr := time.Parse(DateLayout, m.PlannedEnd)
+	if m.PlannedEnd != "" && endErr != nil {
+		errs.Add("planned_end", "must be in format dd/mm/yyyy")
+	}
+	if startErr == nil && endErr == nil && plannedEnd.Before(plannedStart) {
+		errs.Add("planned_end", "must not be before planned_start")
+	}
+	if m.CompletedAt != "" {
+		if _, err := time.Parse(DateLayout, m.CompletedAt); err != nil {
+			errs.Add("completed_at", "must be in format dd/mm/yyyy")
+		}
+		if m.Odometer == nil {
+			errs.Add("odometer", "is required once the maintenance is completed")
+		}
+	}
+}
+
+// MaintenanceDue is a reminder that a vehicle needs its next service.
+type MaintenanceDue struct {
+	Vehicle             Vehicle    `json:"vehicle"`
+	LastServiceAt       *time.Time `json:"last_service_at"`
+	LastServiceOdometer int        `json:"last_service_odometer"`
+	DueAt               time.Time  `json:"due_at"`
+	DueOdometer         int        `json:"due_odometer"`
+	Overdue             bool       `json:"overdue"`
+	Scheduled           bool       `json:"scheduled"`
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"car-rental/pkg/validation"
+
+	"gorm.io/gorm"
+)
+
+// PayoutPeriod is a stretch of days, usually a month, drivers are paid for
+// in one go: their daily cost and incentives from the finished bookings that
+// ended in it. Once settled, those bookings and incentives point at the
+// period and are never paid again.
+type PayoutPeriod struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	StartDate time.Time      `json:"start_date"`
+	EndDate   time.Time      `json:"end_date"`
+	SettledAt *time.Time     `json:"settled_at"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+}
+
+type InputPayoutPeriod struct {
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date" binding:"required"`
+}
+
+func (p InputPayoutPeriod) Validate(errs *validation.Errors) {
+	startDate, startErr := time.Parse(DateLayout, p.StartDate)
+	if p.StartDate != "" && startErr != nil {
+		errs.Add("start_date", "must be in format dd/mm/yyyy")
+	}
+	endDate, endErr := time.Parse(DateLayout, p.EndDate)
+	if p.EndDate != "" && endErr != nil {
+		errs.Add("end_date", "must be in format dd/mm/yyyy")
+	}
+	if startErr == nil && endErr == nil && endDate.Before(startDate) {
+		errs.Add("end_date", "must not be before start_date")
+	}
+}
+
+// PayoutStatement is what a period pays every driver. For a period not
+// settled yet it lists what is still unpaid; once settled, what was paid.
+type PayoutStatement struct {
+	Period         PayoutPeriod   `json:"period"`
+	Drivers        []DriverPayout `json:"drivers"`
+	DriverEarnings int            `json:"driver_earnings"`
+	Incentives     int            `json:"incentives"`
+	Total          int            `json:"total"`
+}
+
+// DriverPayout is one driver's share of a payout statement.
+type DriverPayout struct {
+	DriverID       uint            `json:"driver_id"`
+	DriverName     string          `json:"driver_name"`
+	Bookings       []PayoutBooking `json:"bookings"`
+	DriverEarnings int             `json:"driver_earnings"`
+	Incentives     int             `json:"incentives"`
+	Total          int             `json:"total"`
+}
+
+// PayoutBooking is what one booking pays its driver: the driver's daily cost
+// over the rent, unless it was paid before, and its incentives.
+type PayoutBooking struct {
+	BookingID      uint      `json:"booking_id"`
+	StartRent      time.Time `json:"start_rent"`
+	EndRent        time.Time `json:"end_rent"`
+	DriverEarnings int       `json:"driver_earnings"`
+	Incentives     int       `json:"incentives"`
+}
+
+// DriverOutstanding is what a driver has earned on finished bookings and was
+// not paid yet, whatever the period.
+type DriverOutstanding struct {
+	DriverID       uint   `json:"driver_id"`
+	DriverName     string `json:"driver_name"`
+	Bookings       int    `json:"bookings"`
+	DriverEarnings int    `json:"driver_earnings"`
+	Incentives     int    `json:"incentives"`
+	Total          int    `json:"total"`
+}
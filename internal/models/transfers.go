@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Vehicle transfer statuses. A transfer is requested, the vehicle leaves
+// its branch when it is shipped and belongs to the new branch once received.
+// A transfer not received yet can be cancelled.
+const (
+	TransferStatusRequested = "requested"
+	TransferStatusInTransit = "in_transit"
+	TransferStatusCompleted = "completed"
+	TransferStatusCancelled = "cancelled"
+)
+
+// VehicleTransfer moves a vehicle from one branch to another. While in
+// transit the vehicle cannot be rented at either branch.
+type VehicleTransfer struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	VehicleID    uint       `json:"vehicle_id"`
+	FromBranchID uint       `json:"from_branch_id"`
+	ToBranchID   uint       `json:"to_branch_id"`
+	Status       string     `json:"status"`
+	Notes        string     `json:"notes"`
+	RequestedAt  time.Time  `json:"requested_at"`
+	ShippedAt    *time.Time `json:"shipped_at"`
+	ReceivedAt   *time.Time `json:"received_at"`
+	CancelledAt  *time.Time `json:"cancelled_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+
+	Vehicle    *Vehicle `gorm:"foreignKey:VehicleID" json:"vehicle,omitempty"`
+	FromBranch *Branch  `gorm:"foreignKey:FromBranchID" json:"from_branch,omitempty"`
+	ToBranch   *Branch  `gorm:"foreignKey:ToBranchID" json:"to_branch,omitempty"`
+}
+
+type InputVehicleTransfer struct {
+	VehicleID  uint   `json:"vehicle_id" binding:"required"`
+	ToBranchID uint   `json:"to_branch_id" binding:"required"`
+	Notes      string `json:"notes"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Vehicle statuses. Only active vehicles can be rented; whether one is out
+// on a booking right now follows from the bookings, not from its status.
+const (
+	VehicleStatusActive   = "active"
+	VehicleStatusInactive = "inactive"
+	VehicleStatusRetired  = "retired"
+)
+
+// Vehicle is one physical unit of a Car model, kept at a branch. It only
+// changes branch through a vehicle transfer or a one-way booking.
+type Vehicle struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement;unique" json:"id"`
+	CarID       uint           `json:"car_id"`
+	BranchID    uint           `json:"branch_id"`
+	PlateNumber string         `json:"plate_number"`
+	VIN         string         `json:"vin" gorm:"column:vin"`
+	Color       string         `json:"color"`
+	Year        int            `json:"year"`
+	Odometer    int            `json:"odometer"`
+	Status      string         `json:"status"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string"`
+
+	Car    *Car    `gorm:"foreignKey:CarID" json:"car,omitempty"`
+	Branch *Branch `gorm:"foreignKey:BranchID" json:"branch,omitempty"`
+}
+
+type InputVehicle struct {
+	CarID       uint   `json:"car_id" binding:"required"`
+	BranchID    uint   `json:"branch_id" binding:"required"`
+	PlateNumber string `json:"plate_number" binding:"required,max=20"`
+	VIN         string `json:"vin" binding:"required,len=17"`
+	Color       string `json:"color" binding:"max=50"`
+	Year        int    `json:"year" binding:"required,gte=1900"`
+	Odometer    int    `json:"odometer" binding:"gte=0"`
+	Status      string `json:"status" binding:"omitempty,oneof=active inactive retired"`
+}
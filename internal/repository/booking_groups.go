@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BookingGroupsQuery interface {
+	GetBookingGroups(ctx context.Context, includeDeleted bool) ([]models.BookingGroup, error)
+	GetBookingGroupsByID(ctx context.Context, id uint64) (models.BookingGroup, error)
+	CreateBookingGroups(ctx context.Context, group models.BookingGroup, incentives []*models.DriverIncentive) (models.BookingGroup, error)
+	CancelBookingGroupLines(ctx context.Context, id uint64, bookingID uint64, cancelledAt time.Time, discountPercentage int, repriced []models.Booking) (models.BookingGroup, error)
+}
+
+type bookingGroupsQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewBookingGroupsQuery(db infrastructure.GormPostgres) BookingGroupsQuery {
+	return &bookingGroupsQueryImpl{db: db}
+}
+
+// withBookingGroupRelations preloads the customer and the bookings of a
+// group with what a booking response shows.
+func withBookingGroupRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Customer", unscoped).
+		Preload("Bookings", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Bookings.Car", unscoped).
+		Preload("Bookings.Driver", unscoped).
+		Preload("Bookings.BookingType", unscoped).
+		Preload("Bookings.Vehicle", unscoped).
+		Preload("Bookings.PickupBranch", unscoped).
+		Preload("Bookings.ReturnBranch", unscoped).
+		Preload("Bookings.Extras", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Bookings.Extras.Extra", unscoped).
+		Preload("Bookings.InsurancePlan", unscoped)
+}
+
+func (u *bookingGroupsQueryImpl) GetBookingGroups(ctx context.Context, includeDeleted bool) ([]models.BookingGroup, error) {
+	db := u.db.GetConnection()
+	groups := []models.BookingGroup{}
+	if err := withBookingGroupRelations(withDeleted(db, includeDeleted).WithContext(ctx)).
+		Order("id").
+		Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (u *bookingGroupsQueryImpl) GetBookingGroupsByID(ctx context.Context, id uint64) (models.BookingGroup, error) {
+	db := u.db.GetConnection()
+	group := models.BookingGroup{}
+	if err := withBookingGroupRelations(db.WithContext(ctx)).
+		First(&group, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.BookingGroup{}, nil
+		}
+		return models.BookingGroup{}, err
+	}
+	return group, nil
+}
+
+// GroupLineError is an error met storing one line of a booking group.
+type GroupLineError struct {
+	Line int
+	Err  error
+}
+
+func (e *GroupLineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *GroupLineError) Unwrap() error {
+	return e.Err
+}
+
+// CreateBookingGroups saves a group together with its bookings, their extras
+// and the driver incentives of incentives, which lines up with the bookings,
+// all or none of them. The cars and extras of every line are locked first and
+// each line goes through holdUnit, holdExtras and holdCredit, so the group
+// cannot take a unit, an extra or credit another booking took since it was
+// priced.
+func (u *bookingGroupsQueryImpl) CreateBookingGroups(ctx context.Context, group models.BookingGroup, incentives []*models.DriverIncentive) (models.BookingGroup, error) {
+	db := u.db.GetConnection()
+	bookings := group.Bookings
+	group.Bookings = nil
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		carIDs := make([]uint, len(bookings))
+		for i, booking := range bookings {
+			carIDs[i] = booking.CarID
+		}
+		if err := lockCars(tx, carIDs); err != nil {
+			return err
+		}
+		extraIDs := []uint{}
+		for _, booking := range bookings {
+			for _, item := range booking.Extras {
+				extraIDs = append(extraIDs, item.ExtraID)
+			}
+		}
+		if len(extraIDs) > 0 {
+			if _, err := lockExtras(tx, extraIDs); err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Customer", "Bookings").Create(&group).Error; err != nil {
+			return err
+		}
+		for i := range bookings {
+			if err := holdUnit(tx, bookings[i], 0); err != nil {
+				return &GroupLineError{Line: i, Err: err}
+			}
+			if err := holdExtras(tx, bookings[i], 0); err != nil {
+				return &GroupLineError{Line: i, Err: err}
+			}
+			bookings[i].BookingGroupID = &group.ID
+			if err := tx.Create(&bookings[i]).Error; err != nil {
+				return err
+			}
+			if err := holdCredit(tx, bookings[i].CompanyID); err != nil {
+				return &GroupLineError{Line: i, Err: err}
+			}
+			if err := createIncentive(tx, bookings[i].ID, incentives[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+	return u.GetBookingGroupsByID(ctx, uint64(group.ID))
+}
+
+// CancelBookingGroupLines cancels one booking of a group, which frees its car
+// and drops its unpaid driver incentive, and stores the group discount the
+// remaining bookings are left with, in one transaction.
+func (u *bookingGroupsQueryImpl) CancelBookingGroupLines(ctx context.Context, id uint64, bookingID uint64, cancelledAt time.Time, discountPercentage int, repriced []models.Booking) (models.BookingGroup, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Booking{}).
+			Where("id = ? AND booking_group_id = ?", bookingID, id).
+			Updates(map[string]any{"finished": true, "cancelled_at": cancelledAt, "updated_at": cancelledAt}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.DriverIncentive{}).
+			Where("booking_id = ? AND payout_period_id IS NULL", bookingID).
+			UpdateColumn("deleted_at", cancelledAt).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.BookingGroup{}).
+			Where("id = ?", id).
+			Updates(map[string]any{"discount_percentage": discountPercentage, "updated_at": cancelledAt}).Error; err != nil {
+			return err
+		}
+		for _, booking := range repriced {
+			if err := tx.Model(&models.Booking{}).
+				Where("id = ?", booking.ID).
+				Updates(map[string]any{"group_discount": booking.GroupDiscount, "deposit": booking.Deposit, "updated_at": cancelledAt}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+	return u.GetBookingGroupsByID(ctx, id)
+}
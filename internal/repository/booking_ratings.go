@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BookingRatingsQuery interface {
+	GetBookingRatings(ctx context.Context, filter models.BookingRatingFilter) ([]models.BookingRating, error)
+	GetBookingRatingsByID(ctx context.Context, id uint64) (models.BookingRating, error)
+	GetBookingRatingsByIDWithDeleted(ctx context.Context, id uint64) (models.BookingRating, error)
+	GetBookingRatingByBookingID(ctx context.Context, bookingID uint64) (models.BookingRating, error)
+	CreateBookingRatings(ctx context.Context, rating models.BookingRating) (models.BookingRating, error)
+	EditBookingRatings(ctx context.Context, id uint64, rating models.BookingRating) (models.BookingRating, error)
+	DeleteBookingRatingsByID(ctx context.Context, rating models.BookingRating) error
+	RestoreBookingRatingsByID(ctx context.Context, rating models.BookingRating) (models.BookingRating, error)
+}
+
+type bookingRatingsQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewBookingRatingsQuery(db infrastructure.GormPostgres) BookingRatingsQuery {
+	return &bookingRatingsQueryImpl{db: db}
+}
+
+// GetBookingRatings lists ratings, latest first, narrowed by the filter.
+func (u *bookingRatingsQueryImpl) GetBookingRatings(ctx context.Context, filter models.BookingRatingFilter) ([]models.BookingRating, error) {
+	db := u.db.GetConnection()
+	query := withDeleted(db, filter.IncludeDeleted).WithContext(ctx)
+	if filter.BookingID != 0 {
+		query = query.Where("booking_id = ?", filter.BookingID)
+	}
+	if filter.CarID != 0 {
+		query = query.Where("car_id = ?", filter.CarID)
+	}
+	if filter.DriverID != 0 {
+		query = query.Where("driver_id = ?", filter.DriverID)
+	}
+	ratings := []models.BookingRating{}
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (u *bookingRatingsQueryImpl) GetBookingRatingsByID(ctx context.Context, id uint64) (models.BookingRating, error) {
+	db := u.db.GetConnection()
+	rating := models.BookingRating{}
+	if err := db.
+		WithContext(ctx).
+		First(&rating, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.BookingRating{}, nil
+		}
+		return models.BookingRating{}, err
+	}
+	return rating, nil
+}
+
+// GetBookingRatingsByIDWithDeleted also finds soft-deleted ratings.
+func (u *bookingRatingsQueryImpl) GetBookingRatingsByIDWithDeleted(ctx context.Context, id uint64) (models.BookingRating, error) {
+	db := u.db.GetConnection()
+	rating := models.BookingRating{}
+	if err := withDeleted(db, true).
+		WithContext(ctx).
+		Where("id = ?", id).
+		Limit(1).
+		Find(&rating).Error; err != nil {
+		return models.BookingRating{}, err
+	}
+	return rating, nil
+}
+
+// GetBookingRatingByBookingID finds the rating given for a booking, if any.
+func (u *bookingRatingsQueryImpl) GetBookingRatingByBookingID(ctx context.Context, bookingID uint64) (models.BookingRating, error) {
+	db := u.db.GetConnection()
+	rating := models.BookingRating{}
+	if err := db.
+		WithContext(ctx).
+		Where("booking_id = ?", bookingID).
+		Limit(1).
+		Find(&rating).Error; err != nil {
+		return models.BookingRating{}, err
+	}
+	return rating, nil
+}
+
+// refreshRatings works out again the rolling averages of the car and driver
+// a rating is about: the average of their last models.RatingWindow ratings,
+// and how many ratings they have in all.
+func refreshRatings(tx *gorm.DB, rating models.BookingRating) error {
+	if err := tx.Exec(`UPDATE cars SET
+		rating_average = (SELECT ROUND(AVG(car_rating), 2) FROM (
+			SELECT car_rating FROM booking_ratings
+			WHERE car_id = ? AND deleted_at IS NULL
+			ORDER BY created_at DESC, id DESC LIMIT ?) AS recent),
+		rating_count = (SELECT COUNT(*) FROM booking_ratings WHERE car_id = ? AND deleted_at IS NULL)
+		WHERE id = ?`,
+		rating.CarID, models.RatingWindow, rating.CarID, rating.CarID).Error; err != nil {
+		return err
+	}
+	if rating.DriverID == nil {
+		return nil
+	}
+	return tx.Exec(`UPDATE drivers SET
+		rating_average = (SELECT ROUND(AVG(driver_rating), 2) FROM (
+			SELECT driver_rating FROM booking_ratings
+			WHERE driver_id = ? AND driver_rating IS NOT NULL AND deleted_at IS NULL
+			ORDER BY created_at DESC, id DESC LIMIT ?) AS recent),
+		rating_count = (SELECT COUNT(*) FROM booking_ratings
+			WHERE driver_id = ? AND driver_rating IS NOT NULL AND deleted_at IS NULL)
+		WHERE id = ?`,
+		*rating.DriverID, models.RatingWindow, *rating.DriverID, *rating.DriverID).Error
+}
+
+// CreateBookingRatings saves a rating and updates the averages of its car
+// and driver in the same transaction.
+func (u *bookingRatingsQueryImpl) CreateBookingRatings(ctx context.Context, rating models.BookingRating) (models.BookingRating, error) {
+	db := u.db.GetConnection()
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&rating).Error; err != nil {
+			return err
+		}
+		return refreshRatings(tx, rating)
+	}); err != nil {
+		return models.BookingRating{}, err
+	}
+	return u.GetBookingRatingsByID(ctx, uint64(rating.ID))
+}
+
+func (u *bookingRatingsQueryImpl) EditBookingRatings(ctx context.Context, id uint64, rating models.BookingRating) (models.BookingRating, error) {
+	db := u.db.GetConnection()
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Model(&models.BookingRating{}).
+			Where("id = ?", id).
+			Select("car_rating", "driver_rating", "overall_rating", "comment", "updated_at").
+			Updates(&rating).Error; err != nil {
+			return err
+		}
+		return refreshRatings(tx, rating)
+	}); err != nil {
+		return models.BookingRating{}, err
+	}
+	return u.GetBookingRatingsByID(ctx, id)
+}
+
+func (u *bookingRatingsQueryImpl) DeleteBookingRatingsByID(ctx context.Context, rating models.BookingRating) error {
+	db := u.db.GetConnection()
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.BookingRating{ID: rating.ID}).Error; err != nil {
+			return err
+		}
+		return refreshRatings(tx, rating)
+	})
+}
+
+func (u *bookingRatingsQueryImpl) RestoreBookingRatingsByID(ctx context.Context, rating models.BookingRating) (models.BookingRating, error) {
+	db := u.db.GetConnection()
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Unscoped().
+			Model(&models.BookingRating{}).
+			Where("id = ?", rating.ID).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return refreshRatings(tx, rating)
+	}); err != nil {
+		return models.BookingRating{}, err
+	}
+	return u.GetBookingRatingsByID(ctx, uint64(rating.ID))
+}
@@ -10,11 +10,13 @@ import (
 )
 
 type BookingTypesQuery interface {
-	GetBookingTypes(ctx context.Context) ([]models.BookingType, error)
+	GetBookingTypes(ctx context.Context, includeDeleted bool) ([]models.BookingType, error)
 	GetBookingTypesByID(ctx context.Context, id uint64) (models.BookingType, error)
 	EditBookingTypes(ctx context.Context, id uint64, bookingTypes models.BookingType) (models.BookingType, error)
 	DeleteBookingTypesByID(ctx context.Context, id uint64) error
 	CreateBookingTypes(ctx context.Context, bookingTypes models.BookingType) (models.BookingType, error)
+	RestoreBookingTypesByID(ctx context.Context, id uint64) (models.BookingType, error)
+	ReassignAndDeleteBookingTypesByID(ctx context.Context, id uint64, to uint64) error
 }
 
 type BookingTypesCommand interface {
@@ -29,10 +31,10 @@ func NewBookingTypesQuery(db infrastructure.GormPostgres) BookingTypesQuery {
 	return &bookingTypesQueryImpl{db: db}
 }
 
-func (u *bookingTypesQueryImpl) GetBookingTypes(ctx context.Context) ([]models.BookingType, error) {
+func (u *bookingTypesQueryImpl) GetBookingTypes(ctx context.Context, includeDeleted bool) ([]models.BookingType, error) {
 	db := u.db.GetConnection()
 	bookingTypes := []models.BookingType{}
-	if err := db.
+	if err := withDeleted(db, includeDeleted).
 		WithContext(ctx).
 		Table("booking_types").
 		Find(&bookingTypes).Error; err != nil {
@@ -84,13 +86,52 @@ func (u *bookingTypesQueryImpl) CreateBookingTypes(ctx context.Context, bookingT
 func (u *bookingTypesQueryImpl) EditBookingTypes(ctx context.Context, id uint64, bookingType models.BookingType) (models.BookingType, error) {
 	db := u.db.GetConnection()
 	updatedBookingTypes := models.BookingType{}
+	// Rule flags and limits may legitimately be set back to false or 0,
+	// which Updates would otherwise skip.
 	if err := db.
 		WithContext(ctx).
 		Table("booking_types").
-		Where("id = ?", id).Updates(&bookingType).First(&updatedBookingTypes).Error; err != nil {
+		Where("id = ?", id).
+		Select("booking_type", "description", "requires_driver", "allows_driver",
+			"min_days", "max_days", "price_multiplier", "deposit_percentage", "updated_at").
+		Updates(&bookingType).Error; err != nil {
+		return models.BookingType{}, err
+	}
+	if err := db.
+		WithContext(ctx).
+		Table("booking_types").
+		Where("id = ?", id).
+		First(&updatedBookingTypes).Error; err != nil {
 			if err == gorm.ErrRecordNotFound {
 				return models.BookingType{}, nil
 			}
 		}
 	return updatedBookingTypes, nil
 }
+
+func (u *bookingTypesQueryImpl) RestoreBookingTypesByID(ctx context.Context, id uint64) (models.BookingType, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Table("booking_types").
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.BookingType{}, err
+	}
+	return u.GetBookingTypesByID(ctx, id)
+}
+
+// ReassignAndDeleteBookingTypesByID moves every booking of type id over to
+// type to and deletes id, all in one transaction.
+func (u *bookingTypesQueryImpl) ReassignAndDeleteBookingTypesByID(ctx context.Context, id uint64, to uint64) error {
+	db := u.db.GetConnection()
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Booking{}).
+			Where("book_type_id = ?", id).
+			Update("book_type_id", to).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.BookingType{ID: uint(id)}).Error
+	})
+}
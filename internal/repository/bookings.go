@@ -2,23 +2,67 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"car-rental/internal/infrastructure"
 	"car-rental/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrNoUnitLeft is returned when a booking is about to be stored, or picked
+// up without a vehicle asked for, but every serviceable vehicle of its car at
+// the pickup branch is taken.
+var ErrNoUnitLeft = errors.New("no unit of the car is left")
+
+// ErrNoExtraLeft is returned when a booking is about to be stored but
+// another booking took the last of an extra it asks for over its rent.
+var ErrNoExtraLeft = errors.New("not enough of an extra is left")
+
+// ErrCreditExceeded is returned when a booking billed to a company is about
+// to be stored but would bring what the company owes over its credit limit.
+var ErrCreditExceeded = errors.New("company credit limit exceeded")
+
+// ErrVehicleNotFree is returned when the vehicle asked for at pickup went out
+// on another booking, into maintenance or in transit in the meantime.
+var ErrVehicleNotFree = errors.New("vehicle is not free")
+
+// ErrPickedUp is returned when a booking is picked up a second time.
+var ErrPickedUp = errors.New("booking was already picked up")
+
 type BookingsQuery interface {
-	GetBookings(ctx context.Context) ([]models.Booking, error)
+	GetBookings(ctx context.Context, includeDeleted bool) ([]models.Booking, error)
 	GetBookingsByID(ctx context.Context, id uint64) (models.Booking, error)
 	EditBookings(ctx context.Context, id uint64, bookings models.Booking) (models.Booking, error)
+	RepriceBookings(ctx context.Context, id uint64, booking models.Booking, incentive *models.DriverIncentive) (models.Booking, error)
 	DeleteBookingsByID(ctx context.Context, id uint64) error
-	CreateBookings(ctx context.Context, bookings models.Booking) (models.Booking, error)
+	CreateBookings(ctx context.Context, bookings models.Booking, incentive *models.DriverIncentive) (models.Booking, error)
+	RestoreBookingsByID(ctx context.Context, id uint64) (models.Booking, error)
+	GetOpenBookingIDsByCustomerID(ctx context.Context, customerID uint64) ([]uint, error)
+	GetOpenBookingIDsByCarID(ctx context.Context, carID uint64) ([]uint, error)
+	GetOpenBookingIDsByDriverID(ctx context.Context, driverID uint64) ([]uint, error)
+	GetBookingIDsByBookTypeID(ctx context.Context, bookTypeID uint64) ([]uint, error)
+	GetOpenBookingIDsByVehicleID(ctx context.Context, vehicleID uint64) ([]uint, error)
+	GetOpenBookingIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error)
+	GetOpenBookingIDsByExtraID(ctx context.Context, extraID uint64) ([]uint, error)
+	GetOpenBookingIDsByInsurancePlanID(ctx context.Context, planID uint64) ([]uint, error)
+	GetUnpaidBookingIDsByCompanyID(ctx context.Context, companyID uint64) ([]uint, error)
+	SumUnpaidByCompanyID(ctx context.Context, companyID uint64, excludeID uint64) (int64, error)
+	GetBookingsByCompanyID(ctx context.Context, companyID uint64, start, end time.Time) ([]models.Booking, error)
+	GetUnpaidDriverBookings(ctx context.Context, end time.Time) ([]models.Booking, error)
+	GetBookingsByDriverPayoutPeriodID(ctx context.Context, periodID uint64) ([]models.Booking, error)
+	CountOverlappingBookingsByCarID(ctx context.Context, carID uint64, branchID uint64, start, end time.Time, excludeID uint64) (int64, error)
+	PickUpBookings(ctx context.Context, id uint64, vehicleID *uint, pickedUp models.Booking) (models.Booking, error)
+	ReturnBookings(ctx context.Context, id uint64, returned models.Booking) (models.Booking, error)
+	SetBookingDamageCharge(ctx context.Context, id uint64, charge int) (models.Booking, error)
+	CountLateReturnsByCustomerID(ctx context.Context, customerID uint64, since *time.Time) (int64, error)
+	CountOverdueBookingsByCustomerID(ctx context.Context, customerID uint64, now time.Time) (int64, error)
 }
 
 type BookingsCommand interface {
-	CreateBookings(ctx context.Context, bookings models.Booking) (models.Booking, error)
+	CreateBookings(ctx context.Context, bookings models.Booking, incentive *models.DriverIncentive) (models.Booking, error)
 }
 
 type bookingsQueryImpl struct {
@@ -29,16 +73,30 @@ func NewBookingsQuery(db infrastructure.GormPostgres) BookingsQuery {
 	return &bookingsQueryImpl{db: db}
 }
 
-func (u *bookingsQueryImpl) GetBookings(ctx context.Context) ([]models.Booking, error) {
+// withBookingRelations preloads everything a booking response shows. Related
+// rows are loaded unscoped so old bookings still show soft-deleted customers,
+// cars and drivers.
+func withBookingRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Customer", unscoped).
+		Preload("Customer.Membership", unscoped).
+		Preload("Car", unscoped).
+		Preload("Driver", unscoped).
+		Preload("BookingType", unscoped).
+		Preload("Vehicle", unscoped).
+		Preload("PickupBranch", unscoped).
+		Preload("ReturnBranch", unscoped).
+		Preload("Extras", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Extras.Extra", unscoped).
+		Preload("InsurancePlan", unscoped).
+		Preload("Company", unscoped)
+}
+
+func (u *bookingsQueryImpl) GetBookings(ctx context.Context, includeDeleted bool) ([]models.Booking, error) {
 	db := u.db.GetConnection()
 	bookings := []models.Booking{}
 
-	if err := db.WithContext(ctx).
-		Preload("Customer.Membership").
-		Preload("Customer"). 
-		Preload("Car").
-		Preload("Driver").
-		Preload("BookingType").      
+	if err := withBookingRelations(withDeleted(db, includeDeleted).WithContext(ctx)).
 		Find(&bookings).Error; err != nil {
 		return nil, err
 	}
@@ -46,19 +104,12 @@ func (u *bookingsQueryImpl) GetBookings(ctx context.Context) ([]models.Booking,
 	return bookings, nil
 }
 
-
-
 func (u *bookingsQueryImpl) GetBookingsByID(ctx context.Context, id uint64) (models.Booking, error) {
 	db := u.db.GetConnection()
 	bookings := models.Booking{}
 
-	if err := db.WithContext(ctx).
-		Preload("Customer.Membership").
-		Preload("Customer"). 
-		Preload("Car").
-		Preload("Driver").
-		Preload("BookingType").     
-		First(&bookings, id).Error; err != nil { 
+	if err := withBookingRelations(db.WithContext(ctx)).
+		First(&bookings, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return models.Booking{}, nil
 		}
@@ -68,50 +119,61 @@ func (u *bookingsQueryImpl) GetBookingsByID(ctx context.Context, id uint64) (mod
 	return bookings, nil
 }
 
-
+// DeleteBookingsByID soft-deletes a booking together with its driver
+// incentives not paid out yet, in one transaction. They are all stamped with
+// the same time, so RestoreBookingsByID brings back just those.
 func (u *bookingsQueryImpl) DeleteBookingsByID(ctx context.Context, id uint64) error {
 	db := u.db.GetConnection()
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		booking := models.Booking{}
+		if err := tx.First(&booking, id).Error; err != nil {
+			return err
+		}
 
-	booking := models.Booking{}
-	if err := db.WithContext(ctx).
-		Preload("Customer.Membership").
-		Preload("Customer").
-		Preload("Car").
-		Preload("Driver").
-		Preload("BookingType").
-		First(&booking, id).Error; err != nil {
-		return err
-	}
-
-	// Hapus booking
-	if err := db.WithContext(ctx).
-		Delete(&booking).Error; err != nil {
-		return err
-	}
-
-	return nil
+		deletedAt := time.Now()
+		if err := tx.Model(&booking).
+			UpdateColumn("deleted_at", deletedAt).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.DriverIncentive{}).
+			Where("booking_id = ? AND payout_period_id IS NULL", id).
+			UpdateColumn("deleted_at", deletedAt).Error
+	})
 }
 
-
-func (u *bookingsQueryImpl) CreateBookings(ctx context.Context, bookings models.Booking) (models.Booking, error) {
+// CreateBookings stores a booking once holdUnit made sure its car is still
+// free, together with the driver incentive it earns unless that is nil, in
+// one transaction.
+func (u *bookingsQueryImpl) CreateBookings(ctx context.Context, bookings models.Booking, incentive *models.DriverIncentive) (models.Booking, error) {
 	db := u.db.GetConnection()
 
-	if err := db.WithContext(ctx).Table("bookings").Save(&bookings).Error; err != nil {
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := holdUnit(tx, bookings, 0); err != nil {
+			return err
+		}
+		if err := holdExtras(tx, bookings, 0); err != nil {
+			return err
+		}
+		if err := tx.Table("bookings").Save(&bookings).Error; err != nil {
+			return err
+		}
+		if err := holdCredit(tx, bookings.CompanyID); err != nil {
+			return err
+		}
+		return createIncentive(tx, bookings.ID, incentive)
+	})
+	if err != nil {
 		return models.Booking{}, err
 	}
 
-	if err := db.WithContext(ctx).
-		Preload("Customer.Membership").
-		Preload("Customer").
-		Preload("Car").
-		Preload("Driver").
-		Preload("BookingType").
+	if err := withBookingRelations(db.WithContext(ctx)).
 		First(&bookings, bookings.ID).Error; err != nil {
 		return models.Booking{}, err
 	}
 
 	return bookings, nil
 }
+
 func (u *bookingsQueryImpl) EditBookings(ctx context.Context, id uint64, booking models.Booking) (models.Booking, error) {
 	db := u.db.GetConnection()
 
@@ -123,12 +185,7 @@ func (u *bookingsQueryImpl) EditBookings(ctx context.Context, id uint64, booking
 	}
 
 	updatedBooking := models.Booking{}
-	if err := db.WithContext(ctx).
-		Preload("Customer.Membership").
-		Preload("Customer").
-		Preload("Car").
-		Preload("Driver").
-		Preload("BookingType").
+	if err := withBookingRelations(db.WithContext(ctx)).
 		First(&updatedBooking, id).Error; err != nil {
 		return models.Booking{}, err
 	}
@@ -136,3 +193,544 @@ func (u *bookingsQueryImpl) EditBookings(ctx context.Context, id uint64, booking
 	return updatedBooking, nil
 }
 
+// RepriceBookings stores the terms and costs of an edited booking, replaces
+// its extras and brings its driver incentive in line with incentive, in one
+// transaction, once holdUnit and holdExtras made sure its car and extras are
+// still free and holdCredit that its company can afford it. Unlike
+// EditBookings it also writes zero and null values, so a dropped driver,
+// discount, one-way fee or insurance plan is cleared.
+func (u *bookingsQueryImpl) RepriceBookings(ctx context.Context, id uint64, booking models.Booking, incentive *models.DriverIncentive) (models.Booking, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := holdUnit(tx, booking, id); err != nil {
+			return err
+		}
+		if err := holdExtras(tx, booking, id); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Booking{}).
+			Where("id = ?", id).
+			Select("customer_id", "car_id", "start_rent", "end_rent", "driver_id", "book_type_id",
+				"total_cost", "total_driver_cost", "finished", "discount", "deposit",
+				"pickup_branch_id", "return_branch_id", "one_way_fee", "extras_cost",
+				"insurance_plan_id", "insurance_premium", "insurance_excess", "group_discount",
+				"company_id", "payment_due_at", "updated_at").
+			Updates(&booking).Error; err != nil {
+			return err
+		}
+		if err := holdCredit(tx, booking.CompanyID); err != nil {
+			return err
+		}
+		if err := replaceIncentive(tx, uint(id), incentive, booking.UpdatedAt); err != nil {
+			return err
+		}
+		if err := tx.Where("booking_id = ?", id).Delete(&models.BookingExtra{}).Error; err != nil {
+			return err
+		}
+		if len(booking.Extras) == 0 {
+			return nil
+		}
+		for i := range booking.Extras {
+			booking.Extras[i].BookingID = uint(id)
+		}
+		return tx.Create(&booking.Extras).Error
+	})
+	if err != nil {
+		return models.Booking{}, err
+	}
+	return u.GetBookingsByID(ctx, id)
+}
+
+// RestoreBookingsByID brings back a booking and the driver incentives
+// deleted along with it, in one transaction.
+func (u *bookingsQueryImpl) RestoreBookingsByID(ctx context.Context, id uint64) (models.Booking, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		deletedWithBooking := tx.Unscoped().
+			Model(&models.Booking{}).
+			Select("deleted_at").
+			Where("id = ?", id)
+		if err := tx.Unscoped().
+			Model(&models.DriverIncentive{}).
+			Where("booking_id = ? AND deleted_at = (?)", id, deletedWithBooking).
+			UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().
+			Model(&models.Booking{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error
+	})
+	if err != nil {
+		return models.Booking{}, err
+	}
+	return u.GetBookingsByID(ctx, id)
+}
+
+func (u *bookingsQueryImpl) GetOpenBookingIDsByCustomerID(ctx context.Context, customerID uint64) ([]uint, error) {
+	return u.getBookingIDsWhere(ctx, "customer_id", customerID, true)
+}
+
+func (u *bookingsQueryImpl) GetOpenBookingIDsByCarID(ctx context.Context, carID uint64) ([]uint, error) {
+	return u.getBookingIDsWhere(ctx, "car_id", carID, true)
+}
+
+func (u *bookingsQueryImpl) GetOpenBookingIDsByDriverID(ctx context.Context, driverID uint64) ([]uint, error) {
+	return u.getBookingIDsWhere(ctx, "driver_id", driverID, true)
+}
+
+func (u *bookingsQueryImpl) GetBookingIDsByBookTypeID(ctx context.Context, bookTypeID uint64) ([]uint, error) {
+	return u.getBookingIDsWhere(ctx, "book_type_id", bookTypeID, false)
+}
+
+func (u *bookingsQueryImpl) GetOpenBookingIDsByVehicleID(ctx context.Context, vehicleID uint64) ([]uint, error) {
+	return u.getBookingIDsWhere(ctx, "vehicle_id", vehicleID, true)
+}
+
+func (u *bookingsQueryImpl) GetOpenBookingIDsByInsurancePlanID(ctx context.Context, planID uint64) ([]uint, error) {
+	return u.getBookingIDsWhere(ctx, "insurance_plan_id", planID, true)
+}
+
+// GetOpenBookingIDsByBranchID lists the unfinished bookings picked up from or
+// returned to a branch.
+func (u *bookingsQueryImpl) GetOpenBookingIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.WithContext(ctx).
+		Model(&models.Booking{}).
+		Where("(pickup_branch_id = ? OR return_branch_id = ?) AND finished = ?", branchID, branchID, false).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetOpenBookingIDsByExtraID lists the unfinished bookings that include an
+// extra.
+func (u *bookingsQueryImpl) GetOpenBookingIDsByExtraID(ctx context.Context, extraID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	withExtra := db.Model(&models.BookingExtra{}).
+		Select("booking_id").
+		Where("extra_id = ?", extraID)
+	ids := []uint{}
+	if err := db.WithContext(ctx).
+		Model(&models.Booking{}).
+		Where("id IN (?) AND finished = ?", withExtra, false).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CountOverlappingBookingsByCarID counts the unfinished bookings of a car
+// picked up at a branch that need a unit at some point between start and end.
+func (u *bookingsQueryImpl) CountOverlappingBookingsByCarID(ctx context.Context, carID uint64, branchID uint64, start, end time.Time, excludeID uint64) (int64, error) {
+	db := u.db.GetConnection()
+	var count int64
+	if err := bookingsHoldingUnits(db.WithContext(ctx).Model(&models.Booking{}), start, end).
+		Where("car_id = ? AND pickup_branch_id = ? AND id <> ?", carID, branchID, excludeID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// PickUpBookings hands a booking a vehicle of its car at the pickup branch,
+// vehicleID or else the free one with the lowest odometer, and stores the
+// pickup readings, in one transaction. The car stays locked while its free
+// vehicles are looked up, so concurrent pickups cannot take the same one,
+// and only a booking not picked up yet is updated; ErrPickedUp is returned
+// otherwise. The pickup odometer is the vehicle's.
+func (u *bookingsQueryImpl) PickUpBookings(ctx context.Context, id uint64, vehicleID *uint, pickedUp models.Booking) (models.Booking, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		booking := models.Booking{}
+		if err := tx.First(&booking, id).Error; err != nil {
+			return err
+		}
+		if err := lockCars(tx, []uint{booking.CarID}); err != nil {
+			return err
+		}
+
+		query := freeVehicles(tx, uint64(booking.CarID), uint64(booking.PickupBranchID), booking.EndRent)
+		if vehicleID != nil {
+			query = query.Where("vehicles.id = ?", *vehicleID)
+		}
+		free := []models.Vehicle{}
+		if err := query.Limit(1).Find(&free).Error; err != nil {
+			return err
+		}
+		switch {
+		case len(free) == 0 && vehicleID != nil:
+			return ErrVehicleNotFree
+		case len(free) == 0:
+			return ErrNoUnitLeft
+		}
+
+		pickedUp.VehicleID = &free[0].ID
+		pickedUp.PickupOdometer = &free[0].Odometer
+		result := tx.Model(&models.Booking{}).
+			Where("id = ? AND picked_up_at IS NULL", id).
+			Updates(&pickedUp)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPickedUp
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Booking{}, err
+	}
+	return u.GetBookingsByID(ctx, id)
+}
+
+// ReturnBookings finishes a booking with the readings and charges of its
+// return, and records the vehicle's new odometer reading, in one
+// transaction. The vehicle now belongs to the branch it was returned to.
+func (u *bookingsQueryImpl) ReturnBookings(ctx context.Context, id uint64, returned models.Booking) (models.Booking, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		booking := models.Booking{}
+		if err := tx.First(&booking, id).Error; err != nil {
+			return err
+		}
+		columns := map[string]any{
+			"finished":          true,
+			"returned_at":       returned.ReturnedAt,
+			"return_odometer":   returned.ReturnOdometer,
+			"return_fuel_level": returned.ReturnFuelLevel,
+			"fuel_policy":       returned.FuelPolicy,
+			"fuel_charge":       returned.FuelCharge,
+			"km_allowance":      returned.KmAllowance,
+			"excess_km":         returned.ExcessKm,
+			"mileage_charge":    returned.MileageCharge,
+			"updated_at":        returned.ReturnedAt,
+		}
+		if returned.PaymentDueAt != nil {
+			columns["payment_due_at"] = returned.PaymentDueAt
+		}
+		if err := tx.Model(&booking).Updates(columns).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Vehicle{}).
+			Where("id = ?", booking.VehicleID).
+			Updates(map[string]any{
+				"odometer":   returned.ReturnOdometer,
+				"branch_id":  booking.ReturnBranchID,
+				"updated_at": returned.ReturnedAt,
+			}).Error
+	})
+	if err != nil {
+		return models.Booking{}, err
+	}
+	return u.GetBookingsByID(ctx, id)
+}
+
+// SetBookingDamageCharge stores what the customer owes for damage found at
+// return, replacing any earlier charge.
+func (u *bookingsQueryImpl) SetBookingDamageCharge(ctx context.Context, id uint64, charge int) (models.Booking, error) {
+	db := u.db.GetConnection()
+	if err := db.WithContext(ctx).
+		Model(&models.Booking{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"damage_charge": charge, "updated_at": time.Now()}).Error; err != nil {
+		return models.Booking{}, err
+	}
+	return u.GetBookingsByID(ctx, id)
+}
+
+// bookingChargesSQL adds up everything a booking is charged, return charges
+// included, the way the booking service does.
+const bookingChargesSQL = "bookings.total_cost - COALESCE(bookings.discount, 0) - bookings.group_discount + COALESCE(bookings.total_driver_cost, 0) + " +
+	"bookings.one_way_fee + bookings.extras_cost + bookings.insurance_premium + " +
+	"bookings.damage_charge + bookings.fuel_charge + bookings.mileage_charge"
+
+// unpaidCompanyBookings narrows query to the bookings billed to a company that
+// were neither paid nor cancelled.
+func unpaidCompanyBookings(query *gorm.DB, companyID uint64) *gorm.DB {
+	return query.Where("bookings.company_id = ? AND bookings.paid_at IS NULL AND bookings.cancelled_at IS NULL", companyID)
+}
+
+func (u *bookingsQueryImpl) GetUnpaidBookingIDsByCompanyID(ctx context.Context, companyID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := unpaidCompanyBookings(db.WithContext(ctx).Model(&models.Booking{}), companyID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SumUnpaidByCompanyID adds up what a company owes for its bookings, leaving
+// out the booking being edited.
+func (u *bookingsQueryImpl) SumUnpaidByCompanyID(ctx context.Context, companyID uint64, excludeID uint64) (int64, error) {
+	db := u.db.GetConnection()
+	var sum int64
+	if err := unpaidCompanyBookings(db.WithContext(ctx).Model(&models.Booking{}), companyID).
+		Where("bookings.id <> ?", excludeID).
+		Select("COALESCE(SUM(" + bookingChargesSQL + "), 0)").
+		Scan(&sum).Error; err != nil {
+		return 0, err
+	}
+	return sum, nil
+}
+
+// GetBookingsByCompanyID lists the bookings billed to a company that start
+// between start and end, cancelled ones left out.
+func (u *bookingsQueryImpl) GetBookingsByCompanyID(ctx context.Context, companyID uint64, start, end time.Time) ([]models.Booking, error) {
+	db := u.db.GetConnection()
+	bookings := []models.Booking{}
+	if err := withBookingRelations(db.WithContext(ctx)).
+		Where("company_id = ? AND cancelled_at IS NULL AND start_rent >= ? AND start_rent < ?", companyID, start, end).
+		Order("start_rent, id").
+		Find(&bookings).Error; err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// unpaidDriverBookings narrows query to the finished, not cancelled bookings
+// whose driver was not paid yet.
+func unpaidDriverBookings(query *gorm.DB) *gorm.DB {
+	return query.Where("bookings.driver_id IS NOT NULL AND bookings.finished = ? AND bookings.cancelled_at IS NULL AND bookings.driver_payout_period_id IS NULL", true)
+}
+
+// GetUnpaidDriverBookings lists the bookings that still owe their driver the
+// daily cost, only those ending on or before end when end is not zero.
+func (u *bookingsQueryImpl) GetUnpaidDriverBookings(ctx context.Context, end time.Time) ([]models.Booking, error) {
+	db := u.db.GetConnection()
+	query := unpaidDriverBookings(db.WithContext(ctx).Preload("Driver", unscoped))
+	if !end.IsZero() {
+		query = query.Where("bookings.end_rent <= ?", end)
+	}
+	bookings := []models.Booking{}
+	if err := query.
+		Order("end_rent, id").
+		Find(&bookings).Error; err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// GetBookingsByDriverPayoutPeriodID lists the bookings whose driver was paid
+// in a payout period.
+func (u *bookingsQueryImpl) GetBookingsByDriverPayoutPeriodID(ctx context.Context, periodID uint64) ([]models.Booking, error) {
+	db := u.db.GetConnection()
+	bookings := []models.Booking{}
+	if err := db.WithContext(ctx).
+		Preload("Driver", unscoped).
+		Where("driver_payout_period_id = ?", periodID).
+		Order("end_rent, id").
+		Find(&bookings).Error; err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// bookingsHoldingUnits narrows query to the unfinished bookings that need a
+// unit at some point between start and end. A booking whose car was picked up
+// and not returned holds its unit whatever its dates say.
+func bookingsHoldingUnits(query *gorm.DB, start, end time.Time) *gorm.DB {
+	return query.Where("bookings.finished = ? AND ((bookings.start_rent <= ? AND bookings.end_rent >= ?) OR (bookings.picked_up_at IS NOT NULL AND bookings.returned_at IS NULL))",
+		false, end, start)
+}
+
+// holdUnit locks the car of an unfinished booking and returns ErrNoUnitLeft
+// unless one of its serviceable vehicles at the pickup branch is still free
+// over the rent once the other bookings, but excludeID, are served. The lock
+// lasts until tx ends, so concurrent bookings of the car wait for each other
+// instead of both taking its last unit.
+func holdUnit(tx *gorm.DB, booking models.Booking, excludeID uint64) error {
+	if booking.Finished {
+		return nil
+	}
+	if err := lockCars(tx, []uint{booking.CarID}); err != nil {
+		return err
+	}
+
+	var units int64
+	if err := serviceableVehicles(tx, uint64(booking.CarID), uint64(booking.PickupBranchID), booking.StartRent, booking.EndRent).
+		Count(&units).Error; err != nil {
+		return err
+	}
+	var booked int64
+	if err := bookingsHoldingUnits(tx.Model(&models.Booking{}), booking.StartRent, booking.EndRent).
+		Where("car_id = ? AND pickup_branch_id = ? AND id <> ?", booking.CarID, booking.PickupBranchID, excludeID).
+		Count(&booked).Error; err != nil {
+		return err
+	}
+	if booked >= units {
+		return ErrNoUnitLeft
+	}
+	return nil
+}
+
+// holdExtras locks the extras booking asks for and makes sure enough of each
+// is left over the rent once the other bookings, but excludeID, have theirs.
+// Like holdUnit's, the locks last until tx ends.
+func holdExtras(tx *gorm.DB, booking models.Booking, excludeID uint64) error {
+	if booking.Finished || len(booking.Extras) == 0 {
+		return nil
+	}
+	wanted := map[uint]int64{}
+	extraIDs := []uint{}
+	for _, item := range booking.Extras {
+		if _, ok := wanted[item.ExtraID]; !ok {
+			extraIDs = append(extraIDs, item.ExtraID)
+		}
+		wanted[item.ExtraID] += int64(item.Quantity)
+	}
+	extras, err := lockExtras(tx, extraIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, extra := range extras {
+		var inUse int64
+		if err := extrasInUse(tx, uint64(extra.ID), booking.StartRent, booking.EndRent, excludeID).
+			Scan(&inUse).Error; err != nil {
+			return err
+		}
+		if inUse+wanted[extra.ID] > int64(extra.Stock) {
+			return ErrNoExtraLeft
+		}
+	}
+	return nil
+}
+
+// lockExtras locks the rows of extraIDs until tx ends, in the order of their
+// IDs, and returns them.
+func lockExtras(tx *gorm.DB, extraIDs []uint) ([]models.Extra, error) {
+	extras := []models.Extra{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", extraIDs).
+		Order("id").
+		Find(&extras).Error; err != nil {
+		return nil, err
+	}
+	return extras, nil
+}
+
+// holdCredit locks the company a booking just stored in tx is billed to, if
+// any, and makes sure what the company owes, that booking included, stays
+// within its credit limit. The lock lasts until tx ends, so bookings billed
+// to the company are stored one after the other.
+func holdCredit(tx *gorm.DB, companyID *uint) error {
+	if companyID == nil {
+		return nil
+	}
+	company := models.Company{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&company, *companyID).Error; err != nil {
+		return err
+	}
+	var owed int64
+	if err := unpaidCompanyBookings(tx.Model(&models.Booking{}), uint64(company.ID)).
+		Select("COALESCE(SUM(" + bookingChargesSQL + "), 0)").
+		Scan(&owed).Error; err != nil {
+		return err
+	}
+	if owed > int64(company.CreditLimit) {
+		return ErrCreditExceeded
+	}
+	return nil
+}
+
+// lockCars locks the rows of carIDs until tx ends, in the order of their IDs
+// so transactions locking several cars cannot deadlock each other.
+func lockCars(tx *gorm.DB, carIDs []uint) error {
+	locked := []uint{}
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Model(&models.Car{}).
+		Where("id IN ?", carIDs).
+		Order("id").
+		Pluck("id", &locked).Error
+}
+
+// createIncentive stores the driver incentive of bookingID, when there is
+// one.
+func createIncentive(tx *gorm.DB, bookingID uint, incentive *models.DriverIncentive) error {
+	if incentive == nil {
+		return nil
+	}
+	incentive.BookingID = &bookingID
+	return tx.Omit("Booking").Create(incentive).Error
+}
+
+// replaceIncentive brings the unpaid driver incentive of bookingID in line
+// with incentive: it is recomputed, created when there was none, or deleted
+// when incentive is nil because the booking has no driver any more.
+func replaceIncentive(tx *gorm.DB, bookingID uint, incentive *models.DriverIncentive, updatedAt time.Time) error {
+	if incentive == nil {
+		return tx.Where("booking_id = ? AND payout_period_id IS NULL", bookingID).
+			Delete(&models.DriverIncentive{}).Error
+	}
+	result := tx.Model(&models.DriverIncentive{}).
+		Where("booking_id = ? AND payout_period_id IS NULL", bookingID).
+		Updates(map[string]any{"incentive": incentive.Incentive, "rule_version": incentive.RuleVersion, "updated_at": updatedAt})
+	if result.Error != nil || result.RowsAffected > 0 {
+		return result.Error
+	}
+	return createIncentive(tx, bookingID, incentive)
+}
+
+// getBookingIDsWhere lists the bookings pointing at a record through the
+// given foreign key column, optionally only those not finished yet. column is
+// always a constant from this file.
+func (u *bookingsQueryImpl) getBookingIDsWhere(ctx context.Context, column string, id uint64, openOnly bool) ([]uint, error) {
+	db := u.db.GetConnection()
+	query := db.WithContext(ctx).
+		Model(&models.Booking{}).
+		Where(column+" = ?", id)
+	if openOnly {
+		query = query.Where("finished = ?", false)
+	}
+	ids := []uint{}
+	if err := query.
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CountLateReturnsByCustomerID counts the bookings a customer brought back
+// after the last day of the rent, only those returned after since when it
+// is given.
+func (u *bookingsQueryImpl) CountLateReturnsByCustomerID(ctx context.Context, customerID uint64, since *time.Time) (int64, error) {
+	db := u.db.GetConnection()
+	query := db.
+		WithContext(ctx).
+		Model(&models.Booking{}).
+		Where("customer_id = ? AND cancelled_at IS NULL AND returned_at >= end_rent + INTERVAL '1 day'", customerID)
+	if since != nil {
+		query = query.Where("returned_at > ?", *since)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountOverdueBookingsByCustomerID counts the bookings a customer pays for
+// themselves that still have a balance past their payment due date. Bookings
+// billed to a company are the company's to pay and left out.
+func (u *bookingsQueryImpl) CountOverdueBookingsByCustomerID(ctx context.Context, customerID uint64, now time.Time) (int64, error) {
+	db := u.db.GetConnection()
+	var count int64
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Booking{}).
+		Where("customer_id = ? AND company_id IS NULL AND cancelled_at IS NULL AND paid_at IS NULL AND payment_due_at < ?", customerID, now).
+		Where(bookingChargesSQL + " > bookings.deposit").
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BranchesQuery interface {
+	GetBranches(ctx context.Context, includeDeleted bool) ([]models.Branch, error)
+	GetBranchesByID(ctx context.Context, id uint64) (models.Branch, error)
+	EditBranches(ctx context.Context, id uint64, branch models.Branch) (models.Branch, error)
+	DeleteBranchesByID(ctx context.Context, id uint64) error
+	CreateBranches(ctx context.Context, branch models.Branch) (models.Branch, error)
+	RestoreBranchesByID(ctx context.Context, id uint64) (models.Branch, error)
+}
+
+type branchesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewBranchesQuery(db infrastructure.GormPostgres) BranchesQuery {
+	return &branchesQueryImpl{db: db}
+}
+
+func (u *branchesQueryImpl) GetBranches(ctx context.Context, includeDeleted bool) ([]models.Branch, error) {
+	db := u.db.GetConnection()
+	branches := []models.Branch{}
+	if err := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Order("id").
+		Find(&branches).Error; err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
+
+func (u *branchesQueryImpl) GetBranchesByID(ctx context.Context, id uint64) (models.Branch, error) {
+	db := u.db.GetConnection()
+	branch := models.Branch{}
+	if err := db.
+		WithContext(ctx).
+		First(&branch, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.Branch{}, nil
+		}
+		return models.Branch{}, err
+	}
+	return branch, nil
+}
+
+func (u *branchesQueryImpl) DeleteBranchesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.Branch{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *branchesQueryImpl) CreateBranches(ctx context.Context, branch models.Branch) (models.Branch, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&branch).Error; err != nil {
+		return models.Branch{}, err
+	}
+	return branch, nil
+}
+
+// EditBranches also writes empty and zero values, so the address can be
+// cleared and the one-way fee waived.
+func (u *branchesQueryImpl) EditBranches(ctx context.Context, id uint64, branch models.Branch) (models.Branch, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Branch{}).
+		Where("id = ?", id).
+		Select("name", "city", "address", "phone", "one_way_fee", "updated_at").
+		Updates(&branch).Error; err != nil {
+		return models.Branch{}, err
+	}
+	return u.GetBranchesByID(ctx, id)
+}
+
+func (u *branchesQueryImpl) RestoreBranchesByID(ctx context.Context, id uint64) (models.Branch, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.Branch{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Branch{}, err
+	}
+	return u.GetBranchesByID(ctx, id)
+}
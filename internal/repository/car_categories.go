@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CarCategoriesQuery interface {
+	GetCarCategories(ctx context.Context, includeDeleted bool) ([]models.CarCategory, error)
+	GetCarCategoriesByID(ctx context.Context, id uint64) (models.CarCategory, error)
+	EditCarCategories(ctx context.Context, id uint64, category models.CarCategory) (models.CarCategory, error)
+	DeleteCarCategoriesByID(ctx context.Context, id uint64) error
+	CreateCarCategories(ctx context.Context, category models.CarCategory) (models.CarCategory, error)
+	RestoreCarCategoriesByID(ctx context.Context, id uint64) (models.CarCategory, error)
+	GetCarCategoryIDsByInsurancePlanID(ctx context.Context, planID uint64) ([]uint, error)
+}
+
+type carCategoriesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewCarCategoriesQuery(db infrastructure.GormPostgres) CarCategoriesQuery {
+	return &carCategoriesQueryImpl{db: db}
+}
+
+func (u *carCategoriesQueryImpl) GetCarCategories(ctx context.Context, includeDeleted bool) ([]models.CarCategory, error) {
+	db := u.db.GetConnection()
+	categories := []models.CarCategory{}
+	if err := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Preload("InsurancePlan", unscoped).
+		Order("id").
+		Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (u *carCategoriesQueryImpl) GetCarCategoriesByID(ctx context.Context, id uint64) (models.CarCategory, error) {
+	db := u.db.GetConnection()
+	category := models.CarCategory{}
+	if err := db.
+		WithContext(ctx).
+		Preload("InsurancePlan", unscoped).
+		First(&category, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.CarCategory{}, nil
+		}
+		return models.CarCategory{}, err
+	}
+	return category, nil
+}
+
+func (u *carCategoriesQueryImpl) DeleteCarCategoriesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.CarCategory{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *carCategoriesQueryImpl) CreateCarCategories(ctx context.Context, category models.CarCategory) (models.CarCategory, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&category).Error; err != nil {
+		return models.CarCategory{}, err
+	}
+	return category, nil
+}
+
+// EditCarCategories also writes empty and zero values, so the description
+// can be cleared, a rate or allowance dropped and the insurance plan made
+// optional again.
+func (u *carCategoriesQueryImpl) EditCarCategories(ctx context.Context, id uint64, category models.CarCategory) (models.CarCategory, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.CarCategory{}).
+		Where("id = ?", id).
+		Select("name", "description", "fuel_policy", "refuel_rate", "prepaid_fuel_price",
+			"daily_km_allowance", "excess_km_rate", "insurance_plan_id", "updated_at").
+		Updates(&category).Error; err != nil {
+		return models.CarCategory{}, err
+	}
+	return u.GetCarCategoriesByID(ctx, id)
+}
+
+func (u *carCategoriesQueryImpl) RestoreCarCategoriesByID(ctx context.Context, id uint64) (models.CarCategory, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.CarCategory{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.CarCategory{}, err
+	}
+	return u.GetCarCategoriesByID(ctx, id)
+}
+
+// GetCarCategoryIDsByInsurancePlanID lists the categories whose cars are
+// insured with a plan by default.
+func (u *carCategoriesQueryImpl) GetCarCategoryIDsByInsurancePlanID(ctx context.Context, planID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.CarCategory{}).
+		Where("insurance_plan_id = ?", planID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -10,11 +10,14 @@ import (
 )
 
 type CarsQuery interface {
-	GetCars(ctx context.Context) ([]models.Car, error)
+	GetCars(ctx context.Context, filter models.CarFilter) ([]models.Car, error)
 	GetCarsByID(ctx context.Context, id uint64) (models.Car, error)
 	EditCars(ctx context.Context, id uint64, cars models.Car) (models.Car, error)
 	DeleteCarsByID(ctx context.Context, id uint64) error
 	CreateCars(ctx context.Context, cars models.Car) (models.Car, error)
+	RestoreCarsByID(ctx context.Context, id uint64) (models.Car, error)
+	SetCarStock(ctx context.Context, id uint64, stock int64) error
+	GetCarIDsByCategoryID(ctx context.Context, categoryID uint64) ([]uint, error)
 }
 
 type CarsCommand interface {
@@ -29,12 +32,75 @@ func NewCarsQuery(db infrastructure.GormPostgres) CarsQuery {
 	return &carsQueryImpl{db: db}
 }
 
-func (u *carsQueryImpl) GetCars(ctx context.Context) ([]models.Car, error) {
+// carSorts maps the sort options of CarFilter to their ORDER BY clause.
+var carSorts = map[string]string{
+	"name":        "name ASC",
+	"-name":       "name DESC",
+	"daily_rent":  "daily_rent ASC",
+	"-daily_rent": "daily_rent DESC",
+	"seats":       "seats ASC",
+	"-seats":      "seats DESC",
+	"year":        "year ASC",
+	"-year":       "year DESC",
+}
+
+func (u *carsQueryImpl) GetCars(ctx context.Context, filter models.CarFilter) ([]models.Car, error) {
 	db := u.db.GetConnection()
-	cars := []models.Car{}
-	if err := db.
+	query := withDeleted(db, filter.IncludeDeleted).
 		WithContext(ctx).
 		Table("cars").
+		Preload("Category", unscoped)
+	if filter.CategoryID != 0 {
+		query = query.Where("category_id = ?", filter.CategoryID)
+	}
+	if filter.Brand != "" {
+		query = query.Where("brand ILIKE ?", filter.Brand)
+	}
+	if filter.Transmission != "" {
+		query = query.Where("transmission = ?", filter.Transmission)
+	}
+	if filter.FuelType != "" {
+		query = query.Where("fuel_type = ?", filter.FuelType)
+	}
+	if filter.MinSeats > 0 {
+		query = query.Where("seats >= ?", filter.MinSeats)
+	}
+	if filter.MinLuggage > 0 {
+		query = query.Where("luggage >= ?", filter.MinLuggage)
+	}
+	if filter.MinYear > 0 {
+		query = query.Where("year >= ?", filter.MinYear)
+	}
+	if filter.MinPrice > 0 {
+		query = query.Where("daily_rent >= ?", filter.MinPrice)
+	}
+	if filter.MaxPrice > 0 {
+		query = query.Where("daily_rent <= ?", filter.MaxPrice)
+	}
+	if startRent, endRent, ok := filter.Period(); ok {
+		// More serviceable units than bookings holding one over the period.
+		units := activeVehicles(db, 0, uint64(filter.BranchID)).
+			Select("COUNT(*)").
+			Where("vehicles.car_id = cars.id").
+			Where("vehicles.id NOT IN (?)", vehiclesInMaintenance(db, startRent, endRent)).
+			Where("vehicles.id NOT IN (?)", vehiclesInTransit(db))
+		held := bookingsHoldingUnits(db.Model(&models.Booking{}), startRent, endRent).
+			Select("COUNT(*)").
+			Where("bookings.car_id = cars.id")
+		if filter.BranchID != 0 {
+			held = held.Where("bookings.pickup_branch_id = ?", filter.BranchID)
+		}
+		query = query.Where("(?) > (?)", units, held)
+	}
+	order, ok := carSorts[filter.Sort]
+	if !ok {
+		order = "id ASC"
+	}
+
+	cars := []models.Car{}
+	if err := query.
+		Order(order).
+		Order("id").
 		Find(&cars).Error; err != nil {
 		return nil, err
 	}
@@ -47,6 +113,7 @@ func (u *carsQueryImpl) GetCarsByID(ctx context.Context, id uint64) (models.Car,
 	if err := db.
 		WithContext(ctx).
 		Table("cars").
+		Preload("Category", unscoped).
 		Where("id = ?", id).
 		Find(&cars).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -83,14 +150,52 @@ func (u *carsQueryImpl) CreateCars(ctx context.Context, cars models.Car) (models
 }
 func (u *carsQueryImpl) EditCars(ctx context.Context, id uint64, car models.Car) (models.Car, error) {
 	db := u.db.GetConnection()
-	updatedCars := models.Car{}
 	if err := db.
 		WithContext(ctx).
+		Model(&models.Car{}).
+		Where("id = ?", id).
+		Select("name", "daily_rent", "category_id", "brand", "transmission", "fuel_type", "seats", "year",
+			"luggage", "updated_at").
+		Updates(&car).Error; err != nil {
+		return models.Car{}, err
+	}
+	return u.GetCarsByID(ctx, id)
+}
+
+func (u *carsQueryImpl) RestoreCarsByID(ctx context.Context, id uint64) (models.Car, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
 		Table("cars").
-		Where("id = ?", id).Updates(&car).First(&updatedCars).Error; err != nil {
-			if err == gorm.ErrRecordNotFound {
-				return models.Car{}, nil
-			}
-		}
-	return updatedCars, nil
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Car{}, err
+	}
+	return u.GetCarsByID(ctx, id)
+}
+
+// SetCarStock stores the number of active vehicles of a car.
+func (u *carsQueryImpl) SetCarStock(ctx context.Context, id uint64, stock int64) error {
+	db := u.db.GetConnection()
+	return db.
+		WithContext(ctx).
+		Unscoped().
+		Table("cars").
+		Where("id = ?", id).
+		Update("stock", stock).Error
+}
+
+func (u *carsQueryImpl) GetCarIDsByCategoryID(ctx context.Context, categoryID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Car{}).
+		Where("category_id = ?", categoryID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
 }
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CompaniesQuery interface {
+	GetCompanies(ctx context.Context, includeDeleted bool) ([]models.Company, error)
+	GetCompaniesByID(ctx context.Context, id uint64) (models.Company, error)
+	GetCompaniesByNPWP(ctx context.Context, npwp string) (models.Company, error)
+	EditCompanies(ctx context.Context, id uint64, company models.Company) (models.Company, error)
+	DeleteCompaniesByID(ctx context.Context, id uint64) error
+	CreateCompanies(ctx context.Context, company models.Company) (models.Company, error)
+	RestoreCompaniesByID(ctx context.Context, id uint64) (models.Company, error)
+}
+
+type companiesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewCompaniesQuery(db infrastructure.GormPostgres) CompaniesQuery {
+	return &companiesQueryImpl{db: db}
+}
+
+func withCompanyRates(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Rates", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Rates.Car", unscoped)
+}
+
+func (u *companiesQueryImpl) GetCompanies(ctx context.Context, includeDeleted bool) ([]models.Company, error) {
+	db := u.db.GetConnection()
+	companies := []models.Company{}
+	if err := withCompanyRates(withDeleted(db, includeDeleted).WithContext(ctx)).
+		Order("id").
+		Find(&companies).Error; err != nil {
+		return nil, err
+	}
+	return companies, nil
+}
+
+func (u *companiesQueryImpl) GetCompaniesByID(ctx context.Context, id uint64) (models.Company, error) {
+	db := u.db.GetConnection()
+	company := models.Company{}
+	if err := withCompanyRates(db.WithContext(ctx)).
+		First(&company, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.Company{}, nil
+		}
+		return models.Company{}, err
+	}
+	return company, nil
+}
+
+// GetCompaniesByNPWP also finds soft-deleted companies, as their NPWP stays
+// taken.
+func (u *companiesQueryImpl) GetCompaniesByNPWP(ctx context.Context, npwp string) (models.Company, error) {
+	db := u.db.GetConnection()
+	company := models.Company{}
+	if err := withDeleted(db, true).
+		WithContext(ctx).
+		Where("npwp = ?", npwp).
+		Limit(1).
+		Find(&company).Error; err != nil {
+		return models.Company{}, err
+	}
+	return company, nil
+}
+
+func (u *companiesQueryImpl) DeleteCompaniesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.Company{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateCompanies saves a company together with its rate card.
+func (u *companiesQueryImpl) CreateCompanies(ctx context.Context, company models.Company) (models.Company, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Create(&company).Error; err != nil {
+		return models.Company{}, err
+	}
+	return u.GetCompaniesByID(ctx, uint64(company.ID))
+}
+
+// EditCompanies stores the terms of a company and replaces its rate card, in
+// one transaction. Empty and zero values are written too, so the address can
+// be cleared.
+func (u *companiesQueryImpl) EditCompanies(ctx context.Context, id uint64, company models.Company) (models.Company, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Company{}).
+			Where("id = ?", id).
+			Select("name", "npwp", "address", "billing_contact", "billing_email", "billing_phone",
+				"credit_limit", "payment_terms_days", "updated_at").
+			Updates(&company)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		if err := tx.Where("company_id = ?", id).Delete(&models.CompanyRate{}).Error; err != nil {
+			return err
+		}
+		if len(company.Rates) == 0 {
+			return nil
+		}
+		for i := range company.Rates {
+			company.Rates[i].CompanyID = uint(id)
+		}
+		return tx.Create(&company.Rates).Error
+	})
+	if err != nil {
+		return models.Company{}, err
+	}
+	return u.GetCompaniesByID(ctx, id)
+}
+
+func (u *companiesQueryImpl) RestoreCompaniesByID(ctx context.Context, id uint64) (models.Company, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.Company{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Company{}, err
+	}
+	return u.GetCompaniesByID(ctx, id)
+}
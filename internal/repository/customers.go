@@ -10,12 +10,17 @@ import (
 )
 
 type CustomersQuery interface {
-	GetCustomers(ctx context.Context) ([]models.Customer, error)
+	GetCustomers(ctx context.Context, includeDeleted bool) ([]models.Customer, error)
 	GetCustomersByID(ctx context.Context, id uint64) (models.Customer, error)
 	EditCustomers(ctx context.Context, id uint64, customers models.Customer) (models.Customer, error)
 	DeleteCustomersByID(ctx context.Context, id uint64) error
 	CreateCustomers(ctx context.Context, customers models.Customer) (models.Customer, error)
 	DeleteMembershipByCustomer(ctx context.Context, id uint64, customer models.Customer) (models.Customer, error)
+	RestoreCustomersByID(ctx context.Context, id uint64) (models.Customer, error)
+	GetCustomerIDsByMembershipID(ctx context.Context, membershipID uint64) ([]uint, error)
+	GetCustomersByIDWithDeleted(ctx context.Context, id uint64) (models.Customer, error)
+	GetCustomerByPhone(ctx context.Context, phone string) (models.Customer, error)
+	EditCustomerRisk(ctx context.Context, id uint64, customer models.Customer) (models.Customer, error)
 }
 
 type CustomersCommand interface {
@@ -30,12 +35,12 @@ func NewCustomersQuery(db infrastructure.GormPostgres) CustomersQuery {
 	return &customersQueryImpl{db: db}
 }
 
-func (u *customersQueryImpl) GetCustomers(ctx context.Context) ([]models.Customer, error) {
+func (u *customersQueryImpl) GetCustomers(ctx context.Context, includeDeleted bool) ([]models.Customer, error) {
 	db := u.db.GetConnection()
 	customers := []models.Customer{}
 
-	if err := db.WithContext(ctx).
-		Preload("Membership").     
+	if err := withDeleted(db, includeDeleted).WithContext(ctx).
+		Preload("Membership", unscoped).
 		Find(&customers).Error; err != nil {
 		return nil, err
 	}
@@ -48,7 +53,7 @@ func (u *customersQueryImpl) GetCustomersByID(ctx context.Context, id uint64) (m
 	customers := models.Customer{}
 
 	if err := db.WithContext(ctx).
-		Preload("Membership").    
+		Preload("Membership", unscoped).
 		First(&customers, id).Error; err != nil { 
 		if err == gorm.ErrRecordNotFound {
 			return models.Customer{}, nil
@@ -119,3 +124,73 @@ func (u *customersQueryImpl) DeleteMembershipByCustomer(ctx context.Context, id
 
 	return customer, nil
 }
+
+func (u *customersQueryImpl) RestoreCustomersByID(ctx context.Context, id uint64) (models.Customer, error) {
+	db := u.db.GetConnection()
+	if err := db.WithContext(ctx).
+		Unscoped().
+		Model(&models.Customer{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Customer{}, err
+	}
+	return u.GetCustomersByID(ctx, id)
+}
+
+func (u *customersQueryImpl) GetCustomerIDsByMembershipID(ctx context.Context, membershipID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.WithContext(ctx).
+		Model(&models.Customer{}).
+		Where("membership_id = ?", membershipID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetCustomersByIDWithDeleted also finds soft-deleted customers.
+func (u *customersQueryImpl) GetCustomersByIDWithDeleted(ctx context.Context, id uint64) (models.Customer, error) {
+	db := u.db.GetConnection()
+	customer := models.Customer{}
+	if err := withDeleted(db, true).
+		WithContext(ctx).
+		Where("id = ?", id).
+		Limit(1).
+		Find(&customer).Error; err != nil {
+		return models.Customer{}, err
+	}
+	return customer, nil
+}
+
+// GetCustomerByPhone finds the active customer with a normalized phone
+// number, if any.
+func (u *customersQueryImpl) GetCustomerByPhone(ctx context.Context, phone string) (models.Customer, error) {
+	db := u.db.GetConnection()
+	customer := models.Customer{}
+	if err := db.
+		WithContext(ctx).
+		Where("phone = ?", phone).
+		Limit(1).
+		Find(&customer).Error; err != nil {
+		return models.Customer{}, err
+	}
+	return customer, nil
+}
+
+// EditCustomerRisk sets, or clears when they are empty, the risk fields of
+// a customer.
+func (u *customersQueryImpl) EditCustomerRisk(ctx context.Context, id uint64, customer models.Customer) (models.Customer, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Customer{}).
+		Where("id = ?", id).
+		Select("risk_status", "risk_reason", "risk_flagged_by", "risk_flagged_at", "risk_expires_at",
+			"risk_reviewed_at", "updated_at").
+		Updates(&customer).Error; err != nil {
+		return models.Customer{}, err
+	}
+	return u.GetCustomersByID(ctx, id)
+}
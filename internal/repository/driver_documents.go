@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DriverDocumentsQuery interface {
+	GetDriverDocuments(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverDocument, error)
+	GetDriverDocumentsByID(ctx context.Context, id uint64) (models.DriverDocument, error)
+	GetDriverDocumentsExpiringBefore(ctx context.Context, before time.Time) ([]models.DriverDocument, error)
+	EditDriverDocuments(ctx context.Context, id uint64, document models.DriverDocument) (models.DriverDocument, error)
+	DeleteDriverDocumentsByID(ctx context.Context, id uint64) error
+	CreateDriverDocuments(ctx context.Context, document models.DriverDocument) (models.DriverDocument, error)
+	RestoreDriverDocumentsByID(ctx context.Context, id uint64) (models.DriverDocument, error)
+}
+
+type driverDocumentsQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewDriverDocumentsQuery(db infrastructure.GormPostgres) DriverDocumentsQuery {
+	return &driverDocumentsQueryImpl{db: db}
+}
+
+// GetDriverDocuments lists documents, only those of driverID when it is not 0.
+func (u *driverDocumentsQueryImpl) GetDriverDocuments(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverDocument, error) {
+	db := u.db.GetConnection()
+	query := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Preload("Driver", unscoped)
+	if driverID != 0 {
+		query = query.Where("driver_id = ?", driverID)
+	}
+	documents := []models.DriverDocument{}
+	if err := query.
+		Order("driver_id, expires_at").
+		Find(&documents).Error; err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+func (u *driverDocumentsQueryImpl) GetDriverDocumentsByID(ctx context.Context, id uint64) (models.DriverDocument, error) {
+	db := u.db.GetConnection()
+	document := models.DriverDocument{}
+	if err := db.
+		WithContext(ctx).
+		Preload("Driver", unscoped).
+		First(&document, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.DriverDocument{}, nil
+		}
+		return models.DriverDocument{}, err
+	}
+	return document, nil
+}
+
+// GetDriverDocumentsExpiringBefore returns the documents of drivers still
+// on the books that run out before the given day, soonest first.
+func (u *driverDocumentsQueryImpl) GetDriverDocumentsExpiringBefore(ctx context.Context, before time.Time) ([]models.DriverDocument, error) {
+	db := u.db.GetConnection()
+	documents := []models.DriverDocument{}
+	if err := db.
+		WithContext(ctx).
+		Preload("Driver").
+		Where("expires_at < ?", before).
+		Where("driver_id IN (?)", db.Model(&models.Driver{}).Select("id")).
+		Order("expires_at, id").
+		Find(&documents).Error; err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+func (u *driverDocumentsQueryImpl) DeleteDriverDocumentsByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.DriverDocument{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *driverDocumentsQueryImpl) CreateDriverDocuments(ctx context.Context, document models.DriverDocument) (models.DriverDocument, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&document).Error; err != nil {
+		return models.DriverDocument{}, err
+	}
+	return u.GetDriverDocumentsByID(ctx, uint64(document.ID))
+}
+
+// EditDriverDocuments overwrites every editable column, so a number can also
+// be cleared.
+func (u *driverDocumentsQueryImpl) EditDriverDocuments(ctx context.Context, id uint64, document models.DriverDocument) (models.DriverDocument, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.DriverDocument{}).
+		Where("id = ?", id).
+		Select("driver_id", "type", "number", "expires_at", "updated_at").
+		Updates(&document).Error; err != nil {
+		return models.DriverDocument{}, err
+	}
+	return u.GetDriverDocumentsByID(ctx, id)
+}
+
+func (u *driverDocumentsQueryImpl) RestoreDriverDocumentsByID(ctx context.Context, id uint64) (models.DriverDocument, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.DriverDocument{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.DriverDocument{}, err
+	}
+	return u.GetDriverDocumentsByID(ctx, id)
+}
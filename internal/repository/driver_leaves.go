@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DriverLeavesQuery interface {
+	GetDriverLeaves(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverLeave, error)
+	GetDriverLeavesByID(ctx context.Context, id uint64) (models.DriverLeave, error)
+	GetOverlappingDriverLeaves(ctx context.Context, driverID uint64, start time.Time, end time.Time) ([]models.DriverLeave, error)
+	EditDriverLeaves(ctx context.Context, id uint64, leave models.DriverLeave) (models.DriverLeave, error)
+	DeleteDriverLeavesByID(ctx context.Context, id uint64) error
+	CreateDriverLeaves(ctx context.Context, leave models.DriverLeave) (models.DriverLeave, error)
+	RestoreDriverLeavesByID(ctx context.Context, id uint64) (models.DriverLeave, error)
+}
+
+type driverLeavesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewDriverLeavesQuery(db infrastructure.GormPostgres) DriverLeavesQuery {
+	return &driverLeavesQueryImpl{db: db}
+}
+
+// GetDriverLeaves lists leave, only that of driverID when it is not 0.
+func (u *driverLeavesQueryImpl) GetDriverLeaves(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverLeave, error) {
+	db := u.db.GetConnection()
+	query := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Preload("Driver", unscoped)
+	if driverID != 0 {
+		query = query.Where("driver_id = ?", driverID)
+	}
+	leaves := []models.DriverLeave{}
+	if err := query.
+		Order("start_date DESC, id").
+		Find(&leaves).Error; err != nil {
+		return nil, err
+	}
+	return leaves, nil
+}
+
+func (u *driverLeavesQueryImpl) GetDriverLeavesByID(ctx context.Context, id uint64) (models.DriverLeave, error) {
+	db := u.db.GetConnection()
+	leave := models.DriverLeave{}
+	if err := db.
+		WithContext(ctx).
+		Preload("Driver", unscoped).
+		First(&leave, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.DriverLeave{}, nil
+		}
+		return models.DriverLeave{}, err
+	}
+	return leave, nil
+}
+
+// GetOverlappingDriverLeaves returns the leave of a driver that shares at
+// least one day with start to end.
+func (u *driverLeavesQueryImpl) GetOverlappingDriverLeaves(ctx context.Context, driverID uint64, start time.Time, end time.Time) ([]models.DriverLeave, error) {
+	db := u.db.GetConnection()
+	leaves := []models.DriverLeave{}
+	if err := db.
+		WithContext(ctx).
+		Where("driver_id = ? AND start_date <= ? AND end_date >= ?", driverID, end, start).
+		Order("start_date").
+		Find(&leaves).Error; err != nil {
+		return nil, err
+	}
+	return leaves, nil
+}
+
+func (u *driverLeavesQueryImpl) DeleteDriverLeavesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.DriverLeave{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *driverLeavesQueryImpl) CreateDriverLeaves(ctx context.Context, leave models.DriverLeave) (models.DriverLeave, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&leave).Error; err != nil {
+		return models.DriverLeave{}, err
+	}
+	return u.GetDriverLeavesByID(ctx, uint64(leave.ID))
+}
+
+// EditDriverLeaves overwrites every editable column, so a reason can also be
+// cleared.
+func (u *driverLeavesQueryImpl) EditDriverLeaves(ctx context.Context, id uint64, leave models.DriverLeave) (models.DriverLeave, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.DriverLeave{}).
+		Where("id = ?", id).
+		Select("driver_id", "start_date", "end_date", "reason", "updated_at").
+		Updates(&leave).Error; err != nil {
+		return models.DriverLeave{}, err
+	}
+	return u.GetDriverLeavesByID(ctx, id)
+}
+
+func (u *driverLeavesQueryImpl) RestoreDriverLeavesByID(ctx context.Context, id uint64) (models.DriverLeave, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.DriverLeave{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.DriverLeave{}, err
+	}
+	return u.GetDriverLeavesByID(ctx, id)
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DriverSchedulesQuery interface {
+	GetDriverSchedules(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverSchedule, error)
+	GetDriverSchedulesByID(ctx context.Context, id uint64) (models.DriverSchedule, error)
+	GetDriverSchedulesByDriverID(ctx context.Context, driverID uint64) ([]models.DriverSchedule, error)
+	EditDriverSchedules(ctx context.Context, id uint64, schedule models.DriverSchedule) (models.DriverSchedule, error)
+	DeleteDriverSchedulesByID(ctx context.Context, id uint64) error
+	CreateDriverSchedules(ctx context.Context, schedule models.DriverSchedule) (models.DriverSchedule, error)
+	RestoreDriverSchedulesByID(ctx context.Context, id uint64) (models.DriverSchedule, error)
+}
+
+type driverSchedulesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewDriverSchedulesQuery(db infrastructure.GormPostgres) DriverSchedulesQuery {
+	return &driverSchedulesQueryImpl{db: db}
+}
+
+// GetDriverSchedules lists shifts, only those of driverID when it is not 0.
+func (u *driverSchedulesQueryImpl) GetDriverSchedules(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverSchedule, error) {
+	db := u.db.GetConnection()
+	query := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Preload("Driver", unscoped)
+	if driverID != 0 {
+		query = query.Where("driver_id = ?", driverID)
+	}
+	schedules := []models.DriverSchedule{}
+	if err := query.
+		Order("driver_id, weekday").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+func (u *driverSchedulesQueryImpl) GetDriverSchedulesByID(ctx context.Context, id uint64) (models.DriverSchedule, error) {
+	db := u.db.GetConnection()
+	schedule := models.DriverSchedule{}
+	if err := db.
+		WithContext(ctx).
+		Preload("Driver", unscoped).
+		First(&schedule, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.DriverSchedule{}, nil
+		}
+		return models.DriverSchedule{}, err
+	}
+	return schedule, nil
+}
+
+// GetDriverSchedulesByDriverID returns the weekly shifts of one driver.
+func (u *driverSchedulesQueryImpl) GetDriverSchedulesByDriverID(ctx context.Context, driverID uint64) ([]models.DriverSchedule, error) {
+	db := u.db.GetConnection()
+	schedules := []models.DriverSchedule{}
+	if err := db.
+		WithContext(ctx).
+		Where("driver_id = ?", driverID).
+		Order("weekday").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+func (u *driverSchedulesQueryImpl) DeleteDriverSchedulesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.DriverSchedule{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *driverSchedulesQueryImpl) CreateDriverSchedules(ctx context.Context, schedule models.DriverSchedule) (models.DriverSchedule, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&schedule).Error; err != nil {
+		return models.DriverSchedule{}, err
+	}
+	return u.GetDriverSchedulesByID(ctx, uint64(schedule.ID))
+}
+
+// EditDriverSchedules overwrites every editable column, so a shift can move
+// to Sunday, weekday 0.
+func (u *driverSchedulesQueryImpl) EditDriverSchedules(ctx context.Context, id uint64, schedule models.DriverSchedule) (models.DriverSchedule, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.DriverSchedule{}).
+		Where("id = ?", id).
+		Select("driver_id", "weekday", "start_time", "end_time", "updated_at").
+		Updates(&schedule).Error; err != nil {
+		return models.DriverSchedule{}, err
+	}
+	return u.GetDriverSchedulesByID(ctx, id)
+}
+
+func (u *driverSchedulesQueryImpl) RestoreDriverSchedulesByID(ctx context.Context, id uint64) (models.DriverSchedule, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.DriverSchedule{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.DriverSchedule{}, err
+	}
+	return u.GetDriverSchedulesByID(ctx, id)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"car-rental/internal/infrastructure"
 	"car-rental/internal/models"
@@ -10,11 +11,16 @@ import (
 )
 
 type DriversQuery interface {
-	GetDrivers(ctx context.Context) ([]models.Driver, error)
+	GetDrivers(ctx context.Context, includeDeleted bool) ([]models.Driver, error)
 	GetDriversByID(ctx context.Context, id uint64) (models.Driver, error)
 	EditDrivers(ctx context.Context, id uint64, drivers models.Driver) (models.Driver, error)
 	DeleteDriversByID(ctx context.Context, id uint64) error
 	CreateDrivers(ctx context.Context, drivers models.Driver) (models.Driver, error)
+	RestoreDriversByID(ctx context.Context, id uint64) (models.Driver, error)
+	GetDriverIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error)
+	GetDriversWithLicenseExpiringBefore(ctx context.Context, before time.Time) ([]models.Driver, error)
+	GetDriversWithoutLicense(ctx context.Context) ([]models.Driver, error)
+	GetDriverStats(ctx context.Context, driverID uint64, from, to time.Time) (models.DriverStats, error)
 }
 
 type DriversCommand interface {
@@ -29,10 +35,10 @@ func NewDriversQuery(db infrastructure.GormPostgres) DriversQuery {
 	return &driversQueryImpl{db: db}
 }
 
-func (u *driversQueryImpl) GetDrivers(ctx context.Context) ([]models.Driver, error) {
+func (u *driversQueryImpl) GetDrivers(ctx context.Context, includeDeleted bool) ([]models.Driver, error) {
 	db := u.db.GetConnection()
 	drivers := []models.Driver{}
-	if err := db.
+	if err := withDeleted(db, includeDeleted).
 		WithContext(ctx).
 		Table("drivers").
 		Find(&drivers).Error; err != nil {
@@ -81,16 +87,146 @@ func (u *driversQueryImpl) CreateDrivers(ctx context.Context, drivers models.Dri
 	}
 	return drivers, nil
 }
+
+// EditDrivers overwrites every editable column, so an incentive tier can also
+// be cleared.
 func (u *driversQueryImpl) EditDrivers(ctx context.Context, id uint64, driver models.Driver) (models.Driver, error) {
 	db := u.db.GetConnection()
-	updatedDrivers := models.Driver{}
 	if err := db.
 		WithContext(ctx).
+		Model(&models.Driver{}).
+		Where("id = ?", id).
+		Select("name", "nik", "phone", "birth_date", "gender", "daily_cost", "branch_id", "license_number",
+			"license_class", "license_expiry", "incentive_tier", "updated_at").
+		Updates(&driver).Error; err != nil {
+		return models.Driver{}, err
+	}
+	return u.GetDriversByID(ctx, id)
+}
+
+func (u *driversQueryImpl) RestoreDriversByID(ctx context.Context, id uint64) (models.Driver, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
 		Table("drivers").
-		Where("id = ?", id).Updates(&driver).First(&updatedDrivers).Error; err != nil {
-			if err == gorm.ErrRecordNotFound {
-				return models.Driver{}, nil
-			}
-		}
-	return updatedDrivers, nil
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Driver{}, err
+	}
+	return u.GetDriversByID(ctx, id)
+}
+
+func (u *driversQueryImpl) GetDriverIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Driver{}).
+		Where("branch_id = ?", branchID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetDriversWithLicenseExpiringBefore returns the drivers whose license on
+// record runs out before the given day, soonest first.
+func (u *driversQueryImpl) GetDriversWithLicenseExpiringBefore(ctx context.Context, before time.Time) ([]models.Driver, error) {
+	db := u.db.GetConnection()
+	drivers := []models.Driver{}
+	if err := db.
+		WithContext(ctx).
+		Where("license_expiry < ?", before).
+		Order("license_expiry, id").
+		Find(&drivers).Error; err != nil {
+		return nil, err
+	}
+	return drivers, nil
+}
+
+// GetDriversWithoutLicense returns the drivers with no license number or
+// expiry on record.
+func (u *driversQueryImpl) GetDriversWithoutLicense(ctx context.Context) ([]models.Driver, error) {
+	db := u.db.GetConnection()
+	drivers := []models.Driver{}
+	if err := db.
+		WithContext(ctx).
+		Where("COALESCE(license_number, '') = '' OR license_expiry IS NULL").
+		Order("id").
+		Find(&drivers).Error; err != nil {
+		return nil, err
+	}
+	return drivers, nil
+}
+
+// driverTripsWhere joins the bookings of table's rows and narrows them to the finished, not
+// cancelled trips of driverID that end from from until until, excluded.
+func driverTripsWhere(query *gorm.DB, table string, driverID uint64, from, until time.Time) *gorm.DB {
+	return query.
+		Joins("JOIN bookings ON bookings.id = "+table+".booking_id AND bookings.deleted_at IS NULL").
+		Where("bookings.driver_id = ? AND bookings.finished AND bookings.cancelled_at IS NULL", driverID).
+		Where("bookings.end_rent >= ? AND bookings.end_rent < ?", from, until)
+}
+
+// GetDriverStats works out a driver's KPIs over the days from from to to,
+// both included, leaving utilization to the caller.
+func (u *driversQueryImpl) GetDriverStats(ctx context.Context, driverID uint64, from, to time.Time) (models.DriverStats, error) {
+	db := u.db.GetConnection()
+	until := to.AddDate(0, 0, 1)
+	stats := models.DriverStats{DriverID: uint(driverID), From: from, To: to}
+
+	trips := struct {
+		TripsCompleted int
+		DaysDriven     int
+		Cancellations  int
+	}{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Booking{}).
+		Select(`COUNT(*) FILTER (WHERE finished AND cancelled_at IS NULL AND end_rent >= ? AND end_rent < ?) AS trips_completed,
+			COALESCE(SUM(end_rent::date - GREATEST(start_rent::date, ?::date) + 1)
+				FILTER (WHERE finished AND cancelled_at IS NULL AND end_rent >= ? AND end_rent < ?), 0) AS days_driven,
+			COUNT(*) FILTER (WHERE cancelled_at >= ? AND cancelled_at < ?) AS cancellations`,
+			from, until, from, from, until, from, until).
+		Where("driver_id = ?", driverID).
+		Scan(&trips).Error; err != nil {
+		return models.DriverStats{}, err
+	}
+	stats.TripsCompleted = trips.TripsCompleted
+	stats.DaysDriven = trips.DaysDriven
+	stats.Cancellations = trips.Cancellations
+
+	if err := driverTripsWhere(db.WithContext(ctx).Model(&models.DriverIncentive{}), "driver_incentives", driverID, from, until).
+		Select("COALESCE(SUM(driver_incentives.incentive), 0)").
+		Scan(&stats.TotalIncentive).Error; err != nil {
+		return models.DriverStats{}, err
+	}
+
+	ratings := struct {
+		AverageRating *float64
+		RatingCount   int
+	}{}
+	if err := driverTripsWhere(db.WithContext(ctx).Model(&models.BookingRating{}), "booking_ratings", driverID, from, until).
+		Where("booking_ratings.driver_rating IS NOT NULL").
+		Select("ROUND(AVG(booking_ratings.driver_rating), 2) AS average_rating, COUNT(*) AS rating_count").
+		Scan(&ratings).Error; err != nil {
+		return models.DriverStats{}, err
+	}
+	stats.AverageRating = ratings.AverageRating
+	stats.RatingCount = ratings.RatingCount
+
+	var available int64
+	if err := db.
+		WithContext(ctx).
+		Table("generate_series(?::date, ?::date, interval '1 day') AS days(day)", from, to).
+		Where("NOT EXISTS (SELECT 1 FROM driver_leaves WHERE driver_id = ? AND deleted_at IS NULL AND days.day BETWEEN start_date AND end_date)", driverID).
+		Where("NOT EXISTS (SELECT 1 FROM driver_schedules WHERE driver_id = ? AND deleted_at IS NULL) OR "+
+			"EXISTS (SELECT 1 FROM driver_schedules WHERE driver_id = ? AND deleted_at IS NULL AND weekday = EXTRACT(DOW FROM days.day))", driverID, driverID).
+		Count(&available).Error; err != nil {
+		return models.DriverStats{}, err
+	}
+	stats.AvailableDays = int(available)
+	return stats, nil
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"car-rental/internal/infrastructure"
 	"car-rental/internal/models"
@@ -10,11 +11,17 @@ import (
 )
 
 type DriversIncentiveQuery interface {
-	GetDriversIncentive(ctx context.Context) ([]models.DriverIncentive, error)
+	GetDriversIncentive(ctx context.Context, includeDeleted bool) ([]models.DriverIncentive, error)
 	GetDriversIncentiveByID(ctx context.Context, id uint64) (models.DriverIncentive, error)
 	EditDriversIncentive(ctx context.Context, id uint64, driversIncentive models.DriverIncentive) (models.DriverIncentive, error)
 	DeleteDriversIncentiveByID(ctx context.Context, id uint64) error
 	CreateDriversIncentive(ctx context.Context, driversIncentive models.DriverIncentive) (models.DriverIncentive, error)
+	RestoreDriversIncentiveByID(ctx context.Context, id uint64) (models.DriverIncentive, error)
+	GetPaidDriversIncentiveIDsByBookingID(ctx context.Context, bookingID uint64) ([]uint, error)
+	GetUnpaidDriversIncentive(ctx context.Context, end time.Time) ([]models.DriverIncentive, error)
+	GetDriversIncentiveByPayoutPeriodID(ctx context.Context, periodID uint64) ([]models.DriverIncentive, error)
+	GetDriversIncentiveByDriverID(ctx context.Context, driverID uint64, filter models.IncentiveFilter) ([]models.DriverIncentive, int64, error)
+	SumDriversIncentiveByDriverID(ctx context.Context, driverID uint64, filter models.IncentiveFilter) (int64, error)
 }
 
 type DriversIncentiveCommand interface {
@@ -29,17 +36,23 @@ func NewDriversIncentiveQuery(db infrastructure.GormPostgres) DriversIncentiveQu
 	return &driversIncentiveQueryImpl{db: db}
 }
 
-func (u *driversIncentiveQueryImpl) GetDriversIncentive(ctx context.Context) ([]models.DriverIncentive, error) {
+// withIncentiveRelations preloads the booking an incentive was paid for,
+// including soft-deleted rows so the history stays readable.
+func withIncentiveRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Booking", unscoped).
+		Preload("Booking.Customer", unscoped).
+		Preload("Booking.Customer.Membership", unscoped).
+		Preload("Booking.Car", unscoped).
+		Preload("Booking.Driver", unscoped).
+		Preload("Booking.BookingType", unscoped)
+}
+
+func (u *driversIncentiveQueryImpl) GetDriversIncentive(ctx context.Context, includeDeleted bool) ([]models.DriverIncentive, error) {
 	db := u.db.GetConnection()
 	driversIncentive := []models.DriverIncentive{}
 
-	if err := db.WithContext(ctx).
-		Preload("Booking").
-		Preload("Booking.Customer.Membership").
-		Preload("Booking.Customer").
-		Preload("Booking.Car").
-		Preload("Booking.Driver").
-		Preload("Booking.BookingType").
+	if err := withIncentiveRelations(withDeleted(db, includeDeleted).WithContext(ctx)).
 		Find(&driversIncentive).Error; err != nil {
 		return nil, err
 	}
@@ -51,14 +64,8 @@ func (u *driversIncentiveQueryImpl) GetDriversIncentiveByID(ctx context.Context,
 	db := u.db.GetConnection()
 	driversIncentive := models.DriverIncentive{}
 
-	if err := db.WithContext(ctx).
-		Preload("Booking").
-		Preload("Booking.Customer.Membership").
-		Preload("Booking.Customer").
-		Preload("Booking.Car").
-		Preload("Booking.Driver").
-		Preload("Booking.BookingType").
-		First(&driversIncentive, id).Error; err != nil { 
+	if err := withIncentiveRelations(db.WithContext(ctx)).
+		First(&driversIncentive, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return models.DriverIncentive{}, nil
 		}
@@ -81,20 +88,14 @@ func (u *driversIncentiveQueryImpl) DeleteDriversIncentiveByID(ctx context.Conte
 }
 
 func (u *driversIncentiveQueryImpl) CreateDriversIncentive(ctx context.Context, driversIncentive models.DriverIncentive) (models.DriverIncentive, error) {
-	
+
 	db := u.db.GetConnection()
 
 	if err := db.WithContext(ctx).Table("driver_incentives").Save(&driversIncentive).Error; err != nil {
 		return models.DriverIncentive{}, err
 	}
 
-	if err := db.WithContext(ctx).
-		Preload("Booking").
-		Preload("Booking.Customer.Membership").
-		Preload("Booking.Customer").
-		Preload("Booking.Car").
-		Preload("Booking.Driver").
-		Preload("Booking.BookingType").
+	if err := withIncentiveRelations(db.WithContext(ctx)).
 		First(&driversIncentive, driversIncentive.ID).Error; err != nil {
 		return models.DriverIncentive{}, err
 	}
@@ -111,16 +112,130 @@ func (u *driversIncentiveQueryImpl) EditDriversIncentive(ctx context.Context, id
 	}
 
 	updatedDriverIncentive := models.DriverIncentive{}
-	if err := db.WithContext(ctx).
-		Preload("Booking").
-		Preload("Booking.Customer.Membership").
-		Preload("Booking.Customer").
-		Preload("Booking.Car").
-		Preload("Booking.Driver").
-		Preload("Booking.BookingType").
+	if err := withIncentiveRelations(db.WithContext(ctx)).
 		First(&updatedDriverIncentive, id).Error; err != nil {
 		return models.DriverIncentive{}, err
 	}
 
 	return updatedDriverIncentive, nil
 }
+
+func (u *driversIncentiveQueryImpl) RestoreDriversIncentiveByID(ctx context.Context, id uint64) (models.DriverIncentive, error) {
+	db := u.db.GetConnection()
+	if err := db.WithContext(ctx).
+		Unscoped().
+		Model(&models.DriverIncentive{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.DriverIncentive{}, err
+	}
+	return u.GetDriversIncentiveByID(ctx, id)
+}
+
+// GetPaidDriversIncentiveIDsByBookingID lists the incentives of a booking
+// already paid out in a payout period.
+func (u *driversIncentiveQueryImpl) GetPaidDriversIncentiveIDsByBookingID(ctx context.Context, bookingID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.WithContext(ctx).
+		Model(&models.DriverIncentive{}).
+		Where("booking_id = ? AND payout_period_id IS NOT NULL", bookingID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetUnpaidDriversIncentive lists the incentives not paid out yet of
+// finished, not cancelled bookings with a driver, only those of bookings
+// ending on or before end when end is not zero.
+func (u *driversIncentiveQueryImpl) GetUnpaidDriversIncentive(ctx context.Context, end time.Time) ([]models.DriverIncentive, error) {
+	db := u.db.GetConnection()
+	query := db.WithContext(ctx).
+		Preload("Booking", unscoped).
+		Preload("Booking.Driver", unscoped).
+		Joins("JOIN bookings ON bookings.id = driver_incentives.booking_id").
+		Where("driver_incentives.payout_period_id IS NULL").
+		Where("bookings.driver_id IS NOT NULL AND bookings.finished = ? AND bookings.cancelled_at IS NULL", true)
+	if !end.IsZero() {
+		query = query.Where("bookings.end_rent <= ?", end)
+	}
+	incentives := []models.DriverIncentive{}
+	if err := query.
+		Order("bookings.end_rent, driver_incentives.id").
+		Find(&incentives).Error; err != nil {
+		return nil, err
+	}
+	return incentives, nil
+}
+
+// GetDriversIncentiveByPayoutPeriodID lists the incentives paid out in a
+// payout period.
+func (u *driversIncentiveQueryImpl) GetDriversIncentiveByPayoutPeriodID(ctx context.Context, periodID uint64) ([]models.DriverIncentive, error) {
+	db := u.db.GetConnection()
+	incentives := []models.DriverIncentive{}
+	if err := db.WithContext(ctx).
+		Preload("Booking", unscoped).
+		Preload("Booking.Driver", unscoped).
+		Where("payout_period_id = ?", periodID).
+		Order("id").
+		Find(&incentives).Error; err != nil {
+		return nil, err
+	}
+	return incentives, nil
+}
+
+// driverIncentivesWhere narrows query to the incentives of bookings driven by
+// driverID that end within the filter's range and were not cancelled.
+func driverIncentivesWhere(query *gorm.DB, driverID uint64, filter models.IncentiveFilter) *gorm.DB {
+	query = query.
+		Joins("JOIN bookings ON bookings.id = driver_incentives.booking_id").
+		Where("bookings.driver_id = ? AND bookings.cancelled_at IS NULL", driverID)
+	from, to := filter.Range()
+	if !from.IsZero() {
+		query = query.Where("bookings.end_rent >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("bookings.end_rent < ?", to.AddDate(0, 0, 1))
+	}
+	return query
+}
+
+// GetDriversIncentiveByDriverID returns one page of a driver's incentives,
+// latest booking first, and how many there are over all pages. Only the page
+// is loaded with its relations.
+func (u *driversIncentiveQueryImpl) GetDriversIncentiveByDriverID(ctx context.Context, driverID uint64, filter models.IncentiveFilter) ([]models.DriverIncentive, int64, error) {
+	db := u.db.GetConnection()
+	var total int64
+	if err := driverIncentivesWhere(db.WithContext(ctx).Model(&models.DriverIncentive{}), driverID, filter).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	incentives := []models.DriverIncentive{}
+	if total == 0 {
+		return incentives, 0, nil
+	}
+	if err := driverIncentivesWhere(withIncentiveRelations(db.WithContext(ctx)), driverID, filter).
+		Order("bookings.end_rent DESC, driver_incentives.id DESC").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Find(&incentives).Error; err != nil {
+		return nil, 0, err
+	}
+	return incentives, total, nil
+}
+
+// SumDriversIncentiveByDriverID adds up a driver's incentives within the
+// filter's range; paging does not apply.
+func (u *driversIncentiveQueryImpl) SumDriversIncentiveByDriverID(ctx context.Context, driverID uint64, filter models.IncentiveFilter) (int64, error) {
+	db := u.db.GetConnection()
+	var sum int64
+	if err := driverIncentivesWhere(db.WithContext(ctx).Model(&models.DriverIncentive{}), driverID, filter).
+		Select("COALESCE(SUM(driver_incentives.incentive), 0)").
+		Scan(&sum).Error; err != nil {
+		return 0, err
+	}
+	return sum, nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ExtrasQuery interface {
+	GetExtras(ctx context.Context, includeDeleted bool) ([]models.Extra, error)
+	GetExtrasByID(ctx context.Context, id uint64) (models.Extra, error)
+	EditExtras(ctx context.Context, id uint64, extra models.Extra) (models.Extra, error)
+	DeleteExtrasByID(ctx context.Context, id uint64) error
+	CreateExtras(ctx context.Context, extra models.Extra) (models.Extra, error)
+	RestoreExtrasByID(ctx context.Context, id uint64) (models.Extra, error)
+	CountExtrasInUse(ctx context.Context, extraID uint64, start, end time.Time, excludeBookingID uint64) (int64, error)
+}
+
+type extrasQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewExtrasQuery(db infrastructure.GormPostgres) ExtrasQuery {
+	return &extrasQueryImpl{db: db}
+}
+
+func (u *extrasQueryImpl) GetExtras(ctx context.Context, includeDeleted bool) ([]models.Extra, error) {
+	db := u.db.GetConnection()
+	extras := []models.Extra{}
+	if err := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Order("id").
+		Find(&extras).Error; err != nil {
+		return nil, err
+	}
+	return extras, nil
+}
+
+func (u *extrasQueryImpl) GetExtrasByID(ctx context.Context, id uint64) (models.Extra, error) {
+	db := u.db.GetConnection()
+	extra := models.Extra{}
+	if err := db.
+		WithContext(ctx).
+		First(&extra, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.Extra{}, nil
+		}
+		return models.Extra{}, err
+	}
+	return extra, nil
+}
+
+func (u *extrasQueryImpl) DeleteExtrasByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.Extra{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *extrasQueryImpl) CreateExtras(ctx context.Context, extra models.Extra) (models.Extra, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&extra).Error; err != nil {
+		return models.Extra{}, err
+	}
+	return extra, nil
+}
+
+// EditExtras also writes empty and zero values, so an extra can be made free
+// or its stock run down to nothing.
+func (u *extrasQueryImpl) EditExtras(ctx context.Context, id uint64, extra models.Extra) (models.Extra, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Extra{}).
+		Where("id = ?", id).
+		Select("name", "description", "pricing", "price", "stock", "updated_at").
+		Updates(&extra).Error; err != nil {
+		return models.Extra{}, err
+	}
+	return u.GetExtrasByID(ctx, id)
+}
+
+func (u *extrasQueryImpl) RestoreExtrasByID(ctx context.Context, id uint64) (models.Extra, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.Extra{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Extra{}, err
+	}
+	return u.GetExtrasByID(ctx, id)
+}
+
+// CountExtrasInUse adds up how many of an extra the unfinished bookings
+// holding a car at some point between start and end have taken, leaving out
+// the booking being edited.
+func (u *extrasQueryImpl) CountExtrasInUse(ctx context.Context, extraID uint64, start, end time.Time, excludeBookingID uint64) (int64, error) {
+	db := u.db.GetConnection()
+	var count int64
+	if err := extrasInUse(db.WithContext(ctx), extraID, start, end, excludeBookingID).
+		Scan(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// extrasInUse sums the quantity of extraID taken by the bookings holding a
+// car between start and end, but excludeBookingID.
+func extrasInUse(db *gorm.DB, extraID uint64, start, end time.Time, excludeBookingID uint64) *gorm.DB {
+	holding := bookingsHoldingUnits(db.Model(&models.Booking{}), start, end).
+		Select("bookings.id").
+		Where("bookings.id <> ?", excludeBookingID)
+	return db.
+		Model(&models.BookingExtra{}).
+		Select("COALESCE(SUM(quantity), 0)").
+		Where("extra_id = ? AND booking_id IN (?)", extraID, holding)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type IncentiveRulesQuery interface {
+	GetIncentiveRules(ctx context.Context, includeDeleted bool) ([]models.IncentiveRule, error)
+	GetIncentiveRulesByID(ctx context.Context, id uint64) (models.IncentiveRule, error)
+	GetCurrentIncentiveRule(ctx context.Context) (models.IncentiveRule, error)
+	DeleteIncentiveRulesByID(ctx context.Context, id uint64) error
+	CreateIncentiveRules(ctx context.Context, rule models.IncentiveRule) (models.IncentiveRule, error)
+	RestoreIncentiveRulesByID(ctx context.Context, id uint64) (models.IncentiveRule, error)
+}
+
+type incentiveRulesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewIncentiveRulesQuery(db infrastructure.GormPostgres) IncentiveRulesQuery {
+	return &incentiveRulesQueryImpl{db: db}
+}
+
+func withRuleTiers(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tiers", func(db *gorm.DB) *gorm.DB { return db.Order("tier") })
+}
+
+func (u *incentiveRulesQueryImpl) GetIncentiveRules(ctx context.Context, includeDeleted bool) ([]models.IncentiveRule, error) {
+	db := u.db.GetConnection()
+	rules := []models.IncentiveRule{}
+	if err := withRuleTiers(withDeleted(db, includeDeleted).WithContext(ctx)).
+		Order("version DESC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (u *incentiveRulesQueryImpl) GetIncentiveRulesByID(ctx context.Context, id uint64) (models.IncentiveRule, error) {
+	db := u.db.GetConnection()
+	rule := models.IncentiveRule{}
+	if err := withRuleTiers(db.WithContext(ctx)).
+		First(&rule, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.IncentiveRule{}, nil
+		}
+		return models.IncentiveRule{}, err
+	}
+	return rule, nil
+}
+
+// GetCurrentIncentiveRule returns the latest version that was not retired,
+// or a zero rule when there is none.
+func (u *incentiveRulesQueryImpl) GetCurrentIncentiveRule(ctx context.Context) (models.IncentiveRule, error) {
+	db := u.db.GetConnection()
+	rule := models.IncentiveRule{}
+	if err := withRuleTiers(db.WithContext(ctx)).
+		Order("version DESC").
+		Limit(1).
+		Find(&rule).Error; err != nil {
+		return models.IncentiveRule{}, err
+	}
+	return rule, nil
+}
+
+func (u *incentiveRulesQueryImpl) DeleteIncentiveRulesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.IncentiveRule{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateIncentiveRules saves a rule with its tiers as the version after the
+// highest one so far, retired versions included.
+func (u *incentiveRulesQueryImpl) CreateIncentiveRules(ctx context.Context, rule models.IncentiveRule) (models.IncentiveRule, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		latest := 0
+		if err := tx.Unscoped().
+			Model(&models.IncentiveRule{}).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+		rule.Version = latest + 1
+		return tx.Create(&rule).Error
+	})
+	if err != nil {
+		return models.IncentiveRule{}, err
+	}
+	return u.GetIncentiveRulesByID(ctx, uint64(rule.ID))
+}
+
+func (u *incentiveRulesQueryImpl) RestoreIncentiveRulesByID(ctx context.Context, id uint64) (models.IncentiveRule, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.IncentiveRule{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.IncentiveRule{}, err
+	}
+	return u.GetIncentiveRulesByID(ctx, id)
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type InspectionsQuery interface {
+	GetInspections(ctx context.Context, bookingID uint64, includeDeleted bool) ([]models.Inspection, error)
+	GetInspectionsByID(ctx context.Context, id uint64) (models.Inspection, error)
+	GetInspectionsByIDWithDeleted(ctx context.Context, id uint64) (models.Inspection, error)
+	GetInspectionByBookingID(ctx context.Context, bookingID uint64, kind string) (models.Inspection, error)
+	CreateInspections(ctx context.Context, inspection models.Inspection) (models.Inspection, error)
+	DeleteInspectionsByID(ctx context.Context, id uint64) error
+	RestoreInspectionsByID(ctx context.Context, id uint64) (models.Inspection, error)
+	CreateInspectionImages(ctx context.Context, image models.InspectionImage) (models.InspectionImage, error)
+	GetInspectionImagesByID(ctx context.Context, inspectionID uint64, id uint64) (models.InspectionImage, error)
+}
+
+type inspectionsQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewInspectionsQuery(db infrastructure.GormPostgres) InspectionsQuery {
+	return &inspectionsQueryImpl{db: db}
+}
+
+func withInspectionRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Damages", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Images", func(db *gorm.DB) *gorm.DB { return db.Order("id") })
+}
+
+// GetInspections lists inspections, only those of bookingID when it is not 0.
+func (u *inspectionsQueryImpl) GetInspections(ctx context.Context, bookingID uint64, includeDeleted bool) ([]models.Inspection, error) {
+	db := u.db.GetConnection()
+	query := withInspectionRelations(withDeleted(db, includeDeleted).WithContext(ctx))
+	if bookingID != 0 {
+		query = query.Where("booking_id = ?", bookingID)
+	}
+	inspections := []models.Inspection{}
+	if err := query.
+		Order("id").
+		Find(&inspections).Error; err != nil {
+		return nil, err
+	}
+	return inspections, nil
+}
+
+func (u *inspectionsQueryImpl) GetInspectionsByID(ctx context.Context, id uint64) (models.Inspection, error) {
+	db := u.db.GetConnection()
+	inspection := models.Inspection{}
+	if err := withInspectionRelations(db.WithContext(ctx)).
+		First(&inspection, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.Inspection{}, nil
+		}
+		return models.Inspection{}, err
+	}
+	return inspection, nil
+}
+
+// GetInspectionsByIDWithDeleted also finds soft-deleted inspections.
+func (u *inspectionsQueryImpl) GetInspectionsByIDWithDeleted(ctx context.Context, id uint64) (models.Inspection, error) {
+	db := u.db.GetConnection()
+	inspection := models.Inspection{}
+	if err := withDeleted(db, true).
+		WithContext(ctx).
+		Where("id = ?", id).
+		Limit(1).
+		Find(&inspection).Error; err != nil {
+		return models.Inspection{}, err
+	}
+	return inspection, nil
+}
+
+// GetInspectionByBookingID finds the checkout or checkin inspection of a
+// booking.
+func (u *inspectionsQueryImpl) GetInspectionByBookingID(ctx context.Context, bookingID uint64, kind string) (models.Inspection, error) {
+	db := u.db.GetConnection()
+	inspection := models.Inspection{}
+	if err := withInspectionRelations(db.WithContext(ctx)).
+		Where("booking_id = ? AND kind = ?", bookingID, kind).
+		Limit(1).
+		Find(&inspection).Error; err != nil {
+		return models.Inspection{}, err
+	}
+	return inspection, nil
+}
+
+// CreateInspections saves an inspection together with its damages.
+func (u *inspectionsQueryImpl) CreateInspections(ctx context.Context, inspection models.Inspection) (models.Inspection, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Create(&inspection).Error; err != nil {
+		return models.Inspection{}, err
+	}
+	return u.GetInspectionsByID(ctx, uint64(inspection.ID))
+}
+
+func (u *inspectionsQueryImpl) DeleteInspectionsByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.Inspection{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *inspectionsQueryImpl) RestoreInspectionsByID(ctx context.Context, id uint64) (models.Inspection, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.Inspection{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Inspection{}, err
+	}
+	return u.GetInspectionsByID(ctx, id)
+}
+
+func (u *inspectionsQueryImpl) CreateInspectionImages(ctx context.Context, image models.InspectionImage) (models.InspectionImage, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Create(&image).Error; err != nil {
+		return models.InspectionImage{}, err
+	}
+	return image, nil
+}
+
+func (u *inspectionsQueryImpl) GetInspectionImagesByID(ctx context.Context, inspectionID uint64, id uint64) (models.InspectionImage, error) {
+	db := u.db.GetConnection()
+	image := models.InspectionImage{}
+	if err := db.
+		WithContext(ctx).
+		Where("id = ? AND inspection_id = ?", id, inspectionID).
+		Limit(1).
+		Find(&image).Error; err != nil {
+		return models.InspectionImage{}, err
+	}
+	return image, nil
+}
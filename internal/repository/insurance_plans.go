@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type InsurancePlansQuery interface {
+	GetInsurancePlans(ctx context.Context, includeDeleted bool) ([]models.InsurancePlan, error)
+	GetInsurancePlansByID(ctx context.Context, id uint64) (models.InsurancePlan, error)
+	EditInsurancePlans(ctx context.Context, id uint64, plan models.InsurancePlan) (models.InsurancePlan, error)
+	DeleteInsurancePlansByID(ctx context.Context, id uint64) error
+	CreateInsurancePlans(ctx context.Context, plan models.InsurancePlan) (models.InsurancePlan, error)
+	RestoreInsurancePlansByID(ctx context.Context, id uint64) (models.InsurancePlan, error)
+}
+
+type insurancePlansQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewInsurancePlansQuery(db infrastructure.GormPostgres) InsurancePlansQuery {
+	return &insurancePlansQueryImpl{db: db}
+}
+
+func (u *insurancePlansQueryImpl) GetInsurancePlans(ctx context.Context, includeDeleted bool) ([]models.InsurancePlan, error) {
+	db := u.db.GetConnection()
+	plans := []models.InsurancePlan{}
+	if err := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Order("id").
+		Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+func (u *insurancePlansQueryImpl) GetInsurancePlansByID(ctx context.Context, id uint64) (models.InsurancePlan, error) {
+	db := u.db.GetConnection()
+	plan := models.InsurancePlan{}
+	if err := db.
+		WithContext(ctx).
+		First(&plan, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.InsurancePlan{}, nil
+		}
+		return models.InsurancePlan{}, err
+	}
+	return plan, nil
+}
+
+func (u *insurancePlansQueryImpl) DeleteInsurancePlansByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.InsurancePlan{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *insurancePlansQueryImpl) CreateInsurancePlans(ctx context.Context, plan models.InsurancePlan) (models.InsurancePlan, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&plan).Error; err != nil {
+		return models.InsurancePlan{}, err
+	}
+	return plan, nil
+}
+
+// EditInsurancePlans also writes empty and zero values, so a plan can drop
+// its excess or its premium.
+func (u *insurancePlansQueryImpl) EditInsurancePlans(ctx context.Context, id uint64, plan models.InsurancePlan) (models.InsurancePlan, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.InsurancePlan{}).
+		Where("id = ?", id).
+		Select("name", "description", "daily_premium", "excess", "updated_at").
+		Updates(&plan).Error; err != nil {
+		return models.InsurancePlan{}, err
+	}
+	return u.GetInsurancePlansByID(ctx, id)
+}
+
+func (u *insurancePlansQueryImpl) RestoreInsurancePlansByID(ctx context.Context, id uint64) (models.InsurancePlan, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.InsurancePlan{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.InsurancePlan{}, err
+	}
+	return u.GetInsurancePlansByID(ctx, id)
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type MaintenanceQuery interface {
+	GetMaintenanceRecords(ctx context.Context, vehicleID uint64, includeDeleted bool) ([]models.MaintenanceRecord, error)
+	GetMaintenanceRecordsByID(ctx context.Context, id uint64) (models.MaintenanceRecord, error)
+	EditMaintenanceRecords(ctx context.Context, id uint64, record models.MaintenanceRecord) (models.MaintenanceRecord, error)
+	DeleteMaintenanceRecordsByID(ctx context.Context, id uint64) error
+	CreateMaintenanceRecords(ctx context.Context, record models.MaintenanceRecord) (models.MaintenanceRecord, error)
+	RestoreMaintenanceRecordsByID(ctx context.Context, id uint64) (models.MaintenanceRecord, error)
+	GetLastServices(ctx context.Context) ([]models.MaintenanceRecord, error)
+	GetScheduledServiceVehicleIDs(ctx context.Context) ([]uint, error)
+}
+
+type maintenanceQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewMaintenanceQuery(db infrastructure.GormPostgres) MaintenanceQuery {
+	return &maintenanceQueryImpl{db: db}
+}
+
+// GetMaintenanceRecords lists maintenance records, only those of vehicleID
+// when it is not 0.
+func (u *maintenanceQueryImpl) GetMaintenanceRecords(ctx context.Context, vehicleID uint64, includeDeleted bool) ([]models.MaintenanceRecord, error) {
+	db := u.db.GetConnection()
+	query := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Preload("Vehicle", unscoped)
+	if vehicleID != 0 {
+		query = query.Where("vehicle_id = ?", vehicleID)
+	}
+	records := []models.MaintenanceRecord{}
+	if err := query.
+		Order("planned_start DESC, id").
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (u *maintenanceQueryImpl) GetMaintenanceRecordsByID(ctx context.Context, id uint64) (models.MaintenanceRecord, error) {
+	db := u.db.GetConnection()
+	record := models.MaintenanceRecord{}
+	if err := db.
+		WithContext(ctx).
+		Preload("Vehicle", unscoped).
+		First(&record, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.MaintenanceRecord{}, nil
+		}
+		return models.MaintenanceRecord{}, err
+	}
+	return record, nil
+}
+
+func (u *maintenanceQueryImpl) DeleteMaintenanceRecordsByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.MaintenanceRecord{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *maintenanceQueryImpl) CreateMaintenanceRecords(ctx context.Context, record models.MaintenanceRecord) (models.MaintenanceRecord, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&record).Error; err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	return u.GetMaintenanceRecordsByID(ctx, uint64(record.ID))
+}
+
+// EditMaintenanceRecords overwrites every editable column, so a completion
+// date or odometer reading can also be cleared.
+func (u *maintenanceQueryImpl) EditMaintenanceRecords(ctx context.Context, id uint64, record models.MaintenanceRecord) (models.MaintenanceRecord, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.MaintenanceRecord{}).
+		Where("id = ?", id).
+		Select("vehicle_id", "type", "planned_start", "planned_end", "completed_at",
+			"odometer", "cost", "workshop", "notes", "updated_at").
+		Updates(&record).Error; err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	return u.GetMaintenanceRecordsByID(ctx, id)
+}
+
+func (u *maintenanceQueryImpl) RestoreMaintenanceRecordsByID(ctx context.Context, id uint64) (models.MaintenanceRecord, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.MaintenanceRecord{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	return u.GetMaintenanceRecordsByID(ctx, id)
+}
+
+// GetLastServices returns the latest completed service of every vehicle
+// that had one.
+func (u *maintenanceQueryImpl) GetLastServices(ctx context.Context) ([]models.MaintenanceRecord, error) {
+	db := u.db.GetConnection()
+	records := []models.MaintenanceRecord{}
+	if err := db.
+		WithContext(ctx).
+		Select("DISTINCT ON (vehicle_id) *").
+		Where("type = ? AND completed_at IS NOT NULL", models.MaintenanceTypeService).
+		Order("vehicle_id, completed_at DESC").
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// GetScheduledServiceVehicleIDs lists the vehicles that already have a
+// service booked and not completed yet.
+func (u *maintenanceQueryImpl) GetScheduledServiceVehicleIDs(ctx context.Context) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.MaintenanceRecord{}).
+		Where("type = ? AND completed_at IS NULL", models.MaintenanceTypeService).
+		Distinct().
+		Pluck("vehicle_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -10,11 +10,13 @@ import (
 )
 
 type MembershipQuery interface {
-	GetMembership(ctx context.Context) ([]models.Membership, error)
+	GetMembership(ctx context.Context, includeDeleted bool) ([]models.Membership, error)
 	GetMembershipByID(ctx context.Context, id uint64) (models.Membership, error)
 	EditMembership(ctx context.Context, id uint64, membership models.Membership) (models.Membership, error)
 	DeleteMembershipByID(ctx context.Context, id uint64) error
 	CreateMembership(ctx context.Context, membership models.Membership) (models.Membership, error)
+	RestoreMembershipByID(ctx context.Context, id uint64) (models.Membership, error)
+	ReassignAndDeleteMembershipByID(ctx context.Context, id uint64, to uint64) error
 }
 
 type MembershipCommand interface {
@@ -29,10 +31,10 @@ func NewMembershipQuery(db infrastructure.GormPostgres) MembershipQuery {
 	return &membershipQueryImpl{db: db}
 }
 
-func (u *membershipQueryImpl) GetMembership(ctx context.Context) ([]models.Membership, error) {
+func (u *membershipQueryImpl) GetMembership(ctx context.Context, includeDeleted bool) ([]models.Membership, error) {
 	db := u.db.GetConnection()
 	membership := []models.Membership{}
-	if err := db.
+	if err := withDeleted(db, includeDeleted).
 		WithContext(ctx).
 		Table("memberships").
 		Find(&membership).Error; err != nil {
@@ -94,3 +96,30 @@ func (u *membershipQueryImpl) EditMembership(ctx context.Context, id uint64, mem
 		}
 	return updatedMembership, nil
 }
+
+func (u *membershipQueryImpl) RestoreMembershipByID(ctx context.Context, id uint64) (models.Membership, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Table("memberships").
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Membership{}, err
+	}
+	return u.GetMembershipByID(ctx, id)
+}
+
+// ReassignAndDeleteMembershipByID moves every customer of membership id over
+// to membership to and deletes id, all in one transaction.
+func (u *membershipQueryImpl) ReassignAndDeleteMembershipByID(ctx context.Context, id uint64, to uint64) error {
+	db := u.db.GetConnection()
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Customer{}).
+			Where("membership_id = ?", id).
+			Update("membership_id", to).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Membership{ID: uint(id)}).Error
+	})
+}
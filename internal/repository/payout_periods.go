@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// ErrPeriodSettled is returned when a payout period is settled again, for
+// instance by a concurrent request that got there first.
+var ErrPeriodSettled = errors.New("payout period was already settled")
+
+type PayoutPeriodsQuery interface {
+	GetPayoutPeriods(ctx context.Context, includeDeleted bool) ([]models.PayoutPeriod, error)
+	GetPayoutPeriodsByID(ctx context.Context, id uint64) (models.PayoutPeriod, error)
+	GetOverlappingPayoutPeriods(ctx context.Context, start, end time.Time) ([]models.PayoutPeriod, error)
+	DeletePayoutPeriodsByID(ctx context.Context, id uint64) error
+	CreatePayoutPeriods(ctx context.Context, period models.PayoutPeriod) (models.PayoutPeriod, error)
+	RestorePayoutPeriodsByID(ctx context.Context, id uint64) (models.PayoutPeriod, error)
+	SettlePayoutPeriods(ctx context.Context, id uint64, bookingIDs []uint, incentiveIDs []uint, settledAt time.Time) (models.PayoutPeriod, error)
+}
+
+type payoutPeriodsQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewPayoutPeriodsQuery(db infrastructure.GormPostgres) PayoutPeriodsQuery {
+	return &payoutPeriodsQueryImpl{db: db}
+}
+
+func (u *payoutPeriodsQueryImpl) GetPayoutPeriods(ctx context.Context, includeDeleted bool) ([]models.PayoutPeriod, error) {
+	db := u.db.GetConnection()
+	periods := []models.PayoutPeriod{}
+	if err := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Order("start_date DESC").
+		Find(&periods).Error; err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+func (u *payoutPeriodsQueryImpl) GetPayoutPeriodsByID(ctx context.Context, id uint64) (models.PayoutPeriod, error) {
+	db := u.db.GetConnection()
+	period := models.PayoutPeriod{}
+	if err := db.
+		WithContext(ctx).
+		First(&period, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.PayoutPeriod{}, nil
+		}
+		return models.PayoutPeriod{}, err
+	}
+	return period, nil
+}
+
+// GetOverlappingPayoutPeriods returns the periods sharing at least one day
+// with start to end.
+func (u *payoutPeriodsQueryImpl) GetOverlappingPayoutPeriods(ctx context.Context, start, end time.Time) ([]models.PayoutPeriod, error) {
+	db := u.db.GetConnection()
+	periods := []models.PayoutPeriod{}
+	if err := db.
+		WithContext(ctx).
+		Where("start_date <= ? AND end_date >= ?", end, start).
+		Order("start_date").
+		Find(&periods).Error; err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+func (u *payoutPeriodsQueryImpl) DeletePayoutPeriodsByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.PayoutPeriod{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *payoutPeriodsQueryImpl) CreatePayoutPeriods(ctx context.Context, period models.PayoutPeriod) (models.PayoutPeriod, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&period).Error; err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	return u.GetPayoutPeriodsByID(ctx, uint64(period.ID))
+}
+
+func (u *payoutPeriodsQueryImpl) RestorePayoutPeriodsByID(ctx context.Context, id uint64) (models.PayoutPeriod, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.PayoutPeriod{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	return u.GetPayoutPeriodsByID(ctx, id)
+}
+
+// SettlePayoutPeriods marks a period settled and the driver earnings of
+// bookingIDs and the incentives of incentiveIDs paid in it, in one
+// transaction. It returns ErrPeriodSettled, changing nothing, when the period
+// was settled already. Rows another settlement got to first are left alone.
+func (u *payoutPeriodsQueryImpl) SettlePayoutPeriods(ctx context.Context, id uint64, bookingIDs []uint, incentiveIDs []uint, settledAt time.Time) (models.PayoutPeriod, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.PayoutPeriod{}).
+			Where("id = ? AND settled_at IS NULL", id).
+			Updates(map[string]any{"settled_at": settledAt, "updated_at": settledAt})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPeriodSettled
+		}
+		if len(bookingIDs) > 0 {
+			if err := tx.Model(&models.Booking{}).
+				Where("id IN ? AND driver_payout_period_id IS NULL", bookingIDs).
+				Update("driver_payout_period_id", id).Error; err != nil {
+				return err
+			}
+		}
+		if len(incentiveIDs) > 0 {
+			if err := tx.Model(&models.DriverIncentive{}).
+				Where("id IN ? AND payout_period_id IS NULL", incentiveIDs).
+				Updates(map[string]any{"payout_period_id": id, "paid_at": settledAt}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	return u.GetPayoutPeriodsByID(ctx, id)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import "gorm.io/gorm"
+
+// withDeleted lifts the soft-delete filter when includeDeleted is set, so
+// archived rows can still be listed on request.
+func withDeleted(db *gorm.DB, includeDeleted bool) *gorm.DB {
+	if includeDeleted {
+		return db.Unscoped()
+	}
+	return db
+}
+
+// unscoped is used as a preload condition so that history keeps showing
+// related rows that have since been soft deleted.
+func unscoped(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type TransfersQuery interface {
+	GetTransfers(ctx context.Context, vehicleID uint64) ([]models.VehicleTransfer, error)
+	GetTransfersByID(ctx context.Context, id uint64) (models.VehicleTransfer, error)
+	CreateTransfers(ctx context.Context, transfer models.VehicleTransfer) (models.VehicleTransfer, error)
+	SetTransferStatus(ctx context.Context, id uint64, status string, at time.Time) (models.VehicleTransfer, error)
+	CompleteTransfers(ctx context.Context, id uint64, receivedAt time.Time) (models.VehicleTransfer, error)
+	GetOpenTransferIDsByVehicleID(ctx context.Context, vehicleID uint64) ([]uint, error)
+	GetOpenTransferIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error)
+}
+
+type transfersQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewTransfersQuery(db infrastructure.GormPostgres) TransfersQuery {
+	return &transfersQueryImpl{db: db}
+}
+
+// transferStatusColumns maps a status to the column noting when a transfer
+// reached it.
+var transferStatusColumns = map[string]string{
+	models.TransferStatusInTransit: "shipped_at",
+	models.TransferStatusCompleted: "received_at",
+	models.TransferStatusCancelled: "cancelled_at",
+}
+
+func withTransferRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Vehicle", unscoped).
+		Preload("FromBranch", unscoped).
+		Preload("ToBranch", unscoped)
+}
+
+// GetTransfers lists transfers, newest first, only those of vehicleID when it
+// is not 0.
+func (u *transfersQueryImpl) GetTransfers(ctx context.Context, vehicleID uint64) ([]models.VehicleTransfer, error) {
+	db := u.db.GetConnection()
+	query := withTransferRelations(db.WithContext(ctx))
+	if vehicleID != 0 {
+		query = query.Where("vehicle_id = ?", vehicleID)
+	}
+	transfers := []models.VehicleTransfer{}
+	if err := query.
+		Order("id DESC").
+		Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (u *transfersQueryImpl) GetTransfersByID(ctx context.Context, id uint64) (models.VehicleTransfer, error) {
+	db := u.db.GetConnection()
+	transfer := models.VehicleTransfer{}
+	if err := withTransferRelations(db.WithContext(ctx)).
+		First(&transfer, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.VehicleTransfer{}, nil
+		}
+		return models.VehicleTransfer{}, err
+	}
+	return transfer, nil
+}
+
+func (u *transfersQueryImpl) CreateTransfers(ctx context.Context, transfer models.VehicleTransfer) (models.VehicleTransfer, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Create(&transfer).Error; err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	return u.GetTransfersByID(ctx, uint64(transfer.ID))
+}
+
+// SetTransferStatus moves a transfer to status and notes when it happened.
+func (u *transfersQueryImpl) SetTransferStatus(ctx context.Context, id uint64, status string, at time.Time) (models.VehicleTransfer, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.VehicleTransfer{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":                      status,
+			transferStatusColumns[status]: at,
+			"updated_at":                  at,
+		}).Error; err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	return u.GetTransfersByID(ctx, id)
+}
+
+// CompleteTransfers marks a transfer received and moves its vehicle to the
+// new branch, in one transaction.
+func (u *transfersQueryImpl) CompleteTransfers(ctx context.Context, id uint64, receivedAt time.Time) (models.VehicleTransfer, error) {
+	db := u.db.GetConnection()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transfer := models.VehicleTransfer{}
+		if err := tx.First(&transfer, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&transfer).Updates(map[string]any{
+			"status":      models.TransferStatusCompleted,
+			"received_at": receivedAt,
+			"updated_at":  receivedAt,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Vehicle{}).
+			Where("id = ?", transfer.VehicleID).
+			Updates(map[string]any{"branch_id": transfer.ToBranchID, "updated_at": receivedAt}).Error
+	})
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	return u.GetTransfersByID(ctx, id)
+}
+
+func (u *transfersQueryImpl) GetOpenTransferIDsByVehicleID(ctx context.Context, vehicleID uint64) ([]uint, error) {
+	return u.getOpenTransferIDsWhere(ctx, "vehicle_id = ?", vehicleID)
+}
+
+// GetOpenTransferIDsByBranchID lists the transfers not finished yet that move
+// a vehicle from or to a branch.
+func (u *transfersQueryImpl) GetOpenTransferIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error) {
+	return u.getOpenTransferIDsWhere(ctx, "(from_branch_id = ? OR to_branch_id = ?)", branchID, branchID)
+}
+
+func (u *transfersQueryImpl) getOpenTransferIDsWhere(ctx context.Context, condition string, args ...any) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := openTransfers(db.WithContext(ctx)).
+		Where(condition, args...).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// openTransfers selects the transfers that are neither received nor
+// cancelled.
+func openTransfers(db *gorm.DB) *gorm.DB {
+	return db.
+		Model(&models.VehicleTransfer{}).
+		Where("vehicle_transfers.status IN ?", []string{models.TransferStatusRequested, models.TransferStatusInTransit})
+}
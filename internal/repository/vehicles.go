@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type VehiclesQuery interface {
+	GetVehicles(ctx context.Context, carID uint64, branchID uint64, includeDeleted bool) ([]models.Vehicle, error)
+	GetVehiclesByID(ctx context.Context, id uint64) (models.Vehicle, error)
+	GetVehicleByPlateNumber(ctx context.Context, plateNumber string) (models.Vehicle, error)
+	GetVehicleByVIN(ctx context.Context, vin string) (models.Vehicle, error)
+	EditVehicles(ctx context.Context, id uint64, vehicle models.Vehicle) (models.Vehicle, error)
+	DeleteVehiclesByID(ctx context.Context, id uint64) error
+	CreateVehicles(ctx context.Context, vehicle models.Vehicle) (models.Vehicle, error)
+	RestoreVehiclesByID(ctx context.Context, id uint64) (models.Vehicle, error)
+	GetVehicleIDsByCarID(ctx context.Context, carID uint64) ([]uint, error)
+	GetVehicleIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error)
+	CountActiveVehiclesByCarID(ctx context.Context, carID uint64) (int64, error)
+	CountServiceableVehiclesByCarID(ctx context.Context, carID uint64, branchID uint64, start, end time.Time) (int64, error)
+}
+
+type vehiclesQueryImpl struct {
+	db infrastructure.GormPostgres
+}
+
+func NewVehiclesQuery(db infrastructure.GormPostgres) VehiclesQuery {
+	return &vehiclesQueryImpl{db: db}
+}
+
+// GetVehicles lists vehicles, only those of carID and at branchID when they
+// are not 0.
+func (u *vehiclesQueryImpl) GetVehicles(ctx context.Context, carID uint64, branchID uint64, includeDeleted bool) ([]models.Vehicle, error) {
+	db := u.db.GetConnection()
+	query := withDeleted(db, includeDeleted).
+		WithContext(ctx).
+		Preload("Car", unscoped).
+		Preload("Branch", unscoped)
+	if carID != 0 {
+		query = query.Where("car_id = ?", carID)
+	}
+	if branchID != 0 {
+		query = query.Where("branch_id = ?", branchID)
+	}
+	vehicles := []models.Vehicle{}
+	if err := query.
+		Order("id").
+		Find(&vehicles).Error; err != nil {
+		return nil, err
+	}
+	return vehicles, nil
+}
+
+func (u *vehiclesQueryImpl) GetVehiclesByID(ctx context.Context, id uint64) (models.Vehicle, error) {
+	db := u.db.GetConnection()
+	vehicle := models.Vehicle{}
+	if err := db.
+		WithContext(ctx).
+		Preload("Car", unscoped).
+		Preload("Branch", unscoped).
+		First(&vehicle, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return models.Vehicle{}, nil
+		}
+		return models.Vehicle{}, err
+	}
+	return vehicle, nil
+}
+
+func (u *vehiclesQueryImpl) GetVehicleByPlateNumber(ctx context.Context, plateNumber string) (models.Vehicle, error) {
+	return u.getVehicleWhere(ctx, "plate_number", plateNumber)
+}
+
+func (u *vehiclesQueryImpl) GetVehicleByVIN(ctx context.Context, vin string) (models.Vehicle, error) {
+	return u.getVehicleWhere(ctx, "vin", vin)
+}
+
+// getVehicleWhere finds the vehicle whose column equals value. column is
+// always a constant from this file.
+func (u *vehiclesQueryImpl) getVehicleWhere(ctx context.Context, column string, value string) (models.Vehicle, error) {
+	db := u.db.GetConnection()
+	vehicle := models.Vehicle{}
+	if err := db.
+		WithContext(ctx).
+		Where(column+" = ?", value).
+		Limit(1).
+		Find(&vehicle).Error; err != nil {
+		return models.Vehicle{}, err
+	}
+	return vehicle, nil
+}
+
+func (u *vehiclesQueryImpl) DeleteVehiclesByID(ctx context.Context, id uint64) error {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Delete(&models.Vehicle{ID: uint(id)}).
+		Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *vehiclesQueryImpl) CreateVehicles(ctx context.Context, vehicle models.Vehicle) (models.Vehicle, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Save(&vehicle).Error; err != nil {
+		return models.Vehicle{}, err
+	}
+	return u.GetVehiclesByID(ctx, uint64(vehicle.ID))
+}
+
+func (u *vehiclesQueryImpl) EditVehicles(ctx context.Context, id uint64, vehicle models.Vehicle) (models.Vehicle, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Vehicle{}).
+		Where("id = ?", id).
+		Updates(&vehicle).Error; err != nil {
+		return models.Vehicle{}, err
+	}
+	return u.GetVehiclesByID(ctx, id)
+}
+
+func (u *vehiclesQueryImpl) RestoreVehiclesByID(ctx context.Context, id uint64) (models.Vehicle, error) {
+	db := u.db.GetConnection()
+	if err := db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.Vehicle{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error; err != nil {
+		return models.Vehicle{}, err
+	}
+	return u.GetVehiclesByID(ctx, id)
+}
+
+func (u *vehiclesQueryImpl) GetVehicleIDsByCarID(ctx context.Context, carID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Vehicle{}).
+		Where("car_id = ?", carID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (u *vehiclesQueryImpl) GetVehicleIDsByBranchID(ctx context.Context, branchID uint64) ([]uint, error) {
+	db := u.db.GetConnection()
+	ids := []uint{}
+	if err := db.
+		WithContext(ctx).
+		Model(&models.Vehicle{}).
+		Where("branch_id = ?", branchID).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (u *vehiclesQueryImpl) CountActiveVehiclesByCarID(ctx context.Context, carID uint64) (int64, error) {
+	db := u.db.GetConnection()
+	var count int64
+	if err := activeVehicles(db.WithContext(ctx), carID, 0).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountServiceableVehiclesByCarID counts the active vehicles of a car at a
+// branch that are not in transit and have no open maintenance planned between
+// start and end.
+func (u *vehiclesQueryImpl) CountServiceableVehiclesByCarID(ctx context.Context, carID uint64, branchID uint64, start, end time.Time) (int64, error) {
+	db := u.db.GetConnection()
+	var count int64
+	if err := serviceableVehicles(db.WithContext(ctx), carID, branchID, start, end).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// freeVehicles narrows activeVehicles to those neither out on a booking nor
+// in transit right now and with no open maintenance planned before until,
+// lowest odometer first so wear is spread evenly.
+func freeVehicles(db *gorm.DB, carID uint64, branchID uint64, until time.Time) *gorm.DB {
+	return activeVehicles(db, carID, branchID).
+		Where("id NOT IN (?)", vehiclesOut(db)).
+		Where("id NOT IN (?)", vehiclesInMaintenance(db, time.Now(), until)).
+		Where("id NOT IN (?)", vehiclesInTransit(db)).
+		Order("odometer, id")
+}
+
+// activeVehicles selects the vehicles of carID at branchID that may be
+// rented. A carID or branchID of 0 matches every car or branch.
+func activeVehicles(db *gorm.DB, carID uint64, branchID uint64) *gorm.DB {
+	query := db.
+		Model(&models.Vehicle{}).
+		Where("vehicles.status = ?", models.VehicleStatusActive)
+	if carID != 0 {
+		query = query.Where("vehicles.car_id = ?", carID)
+	}
+	if branchID != 0 {
+		query = query.Where("vehicles.branch_id = ?", branchID)
+	}
+	return query
+}
+
+// serviceableVehicles narrows activeVehicles to those not in transit and
+// with no open maintenance planned between start and end.
+func serviceableVehicles(db *gorm.DB, carID uint64, branchID uint64, start, end time.Time) *gorm.DB {
+	return activeVehicles(db, carID, branchID).
+		Where("id NOT IN (?)", vehiclesInMaintenance(db, start, end)).
+		Where("id NOT IN (?)", vehiclesInTransit(db))
+}
+
+// vehiclesOut selects the IDs of vehicles picked up and not returned yet.
+func vehiclesOut(db *gorm.DB) *gorm.DB {
+	return db.
+		Model(&models.Booking{}).
+		Select("vehicle_id").
+		Where("vehicle_id IS NOT NULL AND picked_up_at IS NOT NULL AND returned_at IS NULL")
+}
+
+// vehiclesInMaintenance selects the IDs of vehicles with maintenance planned
+// between start and end that is not completed yet.
+func vehiclesInMaintenance(db *gorm.DB, start, end time.Time) *gorm.DB {
+	return db.
+		Model(&models.MaintenanceRecord{}).
+		Select("vehicle_id").
+		Where("completed_at IS NULL AND planned_start <= ? AND planned_end >= ?", end, start)
+}
+
+// vehiclesInTransit selects the IDs of vehicles shipped to another branch and
+// not received there yet.
+func vehiclesInTransit(db *gorm.DB) *gorm.DB {
+	return db.
+		Model(&models.VehicleTransfer{}).
+		Select("vehicle_id").
+		Where("status = ?", models.TransferStatusInTransit)
+}
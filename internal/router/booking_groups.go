@@ -0,0 +1,27 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookingGroupRouter interface {
+	Mount()
+}
+
+type bookingGroupRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.BookingGroupHandler
+}
+
+func NewBookingGroupRouter(v *gin.RouterGroup, handler handler.BookingGroupHandler) BookingGroupRouter {
+	return &bookingGroupRouterImpl{v: v, handler: handler}
+}
+
+func (p *bookingGroupRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetBookingGroupByID)
+	p.v.GET("", p.handler.GetBookingGroups)
+	p.v.POST("/:id/bookings/:booking_id/cancel", p.handler.CancelBookingGroupLine)
+	p.v.POST("", p.handler.CreateBookingGroup)
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookingRatingRouter interface {
+	Mount()
+}
+
+type bookingRatingRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.BookingRatingHandler
+}
+
+func NewBookingRatingRouter(v *gin.RouterGroup, handler handler.BookingRatingHandler) BookingRatingRouter {
+	return &bookingRatingRouterImpl{v: v, handler: handler}
+}
+
+func (p *bookingRatingRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetBookingRatingByID)
+	p.v.GET("", p.handler.GetBookingRatings)
+	p.v.DELETE("/:id", p.handler.DeleteBookingRatingByID)
+	p.v.PUT("/:id", p.handler.EditBookingRating)
+	p.v.POST("/:id/restore", p.handler.RestoreBookingRatingByID)
+	p.v.POST("", p.handler.CreateBookingRating)
+}
@@ -24,5 +24,6 @@ func (p *bookingTypeRouterImpl) Mount() {
 	p.v.GET("", p.handler.GetBookingTypes)
 	p.v.DELETE("/:id", p.handler.DeleteBookingTypeByID)
 	p.v.PUT("/:id", p.handler.EditBookingType)
+	p.v.POST("/:id/restore", p.handler.RestoreBookingTypeByID)
 	p.v.POST("", p.handler.CreateBookingType)
 }
@@ -24,5 +24,10 @@ func (p *BookingRouterImpl) Mount() {
 	p.v.GET("", p.handler.GetBookings)
 	p.v.DELETE("/:id", p.handler.DeleteBookingByID)
 	p.v.PUT("/:id", p.handler.EditBooking)
+	p.v.POST("/:id/restore", p.handler.RestoreBookingByID)
+	p.v.POST("/:id/pickup", p.handler.PickUpBooking)
+	p.v.POST("/:id/return", p.handler.ReturnBooking)
+	p.v.GET("/:id/settlement", p.handler.GetSettlement)
+	p.v.POST("/:id/pay", p.handler.PayBooking)
 	p.v.POST("", p.handler.CreateBooking)
 }
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BranchRouter interface {
+	Mount()
+}
+
+type branchRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.BranchHandler
+}
+
+func NewBranchRouter(v *gin.RouterGroup, handler handler.BranchHandler) BranchRouter {
+	return &branchRouterImpl{v: v, handler: handler}
+}
+
+func (p *branchRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetBranchByID)
+	p.v.GET("", p.handler.GetBranches)
+	p.v.DELETE("/:id", p.handler.DeleteBranchByID)
+	p.v.PUT("/:id", p.handler.EditBranch)
+	p.v.POST("/:id/restore", p.handler.RestoreBranchByID)
+	p.v.POST("", p.handler.CreateBranch)
+}
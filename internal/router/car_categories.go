@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CarCategoryRouter interface {
+	Mount()
+}
+
+type carCategoryRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.CarCategoryHandler
+}
+
+func NewCarCategoryRouter(v *gin.RouterGroup, handler handler.CarCategoryHandler) CarCategoryRouter {
+	return &carCategoryRouterImpl{v: v, handler: handler}
+}
+
+func (p *carCategoryRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetCarCategoryByID)
+	p.v.GET("", p.handler.GetCarCategories)
+	p.v.DELETE("/:id", p.handler.DeleteCarCategoryByID)
+	p.v.PUT("/:id", p.handler.EditCarCategory)
+	p.v.POST("/:id/restore", p.handler.RestoreCarCategoryByID)
+	p.v.POST("", p.handler.CreateCarCategory)
+}
@@ -24,5 +24,6 @@ func (p *carRouterImpl) Mount() {
 	p.v.GET("", p.handler.GetCars)
 	p.v.DELETE("/:id", p.handler.DeleteCarByID)
 	p.v.PUT("/:id", p.handler.EditCar)
+	p.v.POST("/:id/restore", p.handler.RestoreCarByID)
 	p.v.POST("", p.handler.CreateCar)
 }
@@ -0,0 +1,30 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyRouter interface {
+	Mount()
+}
+
+type companyRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.CompanyHandler
+}
+
+func NewCompanyRouter(v *gin.RouterGroup, handler handler.CompanyHandler) CompanyRouter {
+	return &companyRouterImpl{v: v, handler: handler}
+}
+
+func (p *companyRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetCompanyByID)
+	p.v.GET("/:id/statement", p.handler.GetCompanyStatement)
+	p.v.GET("", p.handler.GetCompanies)
+	p.v.DELETE("/:id", p.handler.DeleteCompanyByID)
+	p.v.PUT("/:id", p.handler.EditCompany)
+	p.v.POST("/:id/restore", p.handler.RestoreCompanyByID)
+	p.v.POST("", p.handler.CreateCompany)
+}
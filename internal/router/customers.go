@@ -26,5 +26,8 @@ func (p *CustomerRouterImpl) Mount() {
 	p.v.PUT("/:id", p.handler.EditCustomer)
 	p.v.PUT("/:id/membership", p.handler.AssignMembership)
 	p.v.DELETE("/:id/membership", p.handler.DeleteMembershipByCustomer)
+	p.v.PUT("/:id/risk", p.handler.SetCustomerRisk)
+	p.v.DELETE("/:id/risk", p.handler.ClearCustomerRisk)
+	p.v.POST("/:id/restore", p.handler.RestoreCustomerByID)
 	p.v.POST("", p.handler.CreateCustomer)
 }
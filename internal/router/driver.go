@@ -20,9 +20,12 @@ func NewDriverRouter(v *gin.RouterGroup, handler handler.DriverHandler) DriverRo
 }
 
 func (p *driverRouterImpl) Mount() {
+	p.v.GET("/expiring", p.handler.GetExpiringDocuments)
 	p.v.GET("/:id", p.handler.GetDriverByID)
+	p.v.GET("/:id/stats", p.handler.GetDriverStats)
 	p.v.GET("", p.handler.GetDrivers)
 	p.v.DELETE("/:id", p.handler.DeleteDriverByID)
 	p.v.PUT("/:id", p.handler.EditDriver)
+	p.v.POST("/:id/restore", p.handler.RestoreDriverByID)
 	p.v.POST("", p.handler.CreateDriver)
 }
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverDocumentRouter interface {
+	Mount()
+}
+
+type driverDocumentRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.DriverDocumentHandler
+}
+
+func NewDriverDocumentRouter(v *gin.RouterGroup, handler handler.DriverDocumentHandler) DriverDocumentRouter {
+	return &driverDocumentRouterImpl{v: v, handler: handler}
+}
+
+func (p *driverDocumentRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetDriverDocumentByID)
+	p.v.GET("", p.handler.GetDriverDocuments)
+	p.v.DELETE("/:id", p.handler.DeleteDriverDocumentByID)
+	p.v.PUT("/:id", p.handler.EditDriverDocument)
+	p.v.POST("/:id/restore", p.handler.RestoreDriverDocumentByID)
+	p.v.POST("", p.handler.CreateDriverDocument)
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverLeaveRouter interface {
+	Mount()
+}
+
+type driverLeaveRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.DriverLeaveHandler
+}
+
+func NewDriverLeaveRouter(v *gin.RouterGroup, handler handler.DriverLeaveHandler) DriverLeaveRouter {
+	return &driverLeaveRouterImpl{v: v, handler: handler}
+}
+
+func (p *driverLeaveRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetDriverLeaveByID)
+	p.v.GET("", p.handler.GetDriverLeaves)
+	p.v.DELETE("/:id", p.handler.DeleteDriverLeaveByID)
+	p.v.PUT("/:id", p.handler.EditDriverLeave)
+	p.v.POST("/:id/restore", p.handler.RestoreDriverLeaveByID)
+	p.v.POST("", p.handler.CreateDriverLeave)
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverScheduleRouter interface {
+	Mount()
+}
+
+type driverScheduleRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.DriverScheduleHandler
+}
+
+func NewDriverScheduleRouter(v *gin.RouterGroup, handler handler.DriverScheduleHandler) DriverScheduleRouter {
+	return &driverScheduleRouterImpl{v: v, handler: handler}
+}
+
+func (p *driverScheduleRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetDriverScheduleByID)
+	p.v.GET("", p.handler.GetDriverSchedules)
+	p.v.DELETE("/:id", p.handler.DeleteDriverScheduleByID)
+	p.v.PUT("/:id", p.handler.EditDriverSchedule)
+	p.v.POST("/:id/restore", p.handler.RestoreDriverScheduleByID)
+	p.v.POST("", p.handler.CreateDriverSchedule)
+}
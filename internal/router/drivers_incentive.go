@@ -26,5 +26,6 @@ func (p *driverIncentiveRouterImpl) Mount() {
 	p.v.GET("", p.handler.GetDriversincentive)
 	p.v.DELETE("/:id", p.handler.DeleteDriverIncentiveByID)
 	p.v.PUT("/:id", p.handler.EditDriverIncentive)
+	p.v.POST("/:id/restore", p.handler.RestoreDriverIncentiveByID)
 	p.v.POST("", p.handler.CreateDriverIncentive)
 }
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExtraRouter interface {
+	Mount()
+}
+
+type extraRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.ExtraHandler
+}
+
+func NewExtraRouter(v *gin.RouterGroup, handler handler.ExtraHandler) ExtraRouter {
+	return &extraRouterImpl{v: v, handler: handler}
+}
+
+func (p *extraRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetExtraByID)
+	p.v.GET("", p.handler.GetExtras)
+	p.v.DELETE("/:id", p.handler.DeleteExtraByID)
+	p.v.PUT("/:id", p.handler.EditExtra)
+	p.v.POST("/:id/restore", p.handler.RestoreExtraByID)
+	p.v.POST("", p.handler.CreateExtra)
+}
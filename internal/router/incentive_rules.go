@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IncentiveRuleRouter interface {
+	Mount()
+}
+
+type incentiveRuleRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.IncentiveRuleHandler
+}
+
+func NewIncentiveRuleRouter(v *gin.RouterGroup, handler handler.IncentiveRuleHandler) IncentiveRuleRouter {
+	return &incentiveRuleRouterImpl{v: v, handler: handler}
+}
+
+func (p *incentiveRuleRouterImpl) Mount() {
+	p.v.GET("/current", p.handler.GetCurrentIncentiveRule)
+	p.v.GET("/:id", p.handler.GetIncentiveRuleByID)
+	p.v.GET("", p.handler.GetIncentiveRules)
+	p.v.DELETE("/:id", p.handler.DeleteIncentiveRuleByID)
+	p.v.POST("/:id/restore", p.handler.RestoreIncentiveRuleByID)
+	p.v.POST("", p.handler.CreateIncentiveRule)
+}
@@ -0,0 +1,32 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InspectionRouter interface {
+	Mount()
+}
+
+type inspectionRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.InspectionHandler
+}
+
+func NewInspectionRouter(v *gin.RouterGroup, handler handler.InspectionHandler) InspectionRouter {
+	return &inspectionRouterImpl{v: v, handler: handler}
+}
+
+func (p *inspectionRouterImpl) Mount() {
+	p.v.GET("/compare/:id", p.handler.CompareInspections)
+	p.v.POST("/compare/:id/charge", p.handler.ChargeDamage)
+	p.v.GET("/:id", p.handler.GetInspectionByID)
+	p.v.GET("", p.handler.GetInspections)
+	p.v.DELETE("/:id", p.handler.DeleteInspectionByID)
+	p.v.POST("/:id/restore", p.handler.RestoreInspectionByID)
+	p.v.POST("/:id/images", p.handler.UploadInspectionImage)
+	p.v.GET("/:id/images/:image_id", p.handler.GetInspectionImage)
+	p.v.POST("", p.handler.CreateInspection)
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InsurancePlanRouter interface {
+	Mount()
+}
+
+type insurancePlanRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.InsurancePlanHandler
+}
+
+func NewInsurancePlanRouter(v *gin.RouterGroup, handler handler.InsurancePlanHandler) InsurancePlanRouter {
+	return &insurancePlanRouterImpl{v: v, handler: handler}
+}
+
+func (p *insurancePlanRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetInsurancePlanByID)
+	p.v.GET("", p.handler.GetInsurancePlans)
+	p.v.DELETE("/:id", p.handler.DeleteInsurancePlanByID)
+	p.v.PUT("/:id", p.handler.EditInsurancePlan)
+	p.v.POST("/:id/restore", p.handler.RestoreInsurancePlanByID)
+	p.v.POST("", p.handler.CreateInsurancePlan)
+}
@@ -0,0 +1,30 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MaintenanceRouter interface {
+	Mount()
+}
+
+type maintenanceRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.MaintenanceHandler
+}
+
+func NewMaintenanceRouter(v *gin.RouterGroup, handler handler.MaintenanceHandler) MaintenanceRouter {
+	return &maintenanceRouterImpl{v: v, handler: handler}
+}
+
+func (p *maintenanceRouterImpl) Mount() {
+	p.v.GET("/due", p.handler.GetDueMaintenance)
+	p.v.GET("/:id", p.handler.GetMaintenanceRecordByID)
+	p.v.GET("", p.handler.GetMaintenanceRecords)
+	p.v.DELETE("/:id", p.handler.DeleteMaintenanceRecordByID)
+	p.v.PUT("/:id", p.handler.EditMaintenanceRecord)
+	p.v.POST("/:id/restore", p.handler.RestoreMaintenanceRecordByID)
+	p.v.POST("", p.handler.CreateMaintenanceRecord)
+}
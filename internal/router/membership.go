@@ -24,5 +24,6 @@ func (p *membershipRouterImpl) Mount() {
 	p.v.GET("", p.handler.GetMemberships)
 	p.v.DELETE("/:id", p.handler.DeleteMembershipByID)
 	p.v.PUT("/:id", p.handler.EditMembership)
+	p.v.POST("/:id/restore", p.handler.RestoreMembershipByID)
 	p.v.POST("", p.handler.CreateMembership)
 }
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayoutRouter interface {
+	Mount()
+}
+
+type payoutRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.PayoutHandler
+}
+
+func NewPayoutRouter(v *gin.RouterGroup, handler handler.PayoutHandler) PayoutRouter {
+	return &payoutRouterImpl{v: v, handler: handler}
+}
+
+func (p *payoutRouterImpl) Mount() {
+	p.v.GET("/outstanding", p.handler.GetOutstandingPayouts)
+	p.v.GET("/:id/statement", p.handler.GetPayoutStatement)
+	p.v.GET("/:id", p.handler.GetPayoutPeriodByID)
+	p.v.GET("", p.handler.GetPayoutPeriods)
+	p.v.DELETE("/:id", p.handler.DeletePayoutPeriodByID)
+	p.v.POST("/:id/settle", p.handler.SettlePayoutPeriod)
+	p.v.POST("/:id/restore", p.handler.RestorePayoutPeriodByID)
+	p.v.POST("", p.handler.CreatePayoutPeriod)
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransferRouter interface {
+	Mount()
+}
+
+type transferRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.TransferHandler
+}
+
+func NewTransferRouter(v *gin.RouterGroup, handler handler.TransferHandler) TransferRouter {
+	return &transferRouterImpl{v: v, handler: handler}
+}
+
+func (p *transferRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetTransferByID)
+	p.v.GET("", p.handler.GetTransfers)
+	p.v.POST("/:id/ship", p.handler.ShipTransfer)
+	p.v.POST("/:id/receive", p.handler.ReceiveTransfer)
+	p.v.POST("/:id/cancel", p.handler.CancelTransfer)
+	p.v.POST("", p.handler.RequestTransfer)
+}
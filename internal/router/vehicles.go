@@ -0,0 +1,29 @@
+package router
+
+import (
+	"car-rental/internal/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VehicleRouter interface {
+	Mount()
+}
+
+type vehicleRouterImpl struct {
+	v       *gin.RouterGroup
+	handler handler.VehicleHandler
+}
+
+func NewVehicleRouter(v *gin.RouterGroup, handler handler.VehicleHandler) VehicleRouter {
+	return &vehicleRouterImpl{v: v, handler: handler}
+}
+
+func (p *vehicleRouterImpl) Mount() {
+	p.v.GET("/:id", p.handler.GetVehicleByID)
+	p.v.GET("", p.handler.GetVehicles)
+	p.v.DELETE("/:id", p.handler.DeleteVehicleByID)
+	p.v.PUT("/:id", p.handler.EditVehicle)
+	p.v.POST("/:id/restore", p.handler.RestoreVehicleByID)
+	p.v.POST("", p.handler.CreateVehicle)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"car-rental/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func (s *bookingserviceImpl) GetBookingGroups(ctx context.Context, includeDeleted bool) ([]models.BookingGroup, error) {
+	groups, err := s.groupRepo.GetBookingGroups(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		addGroupTotals(&groups[i])
+	}
+	return groups, nil
+}
+
+func (s *bookingserviceImpl) GetBookingGroupsByID(ctx context.Context, id uint64) (models.BookingGroup, error) {
+	group, err := s.groupRepo.GetBookingGroupsByID(ctx, id)
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+	if group.ID == 0 {
+		return models.BookingGroup{}, apperror.NotFound("booking group")
+	}
+	addGroupTotals(&group)
+	return group, nil
+}
+
+// CreateBookingGroup books every line of a group for its customer, or none of
+// them. Each line is checked and priced like a booking of its own, with the
+// lines before it holding their cars and extras, and gets the discount of
+// the group's size. The group, its bookings and their driver incentives are
+// stored together.
+func (s *bookingserviceImpl) CreateBookingGroup(ctx context.Context, group models.InputBookingGroup) (models.BookingGroup, error) {
+	errs := validation.Collect(group)
+	if !errs.Has("customer_id") {
+		customer, err := s.customerRepo.GetCustomersByID(ctx, uint64(group.CustomerID))
+		if err != nil {
+			return models.BookingGroup{}, err
+		}
+		if customer.ID == 0 {
+			errs.Add("customer_id", "customer not found")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return models.BookingGroup{}, err
+	}
+
+	now := time.Now()
+	terms := groupTerms{discountPercentage: models.GroupDiscountPercentage(len(group.Lines))}
+	for i, line := range group.Lines {
+		priced, err := s.priceBooking(ctx, 0, line.Booking(group), terms)
+		if err != nil {
+			return models.BookingGroup{}, lineError(i, err)
+		}
+		if priced.DriverID != nil {
+			for j, other := range terms.pending {
+				if other.DriverID != nil && *other.DriverID == *priced.DriverID &&
+					!other.StartRent.After(priced.EndRent) && !other.EndRent.Before(priced.StartRent) {
+					return models.BookingGroup{}, apperror.Validation("request is invalid",
+						pkg.FieldError{Field: fmt.Sprintf("lines[%d].driver_id", i), Message: fmt.Sprintf("already drives line %d over that period", j)})
+				}
+			}
+		}
+		priced.CreatedAt = now
+		terms.pending = append(terms.pending, priced)
+	}
+
+	NewGroup := models.BookingGroup{}
+	NewGroup.CustomerID = group.CustomerID
+	NewGroup.Name = group.Name
+	NewGroup.DiscountPercentage = terms.discountPercentage
+	NewGroup.Bookings = terms.pending
+	NewGroup.CreatedAt = now
+
+	incentives := make([]*models.DriverIncentive, len(NewGroup.Bookings))
+	for i, booking := range NewGroup.Bookings {
+		incentive, err := s.newIncentive(ctx, booking)
+		if err != nil {
+			return models.BookingGroup{}, err
+		}
+		incentives[i] = incentive
+	}
+
+	createdGroup, err := s.groupRepo.CreateBookingGroups(ctx, NewGroup, incentives)
+	var lineErr *repository.GroupLineError
+	if errors.As(err, &lineErr) {
+		return models.BookingGroup{}, lineError(lineErr.Line, takenMeanwhile(lineErr.Err, NewGroup.Bookings[lineErr.Line]))
+	}
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+	s.saveRiskFlag(ctx, createdGroup.Customer)
+	addGroupTotals(&createdGroup)
+	return createdGroup, nil
+}
+
+// CancelBookingGroupLine cancels one booking of a group before its car is
+// picked up. The bookings left get the discount of the smaller group.
+func (s *bookingserviceImpl) CancelBookingGroupLine(ctx context.Context, id uint64, bookingID uint64) (models.BookingGroup, error) {
+	group, err := s.GetBookingGroupsByID(ctx, id)
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+
+	var line *models.Booking
+	remaining := []models.Booking{}
+	for i, booking := range group.Bookings {
+		switch {
+		case uint64(booking.ID) == bookingID:
+			line = &group.Bookings[i]
+		case booking.CancelledAt == nil:
+			remaining = append(remaining, booking)
+		}
+	}
+	switch {
+	case line == nil:
+		return models.BookingGroup{}, apperror.NotFound("booking")
+	case line.CancelledAt != nil:
+		return models.BookingGroup{}, apperror.Conflict("booking was already cancelled").WithCode("booking_cancelled")
+	case line.PickedUpAt != nil:
+		return models.BookingGroup{}, apperror.Conflict("booking was already picked up").WithCode("booking_picked_up")
+	case line.Finished:
+		return models.BookingGroup{}, apperror.Conflict("booking is already finished").WithCode("booking_finished")
+	}
+
+	discountPercentage := models.GroupDiscountPercentage(len(remaining))
+	for i, booking := range remaining {
+		depositPercentage := 0
+		if booking.BookingType != nil {
+			depositPercentage = booking.BookingType.DepositPercentage
+		}
+		remaining[i].GroupDiscount = booking.TotalCost * discountPercentage / 100
+		remaining[i].Deposit = depositOf(remaining[i], depositPercentage)
+	}
+
+	cancelledGroup, err := s.groupRepo.CancelBookingGroupLines(ctx, id, bookingID, time.Now(), discountPercentage, remaining)
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+	addGroupTotals(&cancelledGroup)
+	return cancelledGroup, nil
+}
+
+// addGroupTotals adds up the bookings of a group that were not cancelled.
+func addGroupTotals(group *models.BookingGroup) {
+	group.Cars, group.Total, group.Deposit = 0, 0, 0
+	for _, booking := range group.Bookings {
+		if booking.CancelledAt != nil {
+			continue
+		}
+		group.Cars++
+		group.Total += bookedTotal(booking)
+		group.Deposit += booking.Deposit
+	}
+}
+
+// lineError points the problems found with one line of a booking group at
+// that line, e.g. "car_id" becomes "lines[2].car_id".
+func lineError(i int, err error) error {
+	appErr, ok := apperror.As(err)
+	if !ok {
+		return err
+	}
+	var fields []pkg.FieldError
+	for _, field := range appErr.Fields {
+		fields = append(fields, pkg.FieldError{Field: fmt.Sprintf("lines[%d].%s", i, field.Field), Message: field.Message})
+	}
+	message := appErr.Message
+	if len(fields) == 0 {
+		message = fmt.Sprintf("line %d: %s", i, message)
+	}
+	return &apperror.Error{Kind: appErr.Kind, Code: appErr.Code, Message: message, Fields: fields, Err: appErr.Err}
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"fmt"
+	"time"
+)
+
+type BookingRatingservice interface {
+	GetBookingRatings(ctx context.Context, filter models.BookingRatingFilter) ([]models.BookingRating, error)
+	GetBookingRatingsByID(ctx context.Context, id uint64) (models.BookingRating, error)
+	CreateBookingRating(ctx context.Context, rating models.InputBookingRating) (models.BookingRating, error)
+	EditBookingRating(ctx context.Context, id uint64, rating models.InputBookingRating) (models.BookingRating, error)
+	DeleteBookingRating(ctx context.Context, id uint64) (models.BookingRating, error)
+	RestoreBookingRating(ctx context.Context, id uint64) (models.BookingRating, error)
+}
+type bookingRatingserviceImpl struct {
+	ratingRepo  repository.BookingRatingsQuery
+	bookingRepo repository.BookingsQuery
+}
+
+func NewBookingRatingservice(ratingRepo repository.BookingRatingsQuery, bookingRepo repository.BookingsQuery) BookingRatingservice {
+	return &bookingRatingserviceImpl{ratingRepo: ratingRepo, bookingRepo: bookingRepo}
+}
+
+func (s *bookingRatingserviceImpl) GetBookingRatings(ctx context.Context, filter models.BookingRatingFilter) ([]models.BookingRating, error) {
+	ratings, err := s.ratingRepo.GetBookingRatings(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (s *bookingRatingserviceImpl) GetBookingRatingsByID(ctx context.Context, id uint64) (models.BookingRating, error) {
+	rating, err := s.ratingRepo.GetBookingRatingsByID(ctx, id)
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	if rating.ID == 0 {
+		return models.BookingRating{}, apperror.NotFound("booking rating")
+	}
+	return rating, nil
+}
+
+// buildRating validates a rating request against its booking, which must be
+// finished and not cancelled. The driver is rated if and only if the
+// booking had one.
+func (s *bookingRatingserviceImpl) buildRating(ctx context.Context, input models.InputBookingRating) (models.BookingRating, error) {
+	errs := validation.Collect(input)
+	booking := models.Booking{}
+	if !errs.Has("booking_id") {
+		found, err := s.bookingRepo.GetBookingsByID(ctx, uint64(input.BookingID))
+		if err != nil {
+			return models.BookingRating{}, err
+		}
+		switch {
+		case found.ID == 0:
+			errs.Add("booking_id", "booking not found")
+		case found.CancelledAt != nil:
+			errs.Add("booking_id", "booking was cancelled")
+		case !found.Finished:
+			errs.Add("booking_id", "booking is not finished yet")
+		}
+		booking = found
+	}
+	if booking.ID != 0 && !errs.Has("driver_rating") {
+		if booking.DriverID == nil && input.DriverRating != nil {
+			errs.Add("driver_rating", "booking had no driver")
+		}
+		if booking.DriverID != nil && input.DriverRating == nil {
+			errs.Add("driver_rating", "is required when the booking had a driver")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return models.BookingRating{}, err
+	}
+
+	rating := models.BookingRating{}
+	rating.BookingID = booking.ID
+	rating.CustomerID = booking.CustomerID
+	rating.CarID = booking.CarID
+	rating.DriverID = booking.DriverID
+	rating.CarRating = input.CarRating
+	rating.DriverRating = input.DriverRating
+	rating.OverallRating = input.OverallRating
+	rating.Comment = input.Comment
+	return rating, nil
+}
+
+func errRatingExists(bookingID uint) error {
+	return apperror.Conflict(fmt.Sprintf("booking %d has already been rated", bookingID)).
+		WithCode("rating_exists")
+}
+
+// CreateBookingRating records the customer's rating of a finished booking.
+// Each booking is rated at most once.
+func (s *bookingRatingserviceImpl) CreateBookingRating(ctx context.Context, input models.InputBookingRating) (models.BookingRating, error) {
+	NewRating, err := s.buildRating(ctx, input)
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	existing, err := s.ratingRepo.GetBookingRatingByBookingID(ctx, uint64(NewRating.BookingID))
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	if existing.ID != 0 {
+		return models.BookingRating{}, errRatingExists(NewRating.BookingID)
+	}
+	NewRating.CreatedAt = time.Now()
+
+	return s.ratingRepo.CreateBookingRatings(ctx, NewRating)
+}
+
+// EditBookingRating changes the scores or comment of a rating; it stays
+// with the booking it was given for.
+func (s *bookingRatingserviceImpl) EditBookingRating(ctx context.Context, id uint64, input models.InputBookingRating) (models.BookingRating, error) {
+	existing, err := s.GetBookingRatingsByID(ctx, id)
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	if input.BookingID != existing.BookingID {
+		return models.BookingRating{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "booking_id", Message: "cannot be changed"})
+	}
+	updatedRating, err := s.buildRating(ctx, input)
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	updatedRating.UpdatedAt = time.Now()
+
+	return s.ratingRepo.EditBookingRatings(ctx, id, updatedRating)
+}
+
+func (s *bookingRatingserviceImpl) DeleteBookingRating(ctx context.Context, id uint64) (models.BookingRating, error) {
+	rating, err := s.GetBookingRatingsByID(ctx, id)
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	if err := s.ratingRepo.DeleteBookingRatingsByID(ctx, rating); err != nil {
+		return models.BookingRating{}, err
+	}
+	return rating, nil
+}
+
+// RestoreBookingRating brings back a deleted rating unless its booking was
+// rated again in the meantime.
+func (s *bookingRatingserviceImpl) RestoreBookingRating(ctx context.Context, id uint64) (models.BookingRating, error) {
+	deleted, err := s.ratingRepo.GetBookingRatingsByIDWithDeleted(ctx, id)
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	if deleted.ID == 0 {
+		return models.BookingRating{}, apperror.NotFound("booking rating")
+	}
+	current, err := s.ratingRepo.GetBookingRatingByBookingID(ctx, uint64(deleted.BookingID))
+	if err != nil {
+		return models.BookingRating{}, err
+	}
+	if current.ID != 0 && current.ID != deleted.ID {
+		return models.BookingRating{}, errRatingExists(deleted.BookingID)
+	}
+	return s.ratingRepo.RestoreBookingRatingsByID(ctx, deleted)
+}
@@ -3,29 +3,32 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
-	"errors"
 	"time"
 )
 
 type BookingTypeservice interface {
-	GetBookingTypes(ctx context.Context) ([]models.BookingType, error)
+	GetBookingTypes(ctx context.Context, includeDeleted bool) ([]models.BookingType, error)
 	GetBookingTypesByID(ctx context.Context, id uint64) (models.BookingType, error)
 	CreateBookingType(ctx context.Context, bookingType models.InputBookingType) (models.BookingType, error)
 	EditBookingType(ctx context.Context, id uint64, bookingType models.InputBookingType) (models.BookingType, error)
-	DeleteBookingType(ctx context.Context, id uint64) (models.BookingType, error)
+	DeleteBookingType(ctx context.Context, id uint64, reassignTo *uint64) (models.BookingType, error)
+	RestoreBookingType(ctx context.Context, id uint64) (models.BookingType, error)
 }
 type bookingTypeserviceImpl struct {
 	bookingTypeRepo repository.BookingTypesQuery
+	bookingRepo     repository.BookingsQuery
 }
 
-func NewBookingTypeservice(bookingTypeRepo repository.BookingTypesQuery) BookingTypeservice {
-	return &bookingTypeserviceImpl{bookingTypeRepo: bookingTypeRepo}
+func NewBookingTypeservice(bookingTypeRepo repository.BookingTypesQuery, bookingRepo repository.BookingsQuery) BookingTypeservice {
+	return &bookingTypeserviceImpl{bookingTypeRepo: bookingTypeRepo, bookingRepo: bookingRepo}
 }
 
 
-func (s *bookingTypeserviceImpl) GetBookingTypes(ctx context.Context) ([]models.BookingType, error) {
-	bookingTypes, err := s.bookingTypeRepo.GetBookingTypes(ctx)
+func (s *bookingTypeserviceImpl) GetBookingTypes(ctx context.Context, includeDeleted bool) ([]models.BookingType, error) {
+	bookingTypes, err := s.bookingTypeRepo.GetBookingTypes(ctx, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -38,15 +41,27 @@ func (s *bookingTypeserviceImpl) GetBookingTypesByID(ctx context.Context, id uin
 		return models.BookingType{}, err
 	}
 	if bookingType.ID == 0 {
-		return models.BookingType{}, errors.New("bookingType not found")
+		return models.BookingType{}, apperror.NotFound("booking type")
 	}
 	return bookingType, nil
 }
 
 func (s *bookingTypeserviceImpl) CreateBookingType(ctx context.Context, bookingType models.InputBookingType) (models.BookingType, error) {
+	if err := validation.Check(bookingType); err != nil {
+		return models.BookingType{}, err
+	}
 	NewBookingType := models.BookingType{}
 	NewBookingType.BookingType = bookingType.BookingType
 	NewBookingType.Description = bookingType.Description
+	NewBookingType.RequiresDriver = bookingType.RequiresDriver
+	NewBookingType.AllowsDriver = bookingType.DriverAllowed()
+	NewBookingType.MinDays = bookingType.MinDays
+	NewBookingType.MaxDays = bookingType.MaxDays
+	NewBookingType.PriceMultiplier = 1
+	if bookingType.PriceMultiplier != nil {
+		NewBookingType.PriceMultiplier = *bookingType.PriceMultiplier
+	}
+	NewBookingType.DepositPercentage = bookingType.DepositPercentage
 	NewBookingType.CreatedAt = time.Now()
 
 	createdBookingType, err := s.bookingTypeRepo.CreateBookingTypes(ctx, NewBookingType)
@@ -57,25 +72,65 @@ func (s *bookingTypeserviceImpl) CreateBookingType(ctx context.Context, bookingT
 }
 
 func (s *bookingTypeserviceImpl) EditBookingType(ctx context.Context, id uint64, bookingType models.InputBookingType) (models.BookingType, error) {
+	if err := validation.Check(bookingType); err != nil {
+		return models.BookingType{}, err
+	}
 	updatedBookingType := models.BookingType{}
 	updatedBookingType.BookingType = bookingType.BookingType
 	updatedBookingType.Description = bookingType.Description
+	updatedBookingType.RequiresDriver = bookingType.RequiresDriver
+	updatedBookingType.AllowsDriver = bookingType.DriverAllowed()
+	updatedBookingType.MinDays = bookingType.MinDays
+	updatedBookingType.MaxDays = bookingType.MaxDays
+	updatedBookingType.PriceMultiplier = 1
+	if bookingType.PriceMultiplier != nil {
+		updatedBookingType.PriceMultiplier = *bookingType.PriceMultiplier
+	}
+	updatedBookingType.DepositPercentage = bookingType.DepositPercentage
 	updatedBookingType.UpdatedAt = time.Now()
 
 	updatedBookingType, err := s.bookingTypeRepo.EditBookingTypes(ctx, id, updatedBookingType)
 	if err != nil {
 		return models.BookingType{}, err
 	}
+	if updatedBookingType.ID == 0 {
+		return models.BookingType{}, apperror.NotFound("booking type")
+	}
 	return updatedBookingType, nil
 }
 
-func (s *bookingTypeserviceImpl) DeleteBookingType(ctx context.Context, id uint64) (models.BookingType, error) {
+// DeleteBookingType removes a booking type. Bookings of that type block the
+// delete unless reassignTo names another type to move them to first.
+func (s *bookingTypeserviceImpl) DeleteBookingType(ctx context.Context, id uint64, reassignTo *uint64) (models.BookingType, error) {
 	bookingType, err := s.bookingTypeRepo.GetBookingTypesByID(ctx, id)
 	if err != nil {
 		return models.BookingType{}, err
 	}
 	if bookingType.ID == 0 {
-		return models.BookingType{}, nil
+		return models.BookingType{}, apperror.NotFound("booking type")
+	}
+
+	if reassignTo != nil {
+		target, err := s.bookingTypeRepo.GetBookingTypesByID(ctx, *reassignTo)
+		if err != nil {
+			return models.BookingType{}, err
+		}
+		if target.ID == 0 || target.ID == bookingType.ID {
+			return models.BookingType{}, apperror.Validation("reassign target booking type not found")
+		}
+		err = s.bookingTypeRepo.ReassignAndDeleteBookingTypesByID(ctx, id, *reassignTo)
+		if err != nil {
+			return models.BookingType{}, err
+		}
+		return bookingType, nil
+	}
+
+	bookingIDs, err := s.bookingRepo.GetBookingIDsByBookTypeID(ctx, id)
+	if err != nil {
+		return models.BookingType{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.BookingType{}, newDependentsConflict("bookingType", id, "booking", bookingIDs)
 	}
 
 	err = s.bookingTypeRepo.DeleteBookingTypesByID(ctx, id)
@@ -85,3 +140,14 @@ func (s *bookingTypeserviceImpl) DeleteBookingType(ctx context.Context, id uint6
 
 	return bookingType, err
 }
+
+func (s *bookingTypeserviceImpl) RestoreBookingType(ctx context.Context, id uint64) (models.BookingType, error) {
+	bookingType, err := s.bookingTypeRepo.RestoreBookingTypesByID(ctx, id)
+	if err != nil {
+		return models.BookingType{}, err
+	}
+	if bookingType.ID == 0 {
+		return models.BookingType{}, apperror.NotFound("booking type")
+	}
+	return bookingType, nil
+}
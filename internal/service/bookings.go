@@ -3,44 +3,91 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"math"
 	"time"
 )
 
 type Bookingservice interface {
-	GetBookings(ctx context.Context) ([]models.Booking, error)
+	GetBookings(ctx context.Context, includeDeleted bool) ([]models.Booking, error)
 	GetBookingsByID(ctx context.Context, id uint64) (models.Booking, error)
 	CreateBooking(ctx context.Context, booking models.InputBooking) (models.Booking, error)
 	EditBooking(ctx context.Context, id uint64, booking models.InputBooking) (models.Booking, error)
 	DeleteBooking(ctx context.Context, id uint64) (models.Booking, error)
+	RestoreBooking(ctx context.Context, id uint64) (models.Booking, error)
+	PickUpBooking(ctx context.Context, id uint64, pickup models.InputPickup) (models.Booking, error)
+	ReturnBooking(ctx context.Context, id uint64, ret models.InputReturn) (models.Booking, error)
+	GetSettlement(ctx context.Context, id uint64) (models.BookingSettlement, error)
+	PayBooking(ctx context.Context, id uint64) (models.Booking, error)
+	GetBookingGroups(ctx context.Context, includeDeleted bool) ([]models.BookingGroup, error)
+	GetBookingGroupsByID(ctx context.Context, id uint64) (models.BookingGroup, error)
+	CreateBookingGroup(ctx context.Context, group models.InputBookingGroup) (models.BookingGroup, error)
+	CancelBookingGroupLine(ctx context.Context, id uint64, bookingID uint64) (models.BookingGroup, error)
 }
 type bookingserviceImpl struct {
-	bookingRepo repository.BookingsQuery
-	carRepo     repository.CarsQuery
-	customerRepo repository.CustomersQuery
-	driverRepo  repository.DriversQuery
+	bookingRepo         repository.BookingsQuery
+	carRepo             repository.CarsQuery
+	customerRepo        repository.CustomersQuery
+	driverRepo          repository.DriversQuery
 	driverIncentiveRepo repository.DriversIncentiveQuery
-
+	bookingTypeRepo     repository.BookingTypesQuery
+	vehicleRepo         repository.VehiclesQuery
+	branchRepo          repository.BranchesQuery
+	carCategoryRepo     repository.CarCategoriesQuery
+	extraRepo           repository.ExtrasQuery
+	planRepo            repository.InsurancePlansQuery
+	groupRepo           repository.BookingGroupsQuery
+	companyRepo         repository.CompaniesQuery
+	scheduleRepo        repository.DriverSchedulesQuery
+	leaveRepo           repository.DriverLeavesQuery
+	ruleRepo            repository.IncentiveRulesQuery
+	risk                riskFlagger
 }
 
-func NewBookingservice(bookingRepo repository.BookingsQuery, 
-	carRepo repository.CarsQuery, 
+func NewBookingservice(bookingRepo repository.BookingsQuery,
+	carRepo repository.CarsQuery,
 	customerRepo repository.CustomersQuery,
 	driverRepo repository.DriversQuery,
-	driverIncentiveRepo repository.DriversIncentiveQuery) Bookingservice {
-	return &bookingserviceImpl{bookingRepo: bookingRepo, 
-		carRepo: carRepo, 
-		customerRepo: customerRepo,
-		driverRepo: driverRepo,
+	driverIncentiveRepo repository.DriversIncentiveQuery,
+	bookingTypeRepo repository.BookingTypesQuery,
+	vehicleRepo repository.VehiclesQuery,
+	branchRepo repository.BranchesQuery,
+	carCategoryRepo repository.CarCategoriesQuery,
+	extraRepo repository.ExtrasQuery,
+	planRepo repository.InsurancePlansQuery,
+	groupRepo repository.BookingGroupsQuery,
+	companyRepo repository.CompaniesQuery,
+	scheduleRepo repository.DriverSchedulesQuery,
+	leaveRepo repository.DriverLeavesQuery,
+	ruleRepo repository.IncentiveRulesQuery) Bookingservice {
+	return &bookingserviceImpl{bookingRepo: bookingRepo,
+		carRepo:             carRepo,
+		customerRepo:        customerRepo,
+		driverRepo:          driverRepo,
 		driverIncentiveRepo: driverIncentiveRepo,
+		bookingTypeRepo:     bookingTypeRepo,
+		vehicleRepo:         vehicleRepo,
+		branchRepo:          branchRepo,
+		carCategoryRepo:     carCategoryRepo,
+		extraRepo:           extraRepo,
+		planRepo:            planRepo,
+		groupRepo:           groupRepo,
+		companyRepo:         companyRepo,
+		scheduleRepo:        scheduleRepo,
+		leaveRepo:           leaveRepo,
+		ruleRepo:            ruleRepo,
+		risk:                riskFlagger{customerRepo: customerRepo, bookingRepo: bookingRepo, thresholds: riskThresholdsFromEnv()},
 	}
 }
 
-
-func (s *bookingserviceImpl) GetBookings(ctx context.Context) ([]models.Booking, error) {
-	bookings, err := s.bookingRepo.GetBookings(ctx)
+func (s *bookingserviceImpl) GetBookings(ctx context.Context, includeDeleted bool) ([]models.Booking, error) {
+	bookings, err := s.bookingRepo.GetBookings(ctx, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -53,134 +100,597 @@ func (s *bookingserviceImpl) GetBookingsByID(ctx context.Context, id uint64) (mo
 		return models.Booking{}, err
 	}
 	if booking.ID == 0 {
-		return models.Booking{}, errors.New("booking not found")
+		return models.Booking{}, apperror.NotFound("booking")
 	}
 	return booking, nil
 }
 
-func (s *bookingserviceImpl) CreateBooking(ctx context.Context, booking models.InputBooking) (models.Booking, error) {
-	NewBooking := models.Booking{}
-	startRent, err := time.Parse("02/01/2006", booking.StartRent)
+// priceBooking validates a booking request against the current customer,
+// car, driver, branches, extras and booking type, and fills in the period and
+// every cost. The booking type decides whether a driver is needed or allowed,
+// how long the rent may be, the price multiplier and the deposit. Returning
+// the car to another branch costs the one-way fee of the pickup branch.
+// Insurance is billed per day, and cars of a category with a mandatory plan
+// are insured with it unless the customer picks another plan. A booking
+// billed to a company is charged the company's negotiated rent, takes no
+// deposit, falls due after the company's payment terms and must fit in its
+// credit limit. A driver must hold a license valid until the rent ends and
+// be working on every day of it, and the customer must be at least
+// models.MinRenterAge when the rent starts. New bookings are refused for
+// blacklisted customers, and for flagged ones without a manager's approval;
+// customers past the risk thresholds count as flagged, though that is only
+// saved once a booking is stored.
+// All problems are collected and reported together. bookingID is the booking
+// being edited, 0 for a new one, so it does not compete with itself for a
+// vehicle. group holds the terms of a booking group the booking belongs to.
+func (s *bookingserviceImpl) priceBooking(ctx context.Context, bookingID uint64, booking models.InputBooking, group groupTerms) (models.Booking, error) {
+	errs := validation.Collect(booking)
+
+	customer := models.Customer{}
+	if !errs.Has("customer_id") {
+		found, err := s.customerRepo.GetCustomersByID(ctx, uint64(booking.CustomerID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		if found.ID == 0 {
+			errs.Add("customer_id", "customer not found")
+		}
+		customer = found
+	}
+	if customer.ID != 0 && bookingID == 0 {
+		assessed, _, err := s.risk.assess(ctx, customer)
+		if err != nil {
+			return models.Booking{}, err
+		}
+		customer = assessed
+	}
+
+	car := models.Car{}
+	if !errs.Has("car_id") {
+		found, err := s.carRepo.GetCarsByID(ctx, uint64(booking.CarID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		if found.ID == 0 {
+			errs.Add("car_id", "car not found")
+		}
+		car = found
+	}
+
+	bookingType := models.BookingType{}
+	if booking.BookTypeID != nil {
+		found, err := s.bookingTypeRepo.GetBookingTypesByID(ctx, uint64(*booking.BookTypeID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		switch {
+		case found.ID == 0:
+			errs.Add("book_type_id", "booking type not found")
+		case found.RequiresDriver && booking.DriverID == nil:
+			errs.Add("driver_id", "is required for booking type "+found.BookingType)
+		case !found.AllowsDriver && booking.DriverID != nil:
+			errs.Add("driver_id", "is not allowed for booking type "+found.BookingType)
+		}
+		bookingType = found
+	}
+
+	pickupBranch := models.Branch{}
+	if !errs.Has("pickup_branch_id") {
+		found, err := s.branchRepo.GetBranchesByID(ctx, uint64(booking.PickupBranchID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		if found.ID == 0 {
+			errs.Add("pickup_branch_id", "branch not found")
+		}
+		pickupBranch = found
+	}
+	if booking.ReturnBranch() != booking.PickupBranchID {
+		found, err := s.branchRepo.GetBranchesByID(ctx, uint64(booking.ReturnBranchID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		if found.ID == 0 {
+			errs.Add("return_branch_id", "branch not found")
+		}
+	}
+
+	company := models.Company{}
+	if booking.CompanyID != nil {
+		found, err := s.companyRepo.GetCompaniesByID(ctx, uint64(*booking.CompanyID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		if found.ID == 0 {
+			errs.Add("company_id", "company not found")
+		}
+		company = found
+	}
+
+	driver := models.Driver{}
+	if booking.DriverID != nil && !errs.Has("driver_id") {
+		found, err := s.driverRepo.GetDriversByID(ctx, uint64(*booking.DriverID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		switch {
+		case found.ID == 0:
+			errs.Add("driver_id", "driver not found")
+		case pickupBranch.ID != 0 && found.BranchID != pickupBranch.ID:
+			errs.Add("driver_id", "works at another branch than "+pickupBranch.Name)
+		}
+		driver = found
+	}
+
+	extras := make([]models.Extra, len(booking.Extras))
+	for i, item := range booking.Extras {
+		field := fmt.Sprintf("extras[%d].extra_id", i)
+		if errs.Has(field) {
+			continue
+		}
+		found, err := s.extraRepo.GetExtrasByID(ctx, uint64(item.ExtraID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		if found.ID == 0 {
+			errs.Add(field, "extra not found")
+		}
+		extras[i] = found
+	}
+
+	plan, err := s.insurancePlan(ctx, booking, car, errs)
 	if err != nil {
 		return models.Booking{}, err
 	}
-	endRent, err := time.Parse("02/01/2006", booking.EndRent)
-	if err != nil {
+
+	if err := errs.Err(); err != nil {
 		return models.Booking{}, err
 	}
-	daysOfRent := int(endRent.Sub(startRent).Hours() / 24) + 1
-	if daysOfRent <= 0 {
-		return models.Booking{}, fmt.Errorf("EndRent must be after StartRent")
+	if bookingID == 0 {
+		if err := checkCustomerRisk(customer, booking.RiskOverride); err != nil {
+			return models.Booking{}, err
+		}
 	}
 
-	car, err := s.carRepo.GetCarsByID(ctx, uint64(booking.CarID))
-	if err != nil {
+	startRent, _ := time.Parse(models.DateLayout, booking.StartRent)
+	endRent, _ := time.Parse(models.DateLayout, booking.EndRent)
+	daysOfRent := rentDays(startRent, endRent)
+	if bookingType.ID != 0 {
+		if daysOfRent < bookingType.MinDays {
+			errs.Add("end_rent", fmt.Sprintf("booking type %s needs at least %d days", bookingType.BookingType, bookingType.MinDays))
+		}
+		if bookingType.MaxDays > 0 && daysOfRent > bookingType.MaxDays {
+			errs.Add("end_rent", fmt.Sprintf("booking type %s allows at most %d days", bookingType.BookingType, bookingType.MaxDays))
+		}
+	}
+	if driver.ID != 0 && !booking.Finished {
+		if err := s.checkDriverAvailability(ctx, driver, startRent, endRent, errs); err != nil {
+			return models.Booking{}, err
+		}
+	}
+	if !booking.Finished {
+		checkRenterAge(customer, startRent, errs)
+	}
+	if err := errs.Err(); err != nil {
 		return models.Booking{}, err
 	}
-	
-	totalCost := daysOfRent * car.DailyRent
 
-	NewBooking.CustomerID = booking.CustomerID
-	NewBooking.CarID = booking.CarID
-	NewBooking.BookTypeID = booking.BookTypeID
-	NewBooking.DriverID = booking.DriverID
-	NewBooking.StartRent = startRent
-	NewBooking.EndRent = endRent
-	NewBooking.TotalCost = totalCost
-	NewBooking.Finished = booking.Finished
-	NewBooking.CreatedAt = time.Now()
+	if !booking.Finished {
+		if err := s.checkAvailability(ctx, bookingID, car, pickupBranch, startRent, endRent, group.pending); err != nil {
+			return models.Booking{}, err
+		}
+		if err := s.checkExtras(ctx, bookingID, booking.Extras, extras, startRent, endRent, group.pending); err != nil {
+			return models.Booking{}, err
+		}
+	}
 
-	customer, err := s.customerRepo.GetCustomersByID(ctx, uint64(booking.CustomerID))
-	if err != nil {
-		return models.Booking{}, err
+	dailyRent := car.DailyRent
+	if company.ID != 0 {
+		dailyRent = company.DailyRent(car)
 	}
-	if customer.MembershipID != nil {
+	totalCost := daysOfRent * dailyRent
+	if bookingType.ID != 0 {
+		totalCost = int(math.Round(float64(totalCost) * bookingType.PriceMultiplier))
+	}
+
+	priced := models.Booking{}
+	priced.CustomerID = booking.CustomerID
+	priced.CarID = booking.CarID
+	priced.BookTypeID = booking.BookTypeID
+	priced.DriverID = booking.DriverID
+	priced.PickupBranchID = booking.PickupBranchID
+	priced.ReturnBranchID = booking.ReturnBranch()
+	priced.StartRent = startRent
+	priced.EndRent = endRent
+	priced.TotalCost = totalCost
+	priced.Finished = booking.Finished
+	if bookingID == 0 && customer.RiskOn(time.Now()) == models.RiskFlagged {
+		priced.RiskOverrideBy = booking.RiskOverride.ApprovedBy
+		priced.RiskOverrideReason = booking.RiskOverride.Reason
+	}
+
+	if customer.MembershipID != nil && customer.Membership != nil {
 		membershipDiscount := customer.Membership.Discount
-		discount := totalCost * membershipDiscount/100
-		NewBooking.Discount = discount
+		discount := totalCost * membershipDiscount / 100
+		priced.Discount = discount
 	}
-	if booking.BookTypeID != nil && *booking.BookTypeID == 2 {
-		driver, err := s.driverRepo.GetDriversByID(ctx, uint64(*booking.DriverID))
-		if err != nil {
-			return models.Booking{}, err
-		}
+	priced.GroupDiscount = totalCost * group.discountPercentage / 100
+	if booking.DriverID != nil {
 		driverCost := driver.DailyCost
 		totalDriverCost := daysOfRent * driverCost
-		NewBooking.TotalDriverCost = totalDriverCost
+		priced.TotalDriverCost = totalDriverCost
+	}
+	if priced.ReturnBranchID != priced.PickupBranchID {
+		priced.OneWayFee = pickupBranch.OneWayFee
+	}
+	priced.Extras = []models.BookingExtra{}
+	for i, item := range booking.Extras {
+		line := models.BookingExtra{}
+		line.ExtraID = item.ExtraID
+		line.Quantity = item.Quantity
+		line.Pricing = extras[i].Pricing
+		line.UnitPrice = extras[i].Price
+		line.Total = line.UnitPrice * line.Quantity
+		if line.Pricing == models.ExtraPricingPerDay {
+			line.Total *= daysOfRent
+		}
+		priced.ExtrasCost += line.Total
+		priced.Extras = append(priced.Extras, line)
+	}
+	if plan.ID != 0 {
+		priced.InsurancePlanID = &plan.ID
+		priced.InsurancePremium = daysOfRent * plan.DailyPremium
+		priced.InsuranceExcess = plan.Excess
+	}
+	priced.Deposit = depositOf(priced, bookingType.DepositPercentage)
+	if company.ID != 0 {
+		dueAt := endRent.AddDate(0, 0, company.PaymentTermsDays)
+		priced.CompanyID = &company.ID
+		priced.Deposit = 0
+		priced.PaymentDueAt = &dueAt
+		if err := s.checkCredit(ctx, bookingID, company, priced, group.pending); err != nil {
+			return models.Booking{}, err
+		}
+	}
+	return priced, nil
+}
+
+// checkCredit makes sure what a company already owes, the pending group
+// lines and the booking together stay within its credit limit.
+func (s *bookingserviceImpl) checkCredit(ctx context.Context, bookingID uint64, company models.Company, booking models.Booking, pending []models.Booking) error {
+	owed, err := s.bookingRepo.SumUnpaidByCompanyID(ctx, uint64(company.ID), bookingID)
+	if err != nil {
+		return err
+	}
+	owed += int64(bookedTotal(booking))
+	for _, line := range pending {
+		owed += int64(bookedTotal(line))
+	}
+	if owed > int64(company.CreditLimit) {
+		return apperror.Conflict(fmt.Sprintf("booking would bring %s to %d, over its credit limit of %d",
+			company.Name, owed, company.CreditLimit)).
+			WithCode("credit_limit_exceeded")
+	}
+	return nil
+}
+
+// groupTerms are what a line of a booking group is priced with: the group
+// discount, and the lines priced before it, which are not stored yet but
+// need their cars and extras all the same.
+type groupTerms struct {
+	discountPercentage int
+	pending            []models.Booking
+}
+
+// pendingOverlapping lists the pending lines that need a car at some point
+// between start and end.
+func pendingOverlapping(pending []models.Booking, start, end time.Time) []models.Booking {
+	overlapping := []models.Booking{}
+	for _, line := range pending {
+		if !line.StartRent.After(end) && !line.EndRent.Before(start) {
+			overlapping = append(overlapping, line)
+		}
 	}
+	return overlapping
+}
 
-	createdBooking, err := s.bookingRepo.CreateBookings(ctx, NewBooking)
-	if err == nil {
-		incentiveDriver := (daysOfRent * car.DailyRent)*5/100
-		newIncentive := models.DriverIncentive{}
-		newIncentive.Incentive = incentiveDriver
-		newIncentive.BookingID = &createdBooking.ID
+// depositOf is the deposit percentage of what a booking costs upfront.
+func depositOf(booking models.Booking, percentage int) int {
+	return (booking.TotalCost - booking.Discount - booking.GroupDiscount + booking.TotalDriverCost +
+		booking.ExtrasCost + booking.InsurancePremium) * percentage / 100
+}
 
-		_, err = s.driverIncentiveRepo.CreateDriversIncentive(ctx, newIncentive)
+// bookedTotal is what a booking costs before the charges of its return.
+func bookedTotal(booking models.Booking) int {
+	return booking.TotalCost - booking.Discount - booking.GroupDiscount + booking.TotalDriverCost +
+		booking.OneWayFee + booking.ExtrasCost + booking.InsurancePremium
+}
+
+// chargedTotal is everything a booking is charged, return charges included.
+func chargedTotal(booking models.Booking) int {
+	return bookedTotal(booking) + booking.DamageCharge + booking.FuelCharge + booking.MileageCharge
+}
+
+// checkDriverAvailability adds a field error when the driver's license runs
+// out before endRent, or when the driver is on leave or has no shift on any
+// day from startRent to endRent. A driver without any schedule works every
+// day.
+func (s *bookingserviceImpl) checkDriverAvailability(ctx context.Context, driver models.Driver, startRent, endRent time.Time, errs *validation.Errors) error {
+	if driver.LicenseExpiresBefore(endRent) {
+		errs.Add("driver_id", "driver's license expires on "+driver.LicenseExpiry.Format(models.DateLayout)+", before the rent ends")
+		return nil
+	}
+
+	leaves, err := s.leaveRepo.GetOverlappingDriverLeaves(ctx, uint64(driver.ID), startRent, endRent)
+	if err != nil {
+		return err
+	}
+	if len(leaves) > 0 {
+		errs.Add("driver_id", fmt.Sprintf("driver is on leave from %s to %s",
+			leaves[0].StartDate.Format(models.DateLayout), leaves[0].EndDate.Format(models.DateLayout)))
+		return nil
+	}
+
+	schedules, err := s.scheduleRepo.GetDriverSchedulesByDriverID(ctx, uint64(driver.ID))
+	if err != nil {
+		return err
+	}
+	if len(schedules) == 0 {
+		return nil
+	}
+	working := map[time.Weekday]bool{}
+	for _, schedule := range schedules {
+		working[time.Weekday(schedule.Weekday)] = true
+	}
+	for day := startRent; !day.After(endRent); day = day.AddDate(0, 0, 1) {
+		if !working[day.Weekday()] {
+			errs.Add("driver_id", fmt.Sprintf("driver has no shift on %s %s", day.Weekday(), day.Format(models.DateLayout)))
+			return nil
+		}
+	}
+	return nil
+}
+
+// checkCustomerRisk keeps blacklisted customers from booking, and flagged
+// ones unless a manager approved the booking.
+func checkCustomerRisk(customer models.Customer, override *models.InputRiskOverride) error {
+	switch customer.RiskOn(time.Now()) {
+	case models.RiskBlacklisted:
+		return apperror.Conflict(fmt.Sprintf("customer %d is blacklisted: %s", customer.ID, customer.RiskReason)).
+			WithCode("customer_blacklisted")
+	case models.RiskFlagged:
+		if override == nil {
+			return apperror.Conflict(fmt.Sprintf("customer %d is flagged: %s; a manager has to approve the booking through risk_override", customer.ID, customer.RiskReason)).
+				WithCode("customer_flagged")
+		}
+	}
+	return nil
+}
+
+// checkRenterAge adds a field error when the customer is younger than
+// models.MinRenterAge on startRent. Customers saved before NIKs were checked
+// may have one that gives no birth date; their age is not checked.
+func checkRenterAge(customer models.Customer, startRent time.Time, errs *validation.Errors) {
+	age, ok := customer.AgeOn(startRent)
+	if ok && age < models.MinRenterAge {
+		errs.Add("customer_id", fmt.Sprintf("customer must be at least %d years old when the rent starts", models.MinRenterAge))
+	}
+}
+
+// insurancePlan finds the plan a booking is insured with: the one asked for,
+// or else the mandatory plan of the car's category. It returns a zero plan
+// for an uninsured booking and adds a field error for an unknown plan.
+func (s *bookingserviceImpl) insurancePlan(ctx context.Context, booking models.InputBooking, car models.Car, errs *validation.Errors) (models.InsurancePlan, error) {
+	if booking.InsurancePlanID != nil {
+		plan, err := s.planRepo.GetInsurancePlansByID(ctx, uint64(*booking.InsurancePlanID))
 		if err != nil {
-			return models.Booking{}, err
+			return models.InsurancePlan{}, err
+		}
+		if plan.ID == 0 {
+			errs.Add("insurance_plan_id", "insurance plan not found")
+		}
+		return plan, nil
+	}
+	if car.CategoryID == nil {
+		return models.InsurancePlan{}, nil
+	}
+	category, err := s.carCategoryRepo.GetCarCategoriesByID(ctx, uint64(*car.CategoryID))
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	if category.InsurancePlan == nil {
+		return models.InsurancePlan{}, nil
+	}
+	return *category.InsurancePlan, nil
+}
+
+// checkExtras makes sure enough of every extra asked for is left over the
+// whole period once the other unfinished bookings and pending group lines
+// have theirs.
+func (s *bookingserviceImpl) checkExtras(ctx context.Context, bookingID uint64, items []models.InputBookingExtra, extras []models.Extra, startRent, endRent time.Time, pending []models.Booking) error {
+	overlapping := pendingOverlapping(pending, startRent, endRent)
+	for i, item := range items {
+		inUse, err := s.extraRepo.CountExtrasInUse(ctx, uint64(item.ExtraID), startRent, endRent, bookingID)
+		if err != nil {
+			return err
+		}
+		for _, line := range overlapping {
+			for _, extra := range line.Extras {
+				if extra.ExtraID == item.ExtraID {
+					inUse += int64(extra.Quantity)
+				}
+			}
+		}
+		if left := int64(extras[i].Stock) - inUse; int64(item.Quantity) > left {
+			if left < 0 {
+				left = 0
+			}
+			return apperror.Unavailable(fmt.Sprintf("only %d %s left from %s to %s",
+				left, extras[i].Name, startRent.Format(models.DateLayout), endRent.Format(models.DateLayout))).
+				WithCode("extra_unavailable")
 		}
 	}
+	return nil
+}
 
+// checkAvailability makes sure at least one active vehicle of car at the
+// pickup branch, outside the workshop and not in transit, is left over the
+// whole period once the other unfinished bookings and pending group lines
+// from that branch are served.
+func (s *bookingserviceImpl) checkAvailability(ctx context.Context, bookingID uint64, car models.Car, branch models.Branch, startRent, endRent time.Time, pending []models.Booking) error {
+	units, err := s.vehicleRepo.CountServiceableVehiclesByCarID(ctx, uint64(car.ID), uint64(branch.ID), startRent, endRent)
 	if err != nil {
-		return models.Booking{}, err
+		return err
 	}
-	return createdBooking, nil
+	booked, err := s.bookingRepo.CountOverlappingBookingsByCarID(ctx, uint64(car.ID), uint64(branch.ID), startRent, endRent, bookingID)
+	if err != nil {
+		return err
+	}
+	for _, line := range pendingOverlapping(pending, startRent, endRent) {
+		if line.CarID == car.ID && line.PickupBranchID == branch.ID {
+			booked++
+		}
+	}
+	if booked >= units {
+		return apperror.Unavailable(fmt.Sprintf("no %s is available at %s from %s to %s",
+			car.Name, branch.Name, startRent.Format(models.DateLayout), endRent.Format(models.DateLayout))).
+			WithCode("car_unavailable")
+	}
+	return nil
 }
 
-func (s *bookingserviceImpl) EditBooking(ctx context.Context, id uint64, booking models.InputBooking) (models.Booking, error) {
-	updatedBooking := models.Booking{}
-	startRent, err := time.Parse("02/01/2006", booking.StartRent)
+// takenMeanwhile turns repository.ErrNoUnitLeft, ErrNoExtraLeft and
+// ErrCreditExceeded, met when another booking took the last unit of the car,
+// the last of an extra or the credit left to the company while booking was
+// being priced, into the errors checkAvailability, checkExtras and
+// checkCredit give, and passes any other error through.
+func takenMeanwhile(err error, booking models.Booking) error {
+	switch {
+	case errors.Is(err, repository.ErrNoUnitLeft):
+		return apperror.Unavailable(fmt.Sprintf("no unit of car %d is left at branch %d from %s to %s",
+			booking.CarID, booking.PickupBranchID, booking.StartRent.Format(models.DateLayout), booking.EndRent.Format(models.DateLayout))).
+			WithCode("car_unavailable")
+	case errors.Is(err, repository.ErrNoExtraLeft):
+		return apperror.Unavailable(fmt.Sprintf("not enough of the extras asked for is left from %s to %s",
+			booking.StartRent.Format(models.DateLayout), booking.EndRent.Format(models.DateLayout))).
+			WithCode("extra_unavailable")
+	case errors.Is(err, repository.ErrCreditExceeded):
+		return apperror.Conflict(fmt.Sprintf("booking would bring company %d over its credit limit", *booking.CompanyID)).
+			WithCode("credit_limit_exceeded")
+	}
+	return err
+}
+
+func (s *bookingserviceImpl) CreateBooking(ctx context.Context, booking models.InputBooking) (models.Booking, error) {
+	NewBooking, err := s.priceBooking(ctx, 0, booking, groupTerms{})
 	if err != nil {
 		return models.Booking{}, err
 	}
-	endRent, err := time.Parse("02/01/2006", booking.EndRent)
+	NewBooking.CreatedAt = time.Now()
+
+	incentive, err := s.newIncentive(ctx, NewBooking)
 	if err != nil {
 		return models.Booking{}, err
 	}
 
-	daysOfRent := int(endRent.Sub(startRent).Hours() / 24) + 1
-	if daysOfRent <= 0 {
-		return models.Booking{}, fmt.Errorf("EndRent must be after StartRent")
+	createdBooking, err := s.bookingRepo.CreateBookings(ctx, NewBooking, incentive)
+	if err != nil {
+		return models.Booking{}, takenMeanwhile(err, NewBooking)
 	}
+	s.saveRiskFlag(ctx, createdBooking.Customer)
+	return createdBooking, nil
+}
+
+// saveRiskFlag saves the flag of a customer who reached a risk threshold,
+// once what they asked for was stored. A failure is only logged, since the
+// request itself succeeded; the customer is assessed again next time.
+func (s *bookingserviceImpl) saveRiskFlag(ctx context.Context, customer models.Customer) {
+	if err := s.risk.autoFlag(ctx, customer); err != nil {
+		log.Printf("flagging customer %d: %v", customer.ID, err)
+	}
+}
 
-	car, err := s.carRepo.GetCarsByID(ctx, uint64(booking.CarID))
+// newIncentive works out what the driver of a booking earns under the
+// incentive rule in force, recording its version, for the repository to
+// store along with the booking. Bookings without a driver, or made while no
+// rule is in force, earn nothing and get nil.
+func (s *bookingserviceImpl) newIncentive(ctx context.Context, booking models.Booking) (*models.DriverIncentive, error) {
+	if booking.DriverID == nil {
+		return nil, nil
+	}
+	rule, err := s.ruleRepo.GetCurrentIncentiveRule(ctx)
 	if err != nil {
-		return models.Booking{}, err
+		return nil, err
+	}
+	if rule.ID == 0 {
+		return nil, nil
+	}
+	driver, err := s.driverRepo.GetDriversByID(ctx, uint64(*booking.DriverID))
+	if err != nil {
+		return nil, err
 	}
-	
-	totalCost := daysOfRent * car.DailyRent
-	updatedBooking.TotalCost = totalCost
-	updatedBooking.CarID = booking.CarID
-	updatedBooking.StartRent = startRent
-	updatedBooking.EndRent = endRent
-	updatedBooking.Finished = booking.Finished
-	updatedBooking.UpdatedAt = time.Now()
 
-	customer, err := s.customerRepo.GetCustomersByID(ctx, uint64(booking.CustomerID))
+	incentive := models.DriverIncentive{}
+	incentive.Incentive = rule.Incentive(booking, driver)
+	incentive.RuleVersion = &rule.Version
+	return &incentive, nil
+}
+
+// EditBooking reprices a booking with its new terms and recomputes what its
+// driver earns under the incentive rule now in force.
+func (s *bookingserviceImpl) EditBooking(ctx context.Context, id uint64, booking models.InputBooking) (models.Booking, error) {
+	existing, err := s.bookingRepo.GetBookingsByID(ctx, id)
 	if err != nil {
 		return models.Booking{}, err
 	}
-	if customer.MembershipID != nil {
-		membershipDiscount := customer.Membership.Discount
-		discount := totalCost * membershipDiscount/100
-		updatedBooking.Discount = discount
+	if existing.ID == 0 {
+		return models.Booking{}, apperror.NotFound("booking")
 	}
-	if booking.BookTypeID != nil && *booking.BookTypeID == 2 {
-		driver, err := s.driverRepo.GetDriversByID(ctx, uint64(*booking.DriverID))
+
+	switch {
+	case existing.CancelledAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was cancelled").WithCode("booking_cancelled")
+	case existing.PaidAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was already paid").WithCode("booking_paid")
+	case existing.DriverPayoutPeriodID != nil:
+		return models.Booking{}, apperror.Conflict(fmt.Sprintf("booking's driver was already paid in payout period %d", *existing.DriverPayoutPeriodID)).
+			WithCode("booking_driver_paid")
+	}
+	group := groupTerms{}
+	if existing.BookingGroupID != nil {
+		found, err := s.groupRepo.GetBookingGroupsByID(ctx, uint64(*existing.BookingGroupID))
 		if err != nil {
 			return models.Booking{}, err
 		}
-		driverCost := driver.DailyCost
-		totalDriverCost := daysOfRent * driverCost
-		updatedBooking.TotalDriverCost = totalDriverCost
+		group.discountPercentage = found.DiscountPercentage
+	}
+	if existing.VehicleID != nil && booking.CarID != existing.CarID {
+		return models.Booking{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "car_id", Message: "cannot change once the car was picked up"})
+	}
+	if existing.VehicleID != nil && booking.PickupBranchID != existing.PickupBranchID {
+		return models.Booking{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "pickup_branch_id", Message: "cannot change once the car was picked up"})
 	}
 
-	updatedBooking, err = s.bookingRepo.EditBookings(ctx, id, updatedBooking)
+	updatedBooking, err := s.priceBooking(ctx, id, booking, group)
 	if err != nil {
 		return models.Booking{}, err
 	}
-	return updatedBooking, nil
+	updatedBooking.UpdatedAt = time.Now()
+	if updatedBooking.CompanyID == nil {
+		updatedBooking.PaymentDueAt = existing.PaymentDueAt
+	}
+	incentive, err := s.newIncentive(ctx, updatedBooking)
+	if err != nil {
+		return models.Booking{}, err
+	}
+
+	repricedBooking, err := s.bookingRepo.RepriceBookings(ctx, id, updatedBooking, incentive)
+	if err != nil {
+		return models.Booking{}, takenMeanwhile(err, updatedBooking)
+	}
+	return repricedBooking, nil
 }
 
 func (s *bookingserviceImpl) DeleteBooking(ctx context.Context, id uint64) (models.Booking, error) {
@@ -189,7 +699,15 @@ func (s *bookingserviceImpl) DeleteBooking(ctx context.Context, id uint64) (mode
 		return models.Booking{}, err
 	}
 	if booking.ID == 0 {
-		return models.Booking{}, nil
+		return models.Booking{}, apperror.NotFound("booking")
+	}
+
+	incentiveIDs, err := s.driverIncentiveRepo.GetPaidDriversIncentiveIDsByBookingID(ctx, id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if len(incentiveIDs) > 0 {
+		return models.Booking{}, newDependentsConflict("booking", id, "paid driver incentive", incentiveIDs)
 	}
 
 	err = s.bookingRepo.DeleteBookingsByID(ctx, id)
@@ -199,3 +717,229 @@ func (s *bookingserviceImpl) DeleteBooking(ctx context.Context, id uint64) (mode
 
 	return booking, err
 }
+
+func (s *bookingserviceImpl) RestoreBooking(ctx context.Context, id uint64) (models.Booking, error) {
+	booking, err := s.bookingRepo.RestoreBookingsByID(ctx, id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if booking.ID == 0 {
+		return models.Booking{}, apperror.NotFound("booking")
+	}
+	return booking, nil
+}
+
+// PickUpBooking hands a vehicle of the booked car at the pickup branch to the
+// customer and notes its odometer reading and fuel level.
+func (s *bookingserviceImpl) PickUpBooking(ctx context.Context, id uint64, pickup models.InputPickup) (models.Booking, error) {
+	if err := validation.Check(pickup); err != nil {
+		return models.Booking{}, err
+	}
+	booking, err := s.GetBookingsByID(ctx, id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	switch {
+	case booking.CancelledAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was cancelled").WithCode("booking_cancelled")
+	case booking.Finished:
+		return models.Booking{}, apperror.Conflict("booking is already finished").WithCode("booking_finished")
+	case booking.PickedUpAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was already picked up").WithCode("booking_picked_up")
+	}
+
+	if pickup.VehicleID != nil {
+		found, err := s.vehicleRepo.GetVehiclesByID(ctx, uint64(*pickup.VehicleID))
+		if err != nil {
+			return models.Booking{}, err
+		}
+		message := ""
+		switch {
+		case found.ID == 0:
+			message = "vehicle not found"
+		case found.CarID != booking.CarID:
+			message = "is not a " + booking.Car.Name
+		case found.BranchID != booking.PickupBranchID:
+			message = "vehicle is at another branch than " + booking.PickupBranch.Name
+		case found.Status != models.VehicleStatusActive:
+			message = "vehicle is " + found.Status
+		}
+		if message != "" {
+			return models.Booking{}, apperror.Validation("request is invalid",
+				pkg.FieldError{Field: "vehicle_id", Message: message})
+		}
+	}
+
+	now := time.Now()
+	fuelLevel := 100
+	if pickup.FuelLevel != nil {
+		fuelLevel = *pickup.FuelLevel
+	}
+	pickedUp := models.Booking{}
+	pickedUp.PickedUpAt = &now
+	pickedUp.PickupFuelLevel = &fuelLevel
+	pickedUp.UpdatedAt = now
+
+	pickedUpBooking, err := s.bookingRepo.PickUpBookings(ctx, id, pickup.VehicleID, pickedUp)
+	switch {
+	case errors.Is(err, repository.ErrPickedUp):
+		return models.Booking{}, apperror.Conflict("booking was already picked up").WithCode("booking_picked_up")
+	case errors.Is(err, repository.ErrNoUnitLeft):
+		return models.Booking{}, apperror.Unavailable("no " + booking.Car.Name + " is free for pickup at " + booking.PickupBranch.Name).
+			WithCode("car_unavailable")
+	case errors.Is(err, repository.ErrVehicleNotFree):
+		return models.Booking{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "vehicle_id", Message: "vehicle is out on another booking or in maintenance"})
+	case err != nil:
+		return models.Booking{}, err
+	}
+	return pickedUpBooking, nil
+}
+
+// ReturnBooking takes the vehicle back, records its odometer reading and fuel
+// level, bills fuel and excess kilometres and finishes the booking. A late
+// return may get the customer flagged.
+func (s *bookingserviceImpl) ReturnBooking(ctx context.Context, id uint64, ret models.InputReturn) (models.Booking, error) {
+	if err := validation.Check(ret); err != nil {
+		return models.Booking{}, err
+	}
+	booking, err := s.GetBookingsByID(ctx, id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	switch {
+	case booking.PickedUpAt == nil:
+		return models.Booking{}, apperror.Conflict("booking has not been picked up").WithCode("booking_not_picked_up")
+	case booking.ReturnedAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was already returned").WithCode("booking_returned")
+	case booking.PickupOdometer != nil && ret.Odometer < *booking.PickupOdometer:
+		return models.Booking{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "odometer", Message: fmt.Sprintf("must be at least the pickup reading of %d", *booking.PickupOdometer)})
+	}
+
+	returnedAt := time.Now()
+	returned := models.Booking{}
+	returned.ReturnedAt = &returnedAt
+	returned.ReturnOdometer = &ret.Odometer
+	returned.ReturnFuelLevel = ret.FuelLevel
+	if booking.CompanyID == nil {
+		dueAt := returnedAt.AddDate(0, 0, models.ReturnPaymentDays)
+		returned.PaymentDueAt = &dueAt
+	}
+	if err := s.chargeFuelAndMileage(ctx, booking, &returned); err != nil {
+		return models.Booking{}, err
+	}
+	returnedBooking, err := s.bookingRepo.ReturnBookings(ctx, id, returned)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	s.saveRiskFlag(ctx, returnedBooking.Customer)
+	return returnedBooking, nil
+}
+
+// chargeFuelAndMileage fills in the fuel and mileage charges of a return
+// under the terms of the car's category. Cars without a category are not
+// charged.
+func (s *bookingserviceImpl) chargeFuelAndMileage(ctx context.Context, booking models.Booking, returned *models.Booking) error {
+	if booking.Car.CategoryID == nil {
+		return nil
+	}
+	category, err := s.carCategoryRepo.GetCarCategoriesByID(ctx, uint64(*booking.Car.CategoryID))
+	if err != nil {
+		return err
+	}
+	if category.ID == 0 {
+		return nil
+	}
+
+	returned.FuelPolicy = category.FuelPolicy
+	switch category.FuelPolicy {
+	case models.FuelPolicyPrepaid:
+		returned.FuelCharge = category.PrepaidFuelPrice
+	default:
+		pickupFuel := 100
+		if booking.PickupFuelLevel != nil {
+			pickupFuel = *booking.PickupFuelLevel
+		}
+		if missing := pickupFuel - *returned.ReturnFuelLevel; missing > 0 {
+			returned.FuelCharge = missing * category.RefuelRate
+		}
+	}
+
+	if category.DailyKmAllowance > 0 && booking.PickupOdometer != nil {
+		returned.KmAllowance = rentDays(booking.StartRent, booking.EndRent) * category.DailyKmAllowance
+		driven := *returned.ReturnOdometer - *booking.PickupOdometer
+		if excess := driven - returned.KmAllowance; excess > 0 {
+			returned.ExcessKm = excess
+			returned.MileageCharge = excess * category.ExcessKmRate
+		}
+	}
+	return nil
+}
+
+// GetSettlement adds up what the customer owes for a booking, extras and
+// insurance included, and what is left to pay after the deposit.
+func (s *bookingserviceImpl) GetSettlement(ctx context.Context, id uint64) (models.BookingSettlement, error) {
+	booking, err := s.GetBookingsByID(ctx, id)
+	if err != nil {
+		return models.BookingSettlement{}, err
+	}
+
+	settlement := models.BookingSettlement{}
+	settlement.BookingID = booking.ID
+	settlement.Rent = booking.TotalCost
+	settlement.Discount = booking.Discount
+	settlement.GroupDiscount = booking.GroupDiscount
+	settlement.DriverCost = booking.TotalDriverCost
+	settlement.OneWayFee = booking.OneWayFee
+	settlement.Extras = booking.ExtrasCost
+	settlement.Insurance = booking.InsurancePremium
+	settlement.DamageCharge = booking.DamageCharge
+	settlement.FuelPolicy = booking.FuelPolicy
+	settlement.FuelCharge = booking.FuelCharge
+	settlement.KmAllowance = booking.KmAllowance
+	settlement.ExcessKm = booking.ExcessKm
+	settlement.MileageCharge = booking.MileageCharge
+	if booking.PickupOdometer != nil && booking.ReturnOdometer != nil {
+		settlement.KmDriven = *booking.ReturnOdometer - *booking.PickupOdometer
+	}
+	settlement.Total = chargedTotal(booking)
+	settlement.Deposit = booking.Deposit
+	settlement.BalanceDue = settlement.Total - settlement.Deposit
+	settlement.CompanyID = booking.CompanyID
+	settlement.PaymentDueAt = booking.PaymentDueAt
+	settlement.PaidAt = booking.PaidAt
+	if booking.PaidAt != nil {
+		settlement.BalanceDue = 0
+	}
+	settlement.Final = booking.ReturnedAt != nil
+	return settlement, nil
+}
+
+// PayBooking records that the company a booking is billed to paid it, or
+// that a customer paying for themselves settled the balance left at return.
+func (s *bookingserviceImpl) PayBooking(ctx context.Context, id uint64) (models.Booking, error) {
+	booking, err := s.GetBookingsByID(ctx, id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	switch {
+	case booking.CompanyID == nil && booking.ReturnedAt == nil:
+		return models.Booking{}, apperror.Conflict("booking has not been returned").WithCode("booking_not_returned")
+	case booking.CancelledAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was cancelled").WithCode("booking_cancelled")
+	case booking.PaidAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was already paid").WithCode("booking_paid")
+	}
+
+	now := time.Now()
+	paid := models.Booking{}
+	paid.PaidAt = &now
+	paid.UpdatedAt = now
+	return s.bookingRepo.EditBookings(ctx, id, paid)
+}
+
+// rentDays counts the days of a rent, both the first and the last included.
+func rentDays(startRent, endRent time.Time) int {
+	return int(endRent.Sub(startRent).Hours()/24) + 1
+}
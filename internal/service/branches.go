@@ -0,0 +1,153 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type Branchservice interface {
+	GetBranches(ctx context.Context, includeDeleted bool) ([]models.Branch, error)
+	GetBranchesByID(ctx context.Context, id uint64) (models.Branch, error)
+	CreateBranch(ctx context.Context, branch models.InputBranch) (models.Branch, error)
+	EditBranch(ctx context.Context, id uint64, branch models.InputBranch) (models.Branch, error)
+	DeleteBranch(ctx context.Context, id uint64) (models.Branch, error)
+	RestoreBranch(ctx context.Context, id uint64) (models.Branch, error)
+}
+type branchserviceImpl struct {
+	branchRepo   repository.BranchesQuery
+	vehicleRepo  repository.VehiclesQuery
+	driverRepo   repository.DriversQuery
+	bookingRepo  repository.BookingsQuery
+	transferRepo repository.TransfersQuery
+}
+
+func NewBranchservice(branchRepo repository.BranchesQuery,
+	vehicleRepo repository.VehiclesQuery,
+	driverRepo repository.DriversQuery,
+	bookingRepo repository.BookingsQuery,
+	transferRepo repository.TransfersQuery) Branchservice {
+	return &branchserviceImpl{branchRepo: branchRepo,
+		vehicleRepo:  vehicleRepo,
+		driverRepo:   driverRepo,
+		bookingRepo:  bookingRepo,
+		transferRepo: transferRepo,
+	}
+}
+
+func (s *branchserviceImpl) GetBranches(ctx context.Context, includeDeleted bool) ([]models.Branch, error) {
+	branches, err := s.branchRepo.GetBranches(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
+
+func (s *branchserviceImpl) GetBranchesByID(ctx context.Context, id uint64) (models.Branch, error) {
+	branch, err := s.branchRepo.GetBranchesByID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if branch.ID == 0 {
+		return models.Branch{}, apperror.NotFound("branch")
+	}
+	return branch, nil
+}
+
+func (s *branchserviceImpl) CreateBranch(ctx context.Context, branch models.InputBranch) (models.Branch, error) {
+	if err := validation.Check(branch); err != nil {
+		return models.Branch{}, err
+	}
+	NewBranch := models.Branch{}
+	NewBranch.Name = branch.Name
+	NewBranch.City = branch.City
+	NewBranch.Address = branch.Address
+	NewBranch.Phone = branch.Phone
+	NewBranch.OneWayFee = branch.OneWayFee
+	NewBranch.CreatedAt = time.Now()
+
+	createdBranch, err := s.branchRepo.CreateBranches(ctx, NewBranch)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	return createdBranch, nil
+}
+
+func (s *branchserviceImpl) EditBranch(ctx context.Context, id uint64, branch models.InputBranch) (models.Branch, error) {
+	if err := validation.Check(branch); err != nil {
+		return models.Branch{}, err
+	}
+	updatedBranch := models.Branch{}
+	updatedBranch.Name = branch.Name
+	updatedBranch.City = branch.City
+	updatedBranch.Address = branch.Address
+	updatedBranch.Phone = branch.Phone
+	updatedBranch.OneWayFee = branch.OneWayFee
+	updatedBranch.UpdatedAt = time.Now()
+
+	updatedBranch, err := s.branchRepo.EditBranches(ctx, id, updatedBranch)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if updatedBranch.ID == 0 {
+		return models.Branch{}, apperror.NotFound("branch")
+	}
+	return updatedBranch, nil
+}
+
+// DeleteBranch closes a branch once no vehicle or driver is kept there and
+// no open booking or transfer involves it.
+func (s *branchserviceImpl) DeleteBranch(ctx context.Context, id uint64) (models.Branch, error) {
+	branch, err := s.GetBranchesByID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+
+	vehicleIDs, err := s.vehicleRepo.GetVehicleIDsByBranchID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if len(vehicleIDs) > 0 {
+		return models.Branch{}, newDependentsConflict("branch", id, "vehicle", vehicleIDs)
+	}
+	driverIDs, err := s.driverRepo.GetDriverIDsByBranchID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if len(driverIDs) > 0 {
+		return models.Branch{}, newDependentsConflict("branch", id, "driver", driverIDs)
+	}
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByBranchID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Branch{}, newDependentsConflict("branch", id, "open booking", bookingIDs)
+	}
+	transferIDs, err := s.transferRepo.GetOpenTransferIDsByBranchID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if len(transferIDs) > 0 {
+		return models.Branch{}, newDependentsConflict("branch", id, "open transfer", transferIDs)
+	}
+
+	if err := s.branchRepo.DeleteBranchesByID(ctx, id); err != nil {
+		return models.Branch{}, err
+	}
+	return branch, nil
+}
+
+func (s *branchserviceImpl) RestoreBranch(ctx context.Context, id uint64) (models.Branch, error) {
+	branch, err := s.branchRepo.RestoreBranchesByID(ctx, id)
+	if err != nil {
+		return models.Branch{}, err
+	}
+	if branch.ID == 0 {
+		return models.Branch{}, apperror.NotFound("branch")
+	}
+	return branch, nil
+}
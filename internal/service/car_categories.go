@@ -0,0 +1,147 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type CarCategoryservice interface {
+	GetCarCategories(ctx context.Context, includeDeleted bool) ([]models.CarCategory, error)
+	GetCarCategoriesByID(ctx context.Context, id uint64) (models.CarCategory, error)
+	CreateCarCategory(ctx context.Context, category models.InputCarCategory) (models.CarCategory, error)
+	EditCarCategory(ctx context.Context, id uint64, category models.InputCarCategory) (models.CarCategory, error)
+	DeleteCarCategory(ctx context.Context, id uint64) (models.CarCategory, error)
+	RestoreCarCategory(ctx context.Context, id uint64) (models.CarCategory, error)
+}
+type carCategoryserviceImpl struct {
+	categoryRepo repository.CarCategoriesQuery
+	carRepo      repository.CarsQuery
+	planRepo     repository.InsurancePlansQuery
+}
+
+func NewCarCategoryservice(categoryRepo repository.CarCategoriesQuery, carRepo repository.CarsQuery, planRepo repository.InsurancePlansQuery) CarCategoryservice {
+	return &carCategoryserviceImpl{categoryRepo: categoryRepo, carRepo: carRepo, planRepo: planRepo}
+}
+
+// checkCarCategory validates a car category request, including that its
+// insurance plan exists.
+func (s *carCategoryserviceImpl) checkCarCategory(ctx context.Context, category models.InputCarCategory) error {
+	errs := validation.Collect(category)
+	if category.InsurancePlanID != nil {
+		plan, err := s.planRepo.GetInsurancePlansByID(ctx, uint64(*category.InsurancePlanID))
+		if err != nil {
+			return err
+		}
+		if plan.ID == 0 {
+			errs.Add("insurance_plan_id", "insurance plan not found")
+		}
+	}
+	return errs.Err()
+}
+
+func (s *carCategoryserviceImpl) GetCarCategories(ctx context.Context, includeDeleted bool) ([]models.CarCategory, error) {
+	categories, err := s.categoryRepo.GetCarCategories(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (s *carCategoryserviceImpl) GetCarCategoriesByID(ctx context.Context, id uint64) (models.CarCategory, error) {
+	category, err := s.categoryRepo.GetCarCategoriesByID(ctx, id)
+	if err != nil {
+		return models.CarCategory{}, err
+	}
+	if category.ID == 0 {
+		return models.CarCategory{}, apperror.NotFound("car category")
+	}
+	return category, nil
+}
+
+func (s *carCategoryserviceImpl) CreateCarCategory(ctx context.Context, category models.InputCarCategory) (models.CarCategory, error) {
+	if err := s.checkCarCategory(ctx, category); err != nil {
+		return models.CarCategory{}, err
+	}
+	NewCategory := models.CarCategory{}
+	NewCategory.Name = category.Name
+	NewCategory.Description = category.Description
+	NewCategory.FuelPolicy = category.FuelPolicy
+	if NewCategory.FuelPolicy == "" {
+		NewCategory.FuelPolicy = models.FuelPolicyFullToFull
+	}
+	NewCategory.RefuelRate = category.RefuelRate
+	NewCategory.PrepaidFuelPrice = category.PrepaidFuelPrice
+	NewCategory.DailyKmAllowance = category.DailyKmAllowance
+	NewCategory.ExcessKmRate = category.ExcessKmRate
+	NewCategory.InsurancePlanID = category.InsurancePlanID
+	NewCategory.CreatedAt = time.Now()
+
+	createdCategory, err := s.categoryRepo.CreateCarCategories(ctx, NewCategory)
+	if err != nil {
+		return models.CarCategory{}, err
+	}
+	return createdCategory, nil
+}
+
+func (s *carCategoryserviceImpl) EditCarCategory(ctx context.Context, id uint64, category models.InputCarCategory) (models.CarCategory, error) {
+	if err := s.checkCarCategory(ctx, category); err != nil {
+		return models.CarCategory{}, err
+	}
+	updatedCategory := models.CarCategory{}
+	updatedCategory.Name = category.Name
+	updatedCategory.Description = category.Description
+	updatedCategory.FuelPolicy = category.FuelPolicy
+	if updatedCategory.FuelPolicy == "" {
+		updatedCategory.FuelPolicy = models.FuelPolicyFullToFull
+	}
+	updatedCategory.RefuelRate = category.RefuelRate
+	updatedCategory.PrepaidFuelPrice = category.PrepaidFuelPrice
+	updatedCategory.DailyKmAllowance = category.DailyKmAllowance
+	updatedCategory.ExcessKmRate = category.ExcessKmRate
+	updatedCategory.InsurancePlanID = category.InsurancePlanID
+	updatedCategory.UpdatedAt = time.Now()
+
+	updatedCategory, err := s.categoryRepo.EditCarCategories(ctx, id, updatedCategory)
+	if err != nil {
+		return models.CarCategory{}, err
+	}
+	if updatedCategory.ID == 0 {
+		return models.CarCategory{}, apperror.NotFound("car category")
+	}
+	return updatedCategory, nil
+}
+
+func (s *carCategoryserviceImpl) DeleteCarCategory(ctx context.Context, id uint64) (models.CarCategory, error) {
+	category, err := s.GetCarCategoriesByID(ctx, id)
+	if err != nil {
+		return models.CarCategory{}, err
+	}
+
+	carIDs, err := s.carRepo.GetCarIDsByCategoryID(ctx, id)
+	if err != nil {
+		return models.CarCategory{}, err
+	}
+	if len(carIDs) > 0 {
+		return models.CarCategory{}, newDependentsConflict("car category", id, "car", carIDs)
+	}
+
+	if err := s.categoryRepo.DeleteCarCategoriesByID(ctx, id); err != nil {
+		return models.CarCategory{}, err
+	}
+	return category, nil
+}
+
+func (s *carCategoryserviceImpl) RestoreCarCategory(ctx context.Context, id uint64) (models.CarCategory, error) {
+	category, err := s.categoryRepo.RestoreCarCategoriesByID(ctx, id)
+	if err != nil {
+		return models.CarCategory{}, err
+	}
+	if category.ID == 0 {
+		return models.CarCategory{}, apperror.NotFound("car category")
+	}
+	return category, nil
+}
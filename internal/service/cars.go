@@ -3,29 +3,37 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
-	"errors"
 	"time"
 )
 
 type Carservice interface {
-	GetCars(ctx context.Context) ([]models.Car, error)
+	GetCars(ctx context.Context, filter models.CarFilter) ([]models.Car, error)
 	GetCarsByID(ctx context.Context, id uint64) (models.Car, error)
 	CreateCar(ctx context.Context, car models.InputCar) (models.Car, error)
 	EditCar(ctx context.Context, id uint64, car models.InputCar) (models.Car, error)
 	DeleteCar(ctx context.Context, id uint64) (models.Car, error)
+	RestoreCar(ctx context.Context, id uint64) (models.Car, error)
 }
 type carserviceImpl struct {
-	carRepo repository.CarsQuery
+	carRepo     repository.CarsQuery
+	bookingRepo repository.BookingsQuery
+	vehicleRepo  repository.VehiclesQuery
+	categoryRepo repository.CarCategoriesQuery
 }
 
-func NewCarservice(carRepo repository.CarsQuery) Carservice {
-	return &carserviceImpl{carRepo: carRepo}
+func NewCarservice(carRepo repository.CarsQuery, bookingRepo repository.BookingsQuery, vehicleRepo repository.VehiclesQuery, categoryRepo repository.CarCategoriesQuery) Carservice {
+	return &carserviceImpl{carRepo: carRepo, bookingRepo: bookingRepo, vehicleRepo: vehicleRepo, categoryRepo: categoryRepo}
 }
 
 
-func (s *carserviceImpl) GetCars(ctx context.Context) ([]models.Car, error) {
-	cars, err := s.carRepo.GetCars(ctx)
+func (s *carserviceImpl) GetCars(ctx context.Context, filter models.CarFilter) ([]models.Car, error) {
+	if err := validation.Check(filter); err != nil {
+		return nil, err
+	}
+	cars, err := s.carRepo.GetCars(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -38,16 +46,40 @@ func (s *carserviceImpl) GetCarsByID(ctx context.Context, id uint64) (models.Car
 		return models.Car{}, err
 	}
 	if car.ID == 0 {
-		return models.Car{}, errors.New("car not found")
+		return models.Car{}, apperror.NotFound("car")
 	}
 	return car, nil
 }
 
+// checkCar validates a car request, including that its category exists.
+func (s *carserviceImpl) checkCar(ctx context.Context, car models.InputCar) error {
+	errs := validation.Collect(car)
+	if car.CategoryID != nil {
+		category, err := s.categoryRepo.GetCarCategoriesByID(ctx, uint64(*car.CategoryID))
+		if err != nil {
+			return err
+		}
+		if category.ID == 0 {
+			errs.Add("category_id", "car category not found")
+		}
+	}
+	return errs.Err()
+}
+
 func (s *carserviceImpl) CreateCar(ctx context.Context, car models.InputCar) (models.Car, error) {
+	if err := s.checkCar(ctx, car); err != nil {
+		return models.Car{}, err
+	}
 	NewCar := models.Car{}
 	NewCar.Name = car.Name
-	NewCar.Stock = car.Stock
 	NewCar.DailyRent = car.DailyRent
+	NewCar.CategoryID = car.CategoryID
+	NewCar.Brand = car.Brand
+	NewCar.Transmission = car.Transmission
+	NewCar.FuelType = car.FuelType
+	NewCar.Seats = car.Seats
+	NewCar.Year = car.Year
+	NewCar.Luggage = car.Luggage
 	NewCar.CreatedAt = time.Now()
 
 	createdCar, err := s.carRepo.CreateCars(ctx, NewCar)
@@ -58,10 +90,19 @@ func (s *carserviceImpl) CreateCar(ctx context.Context, car models.InputCar) (mo
 }
 
 func (s *carserviceImpl) EditCar(ctx context.Context, id uint64, car models.InputCar) (models.Car, error) {
+	if err := s.checkCar(ctx, car); err != nil {
+		return models.Car{}, err
+	}
 	updatedCar := models.Car{}
 	updatedCar.Name = car.Name
-	updatedCar.Stock = car.Stock
 	updatedCar.DailyRent = car.DailyRent
+	updatedCar.CategoryID = car.CategoryID
+	updatedCar.Brand = car.Brand
+	updatedCar.Transmission = car.Transmission
+	updatedCar.FuelType = car.FuelType
+	updatedCar.Seats = car.Seats
+	updatedCar.Year = car.Year
+	updatedCar.Luggage = car.Luggage
 	updatedCar.UpdatedAt = time.Now()
 
 	updatedCar, err := s.carRepo.EditCars(ctx, id, updatedCar)
@@ -77,7 +118,23 @@ func (s *carserviceImpl) DeleteCar(ctx context.Context, id uint64) (models.Car,
 		return models.Car{}, err
 	}
 	if car.ID == 0 {
-		return models.Car{}, nil
+		return models.Car{}, apperror.NotFound("car")
+	}
+
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByCarID(ctx, id)
+	if err != nil {
+		return models.Car{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Car{}, newDependentsConflict("car", id, "open booking", bookingIDs)
+	}
+
+	vehicleIDs, err := s.vehicleRepo.GetVehicleIDsByCarID(ctx, id)
+	if err != nil {
+		return models.Car{}, err
+	}
+	if len(vehicleIDs) > 0 {
+		return models.Car{}, newDependentsConflict("car", id, "vehicle", vehicleIDs)
 	}
 
 	err = s.carRepo.DeleteCarsByID(ctx, id)
@@ -87,3 +144,14 @@ func (s *carserviceImpl) DeleteCar(ctx context.Context, id uint64) (models.Car,
 
 	return car, err
 }
+
+func (s *carserviceImpl) RestoreCar(ctx context.Context, id uint64) (models.Car, error) {
+	car, err := s.carRepo.RestoreCarsByID(ctx, id)
+	if err != nil {
+		return models.Car{}, err
+	}
+	if car.ID == 0 {
+		return models.Car{}, apperror.NotFound("car")
+	}
+	return car, nil
+}
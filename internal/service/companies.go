@@ -0,0 +1,215 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"fmt"
+	"time"
+)
+
+type Companyservice interface {
+	GetCompanies(ctx context.Context, includeDeleted bool) ([]models.Company, error)
+	GetCompaniesByID(ctx context.Context, id uint64) (models.Company, error)
+	CreateCompany(ctx context.Context, company models.InputCompany) (models.Company, error)
+	EditCompany(ctx context.Context, id uint64, company models.InputCompany) (models.Company, error)
+	DeleteCompany(ctx context.Context, id uint64) (models.Company, error)
+	RestoreCompany(ctx context.Context, id uint64) (models.Company, error)
+	GetCompanyStatement(ctx context.Context, id uint64, month time.Time) (models.CompanyStatement, error)
+}
+type companyserviceImpl struct {
+	companyRepo repository.CompaniesQuery
+	carRepo     repository.CarsQuery
+	bookingRepo repository.BookingsQuery
+}
+
+func NewCompanyservice(companyRepo repository.CompaniesQuery, carRepo repository.CarsQuery, bookingRepo repository.BookingsQuery) Companyservice {
+	return &companyserviceImpl{companyRepo: companyRepo, carRepo: carRepo, bookingRepo: bookingRepo}
+}
+
+// checkCompany validates a company request, including that the cars of its
+// rate card exist and that no other company holds its NPWP. id is the
+// company being edited, 0 for a new one.
+func (s *companyserviceImpl) checkCompany(ctx context.Context, id uint64, company models.InputCompany) error {
+	errs := validation.Collect(company)
+	for i, rate := range company.Rates {
+		field := fmt.Sprintf("rates[%d].car_id", i)
+		if errs.Has(field) {
+			continue
+		}
+		car, err := s.carRepo.GetCarsByID(ctx, uint64(rate.CarID))
+		if err != nil {
+			return err
+		}
+		if car.ID == 0 {
+			errs.Add(field, "car not found")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	holder, err := s.companyRepo.GetCompaniesByNPWP(ctx, company.NPWP)
+	if err != nil {
+		return err
+	}
+	if holder.ID != 0 && uint64(holder.ID) != id {
+		return apperror.Conflict(fmt.Sprintf("npwp is already registered to company %d", holder.ID)).WithCode("npwp_taken")
+	}
+	return nil
+}
+
+func (s *companyserviceImpl) GetCompanies(ctx context.Context, includeDeleted bool) ([]models.Company, error) {
+	companies, err := s.companyRepo.GetCompanies(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return companies, nil
+}
+
+func (s *companyserviceImpl) GetCompaniesByID(ctx context.Context, id uint64) (models.Company, error) {
+	company, err := s.companyRepo.GetCompaniesByID(ctx, id)
+	if err != nil {
+		return models.Company{}, err
+	}
+	if company.ID == 0 {
+		return models.Company{}, apperror.NotFound("company")
+	}
+	return company, nil
+}
+
+func (s *companyserviceImpl) CreateCompany(ctx context.Context, company models.InputCompany) (models.Company, error) {
+	if err := s.checkCompany(ctx, 0, company); err != nil {
+		return models.Company{}, err
+	}
+	NewCompany := newCompany(company)
+	NewCompany.CreatedAt = time.Now()
+
+	createdCompany, err := s.companyRepo.CreateCompanies(ctx, NewCompany)
+	if err != nil {
+		return models.Company{}, err
+	}
+	return createdCompany, nil
+}
+
+func (s *companyserviceImpl) EditCompany(ctx context.Context, id uint64, company models.InputCompany) (models.Company, error) {
+	if err := s.checkCompany(ctx, id, company); err != nil {
+		return models.Company{}, err
+	}
+	updatedCompany := newCompany(company)
+	updatedCompany.UpdatedAt = time.Now()
+
+	updatedCompany, err := s.companyRepo.EditCompanies(ctx, id, updatedCompany)
+	if err != nil {
+		return models.Company{}, err
+	}
+	if updatedCompany.ID == 0 {
+		return models.Company{}, apperror.NotFound("company")
+	}
+	return updatedCompany, nil
+}
+
+// newCompany fills in a company from a request. Payment terms default to
+// net 30.
+func newCompany(company models.InputCompany) models.Company {
+	NewCompany := models.Company{}
+	NewCompany.Name = company.Name
+	NewCompany.NPWP = company.NPWP
+	NewCompany.Address = company.Address
+	NewCompany.BillingContact = company.BillingContact
+	NewCompany.BillingEmail = company.BillingEmail
+	NewCompany.BillingPhone = company.BillingPhone
+	NewCompany.CreditLimit = company.CreditLimit
+	NewCompany.PaymentTermsDays = company.PaymentTermsDays
+	if NewCompany.PaymentTermsDays == 0 {
+		NewCompany.PaymentTermsDays = models.DefaultPaymentTermsDays
+	}
+	NewCompany.Rates = []models.CompanyRate{}
+	for _, rate := range company.Rates {
+		NewCompany.Rates = append(NewCompany.Rates, models.CompanyRate{CarID: rate.CarID, DailyRent: rate.DailyRent})
+	}
+	return NewCompany
+}
+
+func (s *companyserviceImpl) DeleteCompany(ctx context.Context, id uint64) (models.Company, error) {
+	company, err := s.GetCompaniesByID(ctx, id)
+	if err != nil {
+		return models.Company{}, err
+	}
+
+	bookingIDs, err := s.bookingRepo.GetUnpaidBookingIDsByCompanyID(ctx, id)
+	if err != nil {
+		return models.Company{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Company{}, newDependentsConflict("company", id, "unpaid booking", bookingIDs)
+	}
+
+	if err := s.companyRepo.DeleteCompaniesByID(ctx, id); err != nil {
+		return models.Company{}, err
+	}
+	return company, nil
+}
+
+func (s *companyserviceImpl) RestoreCompany(ctx context.Context, id uint64) (models.Company, error) {
+	company, err := s.companyRepo.RestoreCompaniesByID(ctx, id)
+	if err != nil {
+		return models.Company{}, err
+	}
+	if company.ID == 0 {
+		return models.Company{}, apperror.NotFound("company")
+	}
+	return company, nil
+}
+
+// GetCompanyStatement lists the bookings billed to a company that start in
+// the month of month, with what they cost, what was paid and what the
+// company owes over all months.
+func (s *companyserviceImpl) GetCompanyStatement(ctx context.Context, id uint64, month time.Time) (models.CompanyStatement, error) {
+	company, err := s.GetCompaniesByID(ctx, id)
+	if err != nil {
+		return models.CompanyStatement{}, err
+	}
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	bookings, err := s.bookingRepo.GetBookingsByCompanyID(ctx, id, start, start.AddDate(0, 1, 0))
+	if err != nil {
+		return models.CompanyStatement{}, err
+	}
+	owed, err := s.bookingRepo.SumUnpaidByCompanyID(ctx, id, 0)
+	if err != nil {
+		return models.CompanyStatement{}, err
+	}
+
+	statement := models.CompanyStatement{}
+	statement.CompanyID = company.ID
+	statement.Name = company.Name
+	statement.NPWP = company.NPWP
+	statement.BillingContact = company.BillingContact
+	statement.BillingEmail = company.BillingEmail
+	statement.Month = start.Format("2006-01")
+	statement.Lines = []models.CompanyStatementLine{}
+	for _, booking := range bookings {
+		line := models.CompanyStatementLine{}
+		line.BookingID = booking.ID
+		line.CustomerName = booking.Customer.Name
+		line.CarName = booking.Car.Name
+		line.StartRent = booking.StartRent
+		line.EndRent = booking.EndRent
+		line.Total = chargedTotal(booking)
+		line.Final = booking.ReturnedAt != nil
+		line.PaymentDueAt = booking.PaymentDueAt
+		line.PaidAt = booking.PaidAt
+		statement.Lines = append(statement.Lines, line)
+
+		statement.Total += line.Total
+		if booking.PaidAt != nil {
+			statement.Paid += line.Total
+		}
+	}
+	statement.Outstanding = statement.Total - statement.Paid
+	statement.CreditLimit = company.CreditLimit
+	statement.CreditUsed = int(owed)
+	return statement, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// riskThresholdsFromEnv reads RISK_LATE_RETURNS and RISK_UNPAID_BOOKINGS,
+// 3 late returns and 2 overdue bookings when unset or not a number.
+func riskThresholdsFromEnv() models.RiskThresholds {
+	return models.RiskThresholds{
+		LateReturns:    envCount("RISK_LATE_RETURNS", 3),
+		UnpaidBookings: envCount("RISK_UNPAID_BOOKINGS", 2),
+	}
+}
+
+func envCount(key string, fallback int) int {
+	count, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || count < 0 {
+		return fallback
+	}
+	return count
+}
+
+// riskFlagger flags customers once they reach the risk thresholds.
+type riskFlagger struct {
+	customerRepo repository.CustomersQuery
+	bookingRepo  repository.BookingsQuery
+	thresholds   models.RiskThresholds
+}
+
+// assess returns a customer without a risk status who returned too many
+// bookings late, or left too many unpaid past their due date, flagged by
+// the system, and reports whether it flagged them. Nothing is saved. Late
+// returns only count from the last time staff reviewed the customer's
+// status, so clearing a flag sticks until the customer is late again.
+func (f riskFlagger) assess(ctx context.Context, customer models.Customer) (models.Customer, bool, error) {
+	now := time.Now()
+	if customer.RiskOn(now) != "" {
+		return customer, false, nil
+	}
+
+	reason := ""
+	if f.thresholds.LateReturns > 0 {
+		late, err := f.bookingRepo.CountLateReturnsByCustomerID(ctx, uint64(customer.ID), customer.RiskReviewedAt)
+		if err != nil {
+			return models.Customer{}, false, err
+		}
+		if late >= int64(f.thresholds.LateReturns) {
+			reason = fmt.Sprintf("returned %d bookings late", late)
+		}
+	}
+	if reason == "" && f.thresholds.UnpaidBookings > 0 {
+		unpaid, err := f.bookingRepo.CountOverdueBookingsByCustomerID(ctx, uint64(customer.ID), now)
+		if err != nil {
+			return models.Customer{}, false, err
+		}
+		if unpaid >= int64(f.thresholds.UnpaidBookings) {
+			reason = fmt.Sprintf("has %d bookings unpaid past their due date", unpaid)
+		}
+	}
+	if reason == "" {
+		return customer, false, nil
+	}
+
+	customer.RiskStatus = models.RiskFlagged
+	customer.RiskReason = reason
+	customer.RiskFlaggedBy = models.RiskFlaggedBySystem
+	customer.RiskFlaggedAt = &now
+	customer.RiskExpiresAt = nil
+	return customer, true, nil
+}
+
+// autoFlag saves the flag assess puts on a customer who reached a risk
+// threshold.
+func (f riskFlagger) autoFlag(ctx context.Context, customer models.Customer) error {
+	flagged, ok, err := f.assess(ctx, customer)
+	if err != nil || !ok {
+		return err
+	}
+	flagged.UpdatedAt = *flagged.RiskFlaggedAt
+	_, err = f.customerRepo.EditCustomerRisk(ctx, uint64(flagged.ID), flagged)
+	return err
+}
+
+// SetCustomerRisk flags or blacklists a customer on the word of a member
+// of staff, replacing any status they had.
+func (s *customerServiceImpl) SetCustomerRisk(ctx context.Context, id uint64, risk models.InputCustomerRisk) (models.Customer, error) {
+	if _, err := s.GetCustomersByID(ctx, id); err != nil {
+		return models.Customer{}, err
+	}
+	if err := validation.Check(risk); err != nil {
+		return models.Customer{}, err
+	}
+
+	now := time.Now()
+	updatedCustomer := models.Customer{}
+	updatedCustomer.RiskStatus = risk.Status
+	updatedCustomer.RiskReason = risk.Reason
+	updatedCustomer.RiskFlaggedBy = risk.FlaggedBy
+	updatedCustomer.RiskFlaggedAt = &now
+	if risk.ExpiresAt != "" {
+		expiresAt, _ := time.Parse(models.DateLayout, risk.ExpiresAt)
+		updatedCustomer.RiskExpiresAt = &expiresAt
+	}
+	updatedCustomer.RiskReviewedAt = &now
+	updatedCustomer.UpdatedAt = now
+	return s.customerRepo.EditCustomerRisk(ctx, id, updatedCustomer)
+}
+
+// ClearCustomerRisk lifts a customer's flag or blacklisting.
+func (s *customerServiceImpl) ClearCustomerRisk(ctx context.Context, id uint64) (models.Customer, error) {
+	customer, err := s.GetCustomersByID(ctx, id)
+	if err != nil {
+		return models.Customer{}, err
+	}
+	if customer.RiskStatus == "" {
+		return models.Customer{}, apperror.Conflict(fmt.Sprintf("customer %d is neither flagged nor blacklisted", id)).
+			WithCode("customer_not_flagged")
+	}
+
+	now := time.Now()
+	updatedCustomer := models.Customer{}
+	updatedCustomer.RiskReviewedAt = &now
+	updatedCustomer.UpdatedAt = now
+	return s.customerRepo.EditCustomerRisk(ctx, id, updatedCustomer)
+}
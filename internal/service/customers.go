@@ -3,31 +3,37 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
-	"errors"
 	"time"
 )
 
 type CustomerService interface {
-	GetCustomers(ctx context.Context) ([]models.Customer, error)
+	GetCustomers(ctx context.Context, includeDeleted bool) ([]models.Customer, error)
 	GetCustomersByID(ctx context.Context, id uint64) (models.Customer, error)
 	CreateCustomer(ctx context.Context, customer models.InputCustomer) (models.Customer, error)
 	EditCustomer(ctx context.Context, id uint64, customer models.InputCustomer) (models.Customer, error)
 	DeleteCustomer(ctx context.Context, id uint64) (models.Customer, error)
+	RestoreCustomer(ctx context.Context, id uint64) (models.Customer, error)
 	AssignMembership(ctx context.Context, id uint64, customerMember models.InputMembershipID) (models.Customer, error)
 	DeleteMembershipByCustomer(ctx context.Context, id uint64, customer models.Customer) (models.Customer, error)
+	SetCustomerRisk(ctx context.Context, id uint64, risk models.InputCustomerRisk) (models.Customer, error)
+	ClearCustomerRisk(ctx context.Context, id uint64) (models.Customer, error)
 }
 type customerServiceImpl struct {
 	customerRepo repository.CustomersQuery
+	bookingRepo  repository.BookingsQuery
 }
 
-func NewCustomerService(customerRepo repository.CustomersQuery) CustomerService {
-	return &customerServiceImpl{customerRepo: customerRepo}
+func NewCustomerService(customerRepo repository.CustomersQuery, bookingRepo repository.BookingsQuery) CustomerService {
+	return &customerServiceImpl{customerRepo: customerRepo, bookingRepo: bookingRepo}
 }
 
 
-func (s *customerServiceImpl) GetCustomers(ctx context.Context) ([]models.Customer, error) {
-	customers, err := s.customerRepo.GetCustomers(ctx)
+func (s *customerServiceImpl) GetCustomers(ctx context.Context, includeDeleted bool) ([]models.Customer, error) {
+	customers, err := s.customerRepo.GetCustomers(ctx, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -40,16 +46,37 @@ func (s *customerServiceImpl) GetCustomersByID(ctx context.Context, id uint64) (
 		return models.Customer{}, err
 	}
 	if customer.ID == 0 {
-		return models.Customer{}, errors.New("customer not found")
+		return models.Customer{}, apperror.NotFound("customer")
 	}
 	return customer, nil
 }
 
+// checkPhone makes sure no other active customer than id has phone.
+func (s *customerServiceImpl) checkPhone(ctx context.Context, id uint64, phone string) error {
+	byPhone, err := s.customerRepo.GetCustomerByPhone(ctx, phone)
+	if err != nil {
+		return err
+	}
+	if byPhone.ID != 0 && uint64(byPhone.ID) != id {
+		return apperror.Conflict("phone number is already registered to another customer",
+			pkg.FieldError{Field: "phone", Message: "is already registered"}).WithCode("phone_taken")
+	}
+	return nil
+}
+
 func (s *customerServiceImpl) CreateCustomer(ctx context.Context, customer models.InputCustomer) (models.Customer, error) {
+	if err := validation.Check(customer); err != nil {
+		return models.Customer{}, err
+	}
+	phone, _ := validation.NormalizePhone(customer.Phone)
+	if err := s.checkPhone(ctx, 0, phone); err != nil {
+		return models.Customer{}, err
+	}
 	NewCustomer := models.Customer{}
 	NewCustomer.Name = customer.Name
 	NewCustomer.NIK = customer.NIK
-	NewCustomer.Phone = customer.Phone
+	NewCustomer.Phone = phone
+	NewCustomer.BirthDate, NewCustomer.Gender = models.NIKIdentity(customer.NIK)
 	NewCustomer.CreatedAt = time.Now()
 
 	// Call repoCustomersitory to create customer
@@ -61,10 +88,18 @@ func (s *customerServiceImpl) CreateCustomer(ctx context.Context, customer model
 }
 
 func (s *customerServiceImpl) EditCustomer(ctx context.Context, id uint64, customer models.InputCustomer) (models.Customer, error) {
+	if err := validation.Check(customer); err != nil {
+		return models.Customer{}, err
+	}
+	phone, _ := validation.NormalizePhone(customer.Phone)
+	if err := s.checkPhone(ctx, id, phone); err != nil {
+		return models.Customer{}, err
+	}
 	updatedCustomer := models.Customer{}
 	updatedCustomer.Name = customer.Name
 	updatedCustomer.NIK = customer.NIK
-	updatedCustomer.Phone = customer.Phone
+	updatedCustomer.Phone = phone
+	updatedCustomer.BirthDate, updatedCustomer.Gender = models.NIKIdentity(customer.NIK)
 	updatedCustomer.UpdatedAt = time.Now()
 
 	// Call repoCustomersitory to create customer
@@ -82,7 +117,16 @@ func (s *customerServiceImpl) DeleteCustomer(ctx context.Context, id uint64) (mo
 	}
 	// if customer doesn't exist, return
 	if customer.ID == 0 {
-		return models.Customer{}, nil
+		return models.Customer{}, apperror.NotFound("customer")
+	}
+
+	// a customer with running bookings cannot go away
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByCustomerID(ctx, id)
+	if err != nil {
+		return models.Customer{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Customer{}, newDependentsConflict("customer", id, "open booking", bookingIDs)
 	}
 
 	// delete customer by id
@@ -96,6 +140,9 @@ func (s *customerServiceImpl) DeleteCustomer(ctx context.Context, id uint64) (mo
 
 
 func (s *customerServiceImpl) AssignMembership(ctx context.Context, id uint64, customerMember models.InputMembershipID) (models.Customer, error) {
+	if err := validation.Check(customerMember); err != nil {
+		return models.Customer{}, err
+	}
 	updatedCustomer := models.Customer{}
 	updatedCustomer.MembershipID = &customerMember.MembershipID
 
@@ -116,4 +163,27 @@ func (s *customerServiceImpl) DeleteMembershipByCustomer(ctx context.Context, id
 		return models.Customer{}, err
 	}
 	return customer, nil
-}
\ No newline at end of file
+}
+
+// RestoreCustomer brings back a deleted customer unless another customer
+// took their phone number in the meantime.
+func (s *customerServiceImpl) RestoreCustomer(ctx context.Context, id uint64) (models.Customer, error) {
+	deleted, err := s.customerRepo.GetCustomersByIDWithDeleted(ctx, id)
+	if err != nil {
+		return models.Customer{}, err
+	}
+	if deleted.ID == 0 {
+		return models.Customer{}, apperror.NotFound("customer")
+	}
+	if err := s.checkPhone(ctx, id, deleted.Phone); err != nil {
+		return models.Customer{}, err
+	}
+	customer, err := s.customerRepo.RestoreCustomersByID(ctx, id)
+	if err != nil {
+		return models.Customer{}, err
+	}
+	if customer.ID == 0 {
+		return models.Customer{}, apperror.NotFound("customer")
+	}
+	return customer, nil
+}
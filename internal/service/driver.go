@@ -3,29 +3,54 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
-	"errors"
+	"math"
+	"sort"
 	"time"
 )
 
 type Driverservice interface {
-	GetDrivers(ctx context.Context) ([]models.Driver, error)
+	GetDrivers(ctx context.Context, includeDeleted bool) ([]models.Driver, error)
 	GetDriversByID(ctx context.Context, id uint64) (models.Driver, error)
 	CreateDriver(ctx context.Context, driver models.InputDriver) (models.Driver, error)
 	EditDriver(ctx context.Context, id uint64, driver models.InputDriver) (models.Driver, error)
 	DeleteDriver(ctx context.Context, id uint64) (models.Driver, error)
+	RestoreDriver(ctx context.Context, id uint64) (models.Driver, error)
+	GetExpiringDocuments(ctx context.Context, withinDays int) ([]models.DriverExpiry, error)
+	GetDriverStats(ctx context.Context, id uint64, filter models.DriverStatsFilter) (models.DriverStats, error)
 }
 type driverserviceImpl struct {
-	driverRepo repository.DriversQuery
+	driverRepo   repository.DriversQuery
+	bookingRepo  repository.BookingsQuery
+	branchRepo   repository.BranchesQuery
+	documentRepo repository.DriverDocumentsQuery
 }
 
-func NewDriverservice(driverRepo repository.DriversQuery) Driverservice {
-	return &driverserviceImpl{driverRepo: driverRepo}
+func NewDriverservice(driverRepo repository.DriversQuery, bookingRepo repository.BookingsQuery, branchRepo repository.BranchesQuery, documentRepo repository.DriverDocumentsQuery) Driverservice {
+	return &driverserviceImpl{driverRepo: driverRepo, bookingRepo: bookingRepo, branchRepo: branchRepo, documentRepo: documentRepo}
 }
 
+// checkDriver validates a driver request, including that the driver's branch
+// exists.
+func (s *driverserviceImpl) checkDriver(ctx context.Context, driver models.InputDriver) error {
+	errs := validation.Collect(driver)
+	if !errs.Has("branch_id") {
+		branch, err := s.branchRepo.GetBranchesByID(ctx, uint64(driver.BranchID))
+		if err != nil {
+			return err
+		}
+		if branch.ID == 0 {
+			errs.Add("branch_id", "branch not found")
+		}
+	}
+	return errs.Err()
+}
 
-func (s *driverserviceImpl) GetDrivers(ctx context.Context) ([]models.Driver, error) {
-	drivers, err := s.driverRepo.GetDrivers(ctx)
+
+func (s *driverserviceImpl) GetDrivers(ctx context.Context, includeDeleted bool) ([]models.Driver, error) {
+	drivers, err := s.driverRepo.GetDrivers(ctx, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -38,17 +63,29 @@ func (s *driverserviceImpl) GetDriversByID(ctx context.Context, id uint64) (mode
 		return models.Driver{}, err
 	}
 	if driver.ID == 0 {
-		return models.Driver{}, errors.New("driver not found")
+		return models.Driver{}, apperror.NotFound("driver")
 	}
 	return driver, nil
 }
 
 func (s *driverserviceImpl) CreateDriver(ctx context.Context, driver models.InputDriver) (models.Driver, error) {
+	if err := s.checkDriver(ctx, driver); err != nil {
+		return models.Driver{}, err
+	}
 	NewDriver := models.Driver{}
 	NewDriver.Name = driver.Name
 	NewDriver.NIK = driver.NIK
-	NewDriver.Phone = driver.Phone
+	NewDriver.Phone, _ = validation.NormalizePhone(driver.Phone)
+	NewDriver.BirthDate, NewDriver.Gender = models.NIKIdentity(driver.NIK)
 	NewDriver.DailyCost = driver.DailyCost
+	NewDriver.BranchID = driver.BranchID
+	NewDriver.LicenseNumber = driver.LicenseNumber
+	NewDriver.LicenseClass = driver.LicenseClass
+	NewDriver.IncentiveTier = driver.IncentiveTier
+	if driver.LicenseExpiry != "" {
+		licenseExpiry, _ := time.Parse(models.DateLayout, driver.LicenseExpiry)
+		NewDriver.LicenseExpiry = &licenseExpiry
+	}
 	NewDriver.CreatedAt = time.Now()
 
 	// Call repoDriversitory to create driver
@@ -60,11 +97,23 @@ func (s *driverserviceImpl) CreateDriver(ctx context.Context, driver models.Inpu
 }
 
 func (s *driverserviceImpl) EditDriver(ctx context.Context, id uint64, driver models.InputDriver) (models.Driver, error) {
+	if err := s.checkDriver(ctx, driver); err != nil {
+		return models.Driver{}, err
+	}
 	updatedDriver := models.Driver{}
 	updatedDriver.Name = driver.Name
 	updatedDriver.NIK = driver.NIK
-	updatedDriver.Phone = driver.Phone
+	updatedDriver.Phone, _ = validation.NormalizePhone(driver.Phone)
+	updatedDriver.BirthDate, updatedDriver.Gender = models.NIKIdentity(driver.NIK)
 	updatedDriver.DailyCost = driver.DailyCost
+	updatedDriver.BranchID = driver.BranchID
+	updatedDriver.LicenseNumber = driver.LicenseNumber
+	updatedDriver.LicenseClass = driver.LicenseClass
+	updatedDriver.IncentiveTier = driver.IncentiveTier
+	if driver.LicenseExpiry != "" {
+		licenseExpiry, _ := time.Parse(models.DateLayout, driver.LicenseExpiry)
+		updatedDriver.LicenseExpiry = &licenseExpiry
+	}
 	updatedDriver.UpdatedAt = time.Now()
 
 	// Call repoDriversitory to create driver
@@ -82,7 +131,16 @@ func (s *driverserviceImpl) DeleteDriver(ctx context.Context, id uint64) (models
 	}
 	// if driver doesn't exist, return
 	if driver.ID == 0 {
-		return models.Driver{}, nil
+		return models.Driver{}, apperror.NotFound("driver")
+	}
+
+	// a driver still assigned to running bookings cannot go away
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByDriverID(ctx, id)
+	if err != nil {
+		return models.Driver{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Driver{}, newDependentsConflict("driver", id, "open booking", bookingIDs)
 	}
 
 	// delete driver by id
@@ -93,3 +151,99 @@ func (s *driverserviceImpl) DeleteDriver(ctx context.Context, id uint64) (models
 
 	return driver, err
 }
+
+func (s *driverserviceImpl) RestoreDriver(ctx context.Context, id uint64) (models.Driver, error) {
+	driver, err := s.driverRepo.RestoreDriversByID(ctx, id)
+	if err != nil {
+		return models.Driver{}, err
+	}
+	if driver.ID == 0 {
+		return models.Driver{}, apperror.NotFound("driver")
+	}
+	return driver, nil
+}
+
+// GetExpiringDocuments reports the licenses and documents of every driver
+// that have run out or will within withinDays days from today, soonest
+// first, after the drivers whose license is missing altogether.
+func (s *driverserviceImpl) GetExpiringDocuments(ctx context.Context, withinDays int) ([]models.DriverExpiry, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	before := today.AddDate(0, 0, withinDays+1)
+
+	drivers, err := s.driverRepo.GetDriversWithLicenseExpiringBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := s.documentRepo.GetDriverDocumentsExpiringBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	unlicensed, err := s.driverRepo.GetDriversWithoutLicense(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiring := []models.DriverExpiry{}
+	for _, driver := range drivers {
+		expiring = append(expiring, newDriverExpiry(driver, models.DriverDocumentLicense, nil, driver.LicenseNumber, *driver.LicenseExpiry, today))
+	}
+	for _, document := range documents {
+		id := document.ID
+		expiring = append(expiring, newDriverExpiry(*document.Driver, document.Type, &id, document.Number, document.ExpiresAt, today))
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ExpiresAt.Before(*expiring[j].ExpiresAt)
+	})
+
+	missing := []models.DriverExpiry{}
+	for _, driver := range unlicensed {
+		line := models.DriverExpiry{}
+		line.DriverID = driver.ID
+		line.DriverName = driver.Name
+		line.BranchID = driver.BranchID
+		line.Document = models.DriverDocumentLicense
+		line.Number = driver.LicenseNumber
+		line.Missing = true
+		missing = append(missing, line)
+	}
+	return append(missing, expiring...), nil
+}
+
+func newDriverExpiry(driver models.Driver, document string, documentID *uint, number string, expiresAt time.Time, today time.Time) models.DriverExpiry {
+	expiry := models.DriverExpiry{}
+	expiry.DriverID = driver.ID
+	expiry.DriverName = driver.Name
+	expiry.BranchID = driver.BranchID
+	expiry.Document = document
+	expiry.DocumentID = documentID
+	expiry.Number = number
+	expiry.ExpiresAt = &expiresAt
+	expiry.DaysLeft = int(expiresAt.Sub(today).Hours() / 24)
+	expiry.Expired = expiresAt.Before(today)
+	return expiry
+}
+
+// GetDriverStats reports a driver's KPIs over the range asked for, the last
+// 30 days by default.
+func (s *driverserviceImpl) GetDriverStats(ctx context.Context, id uint64, filter models.DriverStatsFilter) (models.DriverStats, error) {
+	if _, err := s.GetDriversByID(ctx, id); err != nil {
+		return models.DriverStats{}, err
+	}
+	if err := validation.Check(filter); err != nil {
+		return models.DriverStats{}, err
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from, to := filter.Range(today)
+	stats, err := s.driverRepo.GetDriverStats(ctx, id, from, to)
+	if err != nil {
+		return models.DriverStats{}, err
+	}
+	if stats.AvailableDays > 0 {
+		stats.Utilization = math.Round(float64(stats.DaysDriven)*10000/float64(stats.AvailableDays)) / 100
+	}
+	return stats, nil
+}
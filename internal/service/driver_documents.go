@@ -0,0 +1,123 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type DriverDocumentservice interface {
+	GetDriverDocuments(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverDocument, error)
+	GetDriverDocumentsByID(ctx context.Context, id uint64) (models.DriverDocument, error)
+	CreateDriverDocument(ctx context.Context, document models.InputDriverDocument) (models.DriverDocument, error)
+	EditDriverDocument(ctx context.Context, id uint64, document models.InputDriverDocument) (models.DriverDocument, error)
+	DeleteDriverDocument(ctx context.Context, id uint64) (models.DriverDocument, error)
+	RestoreDriverDocument(ctx context.Context, id uint64) (models.DriverDocument, error)
+}
+type driverDocumentserviceImpl struct {
+	documentRepo repository.DriverDocumentsQuery
+	driverRepo   repository.DriversQuery
+}
+
+func NewDriverDocumentservice(documentRepo repository.DriverDocumentsQuery, driverRepo repository.DriversQuery) DriverDocumentservice {
+	return &driverDocumentserviceImpl{documentRepo: documentRepo, driverRepo: driverRepo}
+}
+
+func (s *driverDocumentserviceImpl) GetDriverDocuments(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverDocument, error) {
+	documents, err := s.documentRepo.GetDriverDocuments(ctx, driverID, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+func (s *driverDocumentserviceImpl) GetDriverDocumentsByID(ctx context.Context, id uint64) (models.DriverDocument, error) {
+	document, err := s.documentRepo.GetDriverDocumentsByID(ctx, id)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	if document.ID == 0 {
+		return models.DriverDocument{}, apperror.NotFound("driver document")
+	}
+	return document, nil
+}
+
+// buildDocument validates a document request and turns it into a record.
+func (s *driverDocumentserviceImpl) buildDocument(ctx context.Context, input models.InputDriverDocument) (models.DriverDocument, error) {
+	errs := validation.Collect(input)
+	if !errs.Has("driver_id") {
+		driver, err := s.driverRepo.GetDriversByID(ctx, uint64(input.DriverID))
+		if err != nil {
+			return models.DriverDocument{}, err
+		}
+		if driver.ID == 0 {
+			errs.Add("driver_id", "driver not found")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return models.DriverDocument{}, err
+	}
+
+	document := models.DriverDocument{}
+	document.DriverID = input.DriverID
+	document.Type = input.Type
+	document.Number = input.Number
+	document.ExpiresAt, _ = time.Parse(models.DateLayout, input.ExpiresAt)
+	return document, nil
+}
+
+func (s *driverDocumentserviceImpl) CreateDriverDocument(ctx context.Context, document models.InputDriverDocument) (models.DriverDocument, error) {
+	NewDocument, err := s.buildDocument(ctx, document)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	NewDocument.CreatedAt = time.Now()
+
+	createdDocument, err := s.documentRepo.CreateDriverDocuments(ctx, NewDocument)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	return createdDocument, nil
+}
+
+func (s *driverDocumentserviceImpl) EditDriverDocument(ctx context.Context, id uint64, document models.InputDriverDocument) (models.DriverDocument, error) {
+	updatedDocument, err := s.buildDocument(ctx, document)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	updatedDocument.UpdatedAt = time.Now()
+
+	updatedDocument, err = s.documentRepo.EditDriverDocuments(ctx, id, updatedDocument)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	if updatedDocument.ID == 0 {
+		return models.DriverDocument{}, apperror.NotFound("driver document")
+	}
+	return updatedDocument, nil
+}
+
+func (s *driverDocumentserviceImpl) DeleteDriverDocument(ctx context.Context, id uint64) (models.DriverDocument, error) {
+	document, err := s.GetDriverDocumentsByID(ctx, id)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	if err := s.documentRepo.DeleteDriverDocumentsByID(ctx, id); err != nil {
+		return models.DriverDocument{}, err
+	}
+	return document, nil
+}
+
+func (s *driverDocumentserviceImpl) RestoreDriverDocument(ctx context.Context, id uint64) (models.DriverDocument, error) {
+	document, err := s.documentRepo.RestoreDriverDocumentsByID(ctx, id)
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	if document.ID == 0 {
+		return models.DriverDocument{}, apperror.NotFound("driver document")
+	}
+	return document, nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type DriverLeaveservice interface {
+	GetDriverLeaves(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverLeave, error)
+	GetDriverLeavesByID(ctx context.Context, id uint64) (models.DriverLeave, error)
+	CreateDriverLeave(ctx context.Context, leave models.InputDriverLeave) (models.DriverLeave, error)
+	EditDriverLeave(ctx context.Context, id uint64, leave models.InputDriverLeave) (models.DriverLeave, error)
+	DeleteDriverLeave(ctx context.Context, id uint64) (models.DriverLeave, error)
+	RestoreDriverLeave(ctx context.Context, id uint64) (models.DriverLeave, error)
+}
+type driverLeaveserviceImpl struct {
+	leaveRepo  repository.DriverLeavesQuery
+	driverRepo repository.DriversQuery
+}
+
+func NewDriverLeaveservice(leaveRepo repository.DriverLeavesQuery, driverRepo repository.DriversQuery) DriverLeaveservice {
+	return &driverLeaveserviceImpl{leaveRepo: leaveRepo, driverRepo: driverRepo}
+}
+
+func (s *driverLeaveserviceImpl) GetDriverLeaves(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverLeave, error) {
+	leaves, err := s.leaveRepo.GetDriverLeaves(ctx, driverID, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return leaves, nil
+}
+
+func (s *driverLeaveserviceImpl) GetDriverLeavesByID(ctx context.Context, id uint64) (models.DriverLeave, error) {
+	leave, err := s.leaveRepo.GetDriverLeavesByID(ctx, id)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	if leave.ID == 0 {
+		return models.DriverLeave{}, apperror.NotFound("driver leave")
+	}
+	return leave, nil
+}
+
+// buildLeave validates a leave request and turns it into a leave record.
+func (s *driverLeaveserviceImpl) buildLeave(ctx context.Context, input models.InputDriverLeave) (models.DriverLeave, error) {
+	errs := validation.Collect(input)
+	if !errs.Has("driver_id") {
+		driver, err := s.driverRepo.GetDriversByID(ctx, uint64(input.DriverID))
+		if err != nil {
+			return models.DriverLeave{}, err
+		}
+		if driver.ID == 0 {
+			errs.Add("driver_id", "driver not found")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return models.DriverLeave{}, err
+	}
+
+	leave := models.DriverLeave{}
+	leave.DriverID = input.DriverID
+	leave.StartDate, _ = time.Parse(models.DateLayout, input.StartDate)
+	leave.EndDate, _ = time.Parse(models.DateLayout, input.EndDate)
+	leave.Reason = input.Reason
+	return leave, nil
+}
+
+func (s *driverLeaveserviceImpl) CreateDriverLeave(ctx context.Context, leave models.InputDriverLeave) (models.DriverLeave, error) {
+	NewLeave, err := s.buildLeave(ctx, leave)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	NewLeave.CreatedAt = time.Now()
+
+	createdLeave, err := s.leaveRepo.CreateDriverLeaves(ctx, NewLeave)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	return createdLeave, nil
+}
+
+func (s *driverLeaveserviceImpl) EditDriverLeave(ctx context.Context, id uint64, leave models.InputDriverLeave) (models.DriverLeave, error) {
+	updatedLeave, err := s.buildLeave(ctx, leave)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	updatedLeave.UpdatedAt = time.Now()
+
+	updatedLeave, err = s.leaveRepo.EditDriverLeaves(ctx, id, updatedLeave)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	if updatedLeave.ID == 0 {
+		return models.DriverLeave{}, apperror.NotFound("driver leave")
+	}
+	return updatedLeave, nil
+}
+
+func (s *driverLeaveserviceImpl) DeleteDriverLeave(ctx context.Context, id uint64) (models.DriverLeave, error) {
+	leave, err := s.GetDriverLeavesByID(ctx, id)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	if err := s.leaveRepo.DeleteDriverLeavesByID(ctx, id); err != nil {
+		return models.DriverLeave{}, err
+	}
+	return leave, nil
+}
+
+func (s *driverLeaveserviceImpl) RestoreDriverLeave(ctx context.Context, id uint64) (models.DriverLeave, error) {
+	leave, err := s.leaveRepo.RestoreDriverLeavesByID(ctx, id)
+	if err != nil {
+		return models.DriverLeave{}, err
+	}
+	if leave.ID == 0 {
+		return models.DriverLeave{}, apperror.NotFound("driver leave")
+	}
+	return leave, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"fmt"
+	"time"
+)
+
+type DriverScheduleservice interface {
+	GetDriverSchedules(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverSchedule, error)
+	GetDriverSchedulesByID(ctx context.Context, id uint64) (models.DriverSchedule, error)
+	CreateDriverSchedule(ctx context.Context, schedule models.InputDriverSchedule) (models.DriverSchedule, error)
+	EditDriverSchedule(ctx context.Context, id uint64, schedule models.InputDriverSchedule) (models.DriverSchedule, error)
+	DeleteDriverSchedule(ctx context.Context, id uint64) (models.DriverSchedule, error)
+	RestoreDriverSchedule(ctx context.Context, id uint64) (models.DriverSchedule, error)
+}
+type driverScheduleserviceImpl struct {
+	scheduleRepo repository.DriverSchedulesQuery
+	driverRepo   repository.DriversQuery
+}
+
+func NewDriverScheduleservice(scheduleRepo repository.DriverSchedulesQuery, driverRepo repository.DriversQuery) DriverScheduleservice {
+	return &driverScheduleserviceImpl{scheduleRepo: scheduleRepo, driverRepo: driverRepo}
+}
+
+func (s *driverScheduleserviceImpl) GetDriverSchedules(ctx context.Context, driverID uint64, includeDeleted bool) ([]models.DriverSchedule, error) {
+	schedules, err := s.scheduleRepo.GetDriverSchedules(ctx, driverID, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+func (s *driverScheduleserviceImpl) GetDriverSchedulesByID(ctx context.Context, id uint64) (models.DriverSchedule, error) {
+	schedule, err := s.scheduleRepo.GetDriverSchedulesByID(ctx, id)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	if schedule.ID == 0 {
+		return models.DriverSchedule{}, apperror.NotFound("driver schedule")
+	}
+	return schedule, nil
+}
+
+// buildSchedule validates a shift request and turns it into a schedule.
+// scheduleID is the shift being edited, 0 for a new one, so it does not
+// clash with itself. A driver works at most one shift a day.
+func (s *driverScheduleserviceImpl) buildSchedule(ctx context.Context, scheduleID uint64, input models.InputDriverSchedule) (models.DriverSchedule, error) {
+	errs := validation.Collect(input)
+	if !errs.Has("driver_id") {
+		driver, err := s.driverRepo.GetDriversByID(ctx, uint64(input.DriverID))
+		if err != nil {
+			return models.DriverSchedule{}, err
+		}
+		if driver.ID == 0 {
+			errs.Add("driver_id", "driver not found")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return models.DriverSchedule{}, err
+	}
+
+	schedules, err := s.scheduleRepo.GetDriverSchedulesByDriverID(ctx, uint64(input.DriverID))
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	for _, existing := range schedules {
+		if existing.Weekday == *input.Weekday && uint64(existing.ID) != scheduleID {
+			return models.DriverSchedule{}, apperror.Conflict(fmt.Sprintf("driver %d already has a shift on %s (schedule %d)",
+				input.DriverID, time.Weekday(existing.Weekday), existing.ID)).WithCode("schedule_exists")
+		}
+	}
+
+	schedule := models.DriverSchedule{}
+	schedule.DriverID = input.DriverID
+	schedule.Weekday = *input.Weekday
+	schedule.StartTime = input.StartTime
+	schedule.EndTime = input.EndTime
+	return schedule, nil
+}
+
+func (s *driverScheduleserviceImpl) CreateDriverSchedule(ctx context.Context, schedule models.InputDriverSchedule) (models.DriverSchedule, error) {
+	NewSchedule, err := s.buildSchedule(ctx, 0, schedule)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	NewSchedule.CreatedAt = time.Now()
+
+	createdSchedule, err := s.scheduleRepo.CreateDriverSchedules(ctx, NewSchedule)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	return createdSchedule, nil
+}
+
+func (s *driverScheduleserviceImpl) EditDriverSchedule(ctx context.Context, id uint64, schedule models.InputDriverSchedule) (models.DriverSchedule, error) {
+	updatedSchedule, err := s.buildSchedule(ctx, id, schedule)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	updatedSchedule.UpdatedAt = time.Now()
+
+	updatedSchedule, err = s.scheduleRepo.EditDriverSchedules(ctx, id, updatedSchedule)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	if updatedSchedule.ID == 0 {
+		return models.DriverSchedule{}, apperror.NotFound("driver schedule")
+	}
+	return updatedSchedule, nil
+}
+
+func (s *driverScheduleserviceImpl) DeleteDriverSchedule(ctx context.Context, id uint64) (models.DriverSchedule, error) {
+	schedule, err := s.GetDriverSchedulesByID(ctx, id)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	if err := s.scheduleRepo.DeleteDriverSchedulesByID(ctx, id); err != nil {
+		return models.DriverSchedule{}, err
+	}
+	return schedule, nil
+}
+
+func (s *driverScheduleserviceImpl) RestoreDriverSchedule(ctx context.Context, id uint64) (models.DriverSchedule, error) {
+	schedule, err := s.scheduleRepo.RestoreDriverSchedulesByID(ctx, id)
+	if err != nil {
+		return models.DriverSchedule{}, err
+	}
+	if schedule.ID == 0 {
+		return models.DriverSchedule{}, apperror.NotFound("driver schedule")
+	}
+	return schedule, nil
+}
@@ -3,19 +3,22 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
-	"errors"
+	"fmt"
 	"time"
 )
 
 type DriversIncentiveservice interface {
-	GetDriversIncentive(ctx context.Context) ([]models.DriverIncentive, error)
+	GetDriversIncentive(ctx context.Context, includeDeleted bool) ([]models.DriverIncentive, error)
 	GetDriversIncentiveByID(ctx context.Context, id uint64) (models.DriverIncentive, error)
 	CreateDriverIncentive(ctx context.Context, driverIncentive models.InputDriverIncentive) (models.DriverIncentive, error)
 	EditDriverIncentive(ctx context.Context, id uint64, driverIncentive models.InputDriverIncentive) (models.DriverIncentive, error)
 	DeleteDriverIncentive(ctx context.Context, id uint64) (models.DriverIncentive, error)
-	GetDriverIncentivesByDriverID(ctx context.Context, id uint64) ([]models.DriverIncentive, error)
-	GetTotalDriversIncentiveByDriverID(ctx context.Context, id uint64) (float64, error) 
+	RestoreDriverIncentive(ctx context.Context, id uint64) (models.DriverIncentive, error)
+	GetDriverIncentivesByDriverID(ctx context.Context, id uint64, filter models.IncentiveFilter) ([]models.DriverIncentive, int64, error)
+	GetTotalDriversIncentiveByDriverID(ctx context.Context, id uint64, filter models.IncentiveFilter) (int64, error)
 }
 type driversIncentiveerviceImpl struct {
 	driverIncentiveRepo repository.DriversIncentiveQuery
@@ -26,8 +29,8 @@ func NewDriversIncentiveervice(driverIncentiveRepo repository.DriversIncentiveQu
 }
 
 
-func (s *driversIncentiveerviceImpl) GetDriversIncentive(ctx context.Context) ([]models.DriverIncentive, error) {
-	driversIncentive, err := s.driverIncentiveRepo.GetDriversIncentive(ctx)
+func (s *driversIncentiveerviceImpl) GetDriversIncentive(ctx context.Context, includeDeleted bool) ([]models.DriverIncentive, error) {
+	driversIncentive, err := s.driverIncentiveRepo.GetDriversIncentive(ctx, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -41,12 +44,15 @@ func (s *driversIncentiveerviceImpl) GetDriversIncentiveByID(ctx context.Context
 		return models.DriverIncentive{}, err
 	}
 	if driverIncentive.ID == 0 {
-		return models.DriverIncentive{}, errors.New("driverIncentive not found")
+		return models.DriverIncentive{}, apperror.NotFound("driver incentive")
 	}
 	return driverIncentive, nil
 }
 
 func (s *driversIncentiveerviceImpl) CreateDriverIncentive(ctx context.Context, driverIncentive models.InputDriverIncentive) (models.DriverIncentive, error) {
+	if err := validation.Check(driverIncentive); err != nil {
+		return models.DriverIncentive{}, err
+	}
 	NewDriverIncentive := models.DriverIncentive{}
 	NewDriverIncentive.BookingID = &driverIncentive.BookingID
 	NewDriverIncentive.Incentive = driverIncentive.Incentive
@@ -60,12 +66,22 @@ func (s *driversIncentiveerviceImpl) CreateDriverIncentive(ctx context.Context,
 }
 
 func (s *driversIncentiveerviceImpl) EditDriverIncentive(ctx context.Context, id uint64, driverIncentive models.InputDriverIncentive) (models.DriverIncentive, error) {
+	if err := validation.Check(driverIncentive); err != nil {
+		return models.DriverIncentive{}, err
+	}
+	existing, err := s.GetDriversIncentiveByID(ctx, id)
+	if err != nil {
+		return models.DriverIncentive{}, err
+	}
+	if existing.PaidAt != nil {
+		return models.DriverIncentive{}, errIncentivePaid(existing)
+	}
 	updatedDriverIncentive := models.DriverIncentive{}
 	updatedDriverIncentive.BookingID = &driverIncentive.BookingID
 	updatedDriverIncentive.Incentive = driverIncentive.Incentive
 	updatedDriverIncentive.UpdatedAt = time.Now()
 
-	updatedDriverIncentive, err := s.driverIncentiveRepo.EditDriversIncentive(ctx, id, updatedDriverIncentive)
+	updatedDriverIncentive, err = s.driverIncentiveRepo.EditDriversIncentive(ctx, id, updatedDriverIncentive)
 	if err != nil {
 		return models.DriverIncentive{}, err
 	}
@@ -78,7 +94,10 @@ func (s *driversIncentiveerviceImpl) DeleteDriverIncentive(ctx context.Context,
 		return models.DriverIncentive{}, err
 	}
 	if driverIncentive.ID == 0 {
-		return models.DriverIncentive{}, nil
+		return models.DriverIncentive{}, apperror.NotFound("driver incentive")
+	}
+	if driverIncentive.PaidAt != nil {
+		return models.DriverIncentive{}, errIncentivePaid(driverIncentive)
 	}
 
 	err = s.driverIncentiveRepo.DeleteDriversIncentiveByID(ctx, id)
@@ -89,44 +108,46 @@ func (s *driversIncentiveerviceImpl) DeleteDriverIncentive(ctx context.Context,
 	return driverIncentive, err
 }
 
-func (s *driversIncentiveerviceImpl) GetTotalDriversIncentiveByDriverID(ctx context.Context, id uint64) (float64, error) {
-	allIncentive, err := s.driverIncentiveRepo.GetDriversIncentive(ctx)
+// errIncentivePaid reports that an incentive can no longer change because it
+// was paid out.
+func errIncentivePaid(incentive models.DriverIncentive) error {
+	return apperror.Conflict(fmt.Sprintf("incentive was already paid out in payout period %d", *incentive.PayoutPeriodID)).
+		WithCode("incentive_paid")
+}
+
+// GetTotalDriversIncentiveByDriverID adds up what a driver earned in
+// incentives on bookings ending within the filter's range.
+func (s *driversIncentiveerviceImpl) GetTotalDriversIncentiveByDriverID(ctx context.Context, id uint64, filter models.IncentiveFilter) (int64, error) {
+	if err := validation.Check(filter); err != nil {
+		return 0, err
+	}
+	total, err := s.driverIncentiveRepo.SumDriversIncentiveByDriverID(ctx, id, filter)
 	if err != nil {
 		return 0, err
 	}
+	return total, nil
+}
 
-	var totalIncentive float64
-
-	for _, incentive := range allIncentive {
-		if incentive.Booking.DriverID != nil && uint64(*incentive.Booking.DriverID) == id {
-			totalIncentive += float64(incentive.Incentive) 
-		}
+// GetDriverIncentivesByDriverID returns one page of a driver's incentives on
+// bookings ending within the filter's range, and how many there are in all.
+func (s *driversIncentiveerviceImpl) GetDriverIncentivesByDriverID(ctx context.Context, id uint64, filter models.IncentiveFilter) ([]models.DriverIncentive, int64, error) {
+	if err := validation.Check(filter); err != nil {
+		return nil, 0, err
 	}
-
-	if totalIncentive == 0 {
-		return 0, errors.New("incentive not found for this driver")
+	driverIncentives, total, err := s.driverIncentiveRepo.GetDriversIncentiveByDriverID(ctx, id, filter)
+	if err != nil {
+		return nil, 0, err
 	}
-
-	return totalIncentive, nil
+	return driverIncentives, total, nil
 }
 
-func (s *driversIncentiveerviceImpl) GetDriverIncentivesByDriverID(ctx context.Context, id uint64) ([]models.DriverIncentive, error) {
-	allIncentive, err := s.driverIncentiveRepo.GetDriversIncentive(ctx)
+func (s *driversIncentiveerviceImpl) RestoreDriverIncentive(ctx context.Context, id uint64) (models.DriverIncentive, error) {
+	driverIncentive, err := s.driverIncentiveRepo.RestoreDriversIncentiveByID(ctx, id)
 	if err != nil {
-		return nil, err
-	}
-
-	var driverIncentives []models.DriverIncentive
-
-	for _, incentive := range allIncentive {
-		if incentive.Booking.DriverID != nil && uint64(*incentive.Booking.DriverID) == id {
-			driverIncentives = append(driverIncentives, incentive)
-		}
+		return models.DriverIncentive{}, err
 	}
-
-	if len(driverIncentives) == 0 {
-		return nil, errors.New("no incentives found for this driver")
+	if driverIncentive.ID == 0 {
+		return models.DriverIncentive{}, apperror.NotFound("driver incentive")
 	}
-
-	return driverIncentives, nil
+	return driverIncentive, nil
 }
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+)
+
+// maxListedDependents caps how many blocking records a conflict spells out,
+// so deleting a popular membership does not return thousands of lines.
+const maxListedDependents = 20
+
+// newDependentsConflict builds the error for "<resource> <id> is used by
+// <n> <dependent>s", listing the blocking records by ID.
+func newDependentsConflict(resource string, id uint64, dependent string, ids []uint) *apperror.Error {
+	noun := dependent
+	if len(ids) != 1 {
+		noun += "s"
+	}
+	field := strings.ReplaceAll(dependent, " ", "_") + "s"
+	dependents := []pkg.FieldError{}
+	for i, depID := range ids {
+		if i == maxListedDependents {
+			dependents = append(dependents, pkg.FieldError{Field: field, Message: fmt.Sprintf("and %d more", len(ids)-maxListedDependents)})
+			break
+		}
+		dependents = append(dependents, pkg.FieldError{Field: field, Message: fmt.Sprintf("%s %d", dependent, depID)})
+	}
+	return apperror.Conflict(fmt.Sprintf("%s %d is used by %d %s", resource, id, len(ids), noun), dependents...).
+		WithCode(strings.ReplaceAll(resource, " ", "_") + "_in_use")
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type Extraservice interface {
+	GetExtras(ctx context.Context, includeDeleted bool) ([]models.Extra, error)
+	GetExtrasByID(ctx context.Context, id uint64) (models.Extra, error)
+	CreateExtra(ctx context.Context, extra models.InputExtra) (models.Extra, error)
+	EditExtra(ctx context.Context, id uint64, extra models.InputExtra) (models.Extra, error)
+	DeleteExtra(ctx context.Context, id uint64) (models.Extra, error)
+	RestoreExtra(ctx context.Context, id uint64) (models.Extra, error)
+}
+type extraserviceImpl struct {
+	extraRepo   repository.ExtrasQuery
+	bookingRepo repository.BookingsQuery
+}
+
+func NewExtraservice(extraRepo repository.ExtrasQuery, bookingRepo repository.BookingsQuery) Extraservice {
+	return &extraserviceImpl{extraRepo: extraRepo, bookingRepo: bookingRepo}
+}
+
+func (s *extraserviceImpl) GetExtras(ctx context.Context, includeDeleted bool) ([]models.Extra, error) {
+	extras, err := s.extraRepo.GetExtras(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return extras, nil
+}
+
+func (s *extraserviceImpl) GetExtrasByID(ctx context.Context, id uint64) (models.Extra, error) {
+	extra, err := s.extraRepo.GetExtrasByID(ctx, id)
+	if err != nil {
+		return models.Extra{}, err
+	}
+	if extra.ID == 0 {
+		return models.Extra{}, apperror.NotFound("extra")
+	}
+	return extra, nil
+}
+
+func (s *extraserviceImpl) CreateExtra(ctx context.Context, extra models.InputExtra) (models.Extra, error) {
+	if err := validation.Check(extra); err != nil {
+		return models.Extra{}, err
+	}
+	NewExtra := models.Extra{}
+	NewExtra.Name = extra.Name
+	NewExtra.Description = extra.Description
+	NewExtra.Pricing = extra.Pricing
+	NewExtra.Price = extra.Price
+	NewExtra.Stock = extra.Stock
+	NewExtra.CreatedAt = time.Now()
+
+	createdExtra, err := s.extraRepo.CreateExtras(ctx, NewExtra)
+	if err != nil {
+		return models.Extra{}, err
+	}
+	return createdExtra, nil
+}
+
+func (s *extraserviceImpl) EditExtra(ctx context.Context, id uint64, extra models.InputExtra) (models.Extra, error) {
+	if err := validation.Check(extra); err != nil {
+		return models.Extra{}, err
+	}
+	updatedExtra := models.Extra{}
+	updatedExtra.Name = extra.Name
+	updatedExtra.Description = extra.Description
+	updatedExtra.Pricing = extra.Pricing
+	updatedExtra.Price = extra.Price
+	updatedExtra.Stock = extra.Stock
+	updatedExtra.UpdatedAt = time.Now()
+
+	updatedExtra, err := s.extraRepo.EditExtras(ctx, id, updatedExtra)
+	if err != nil {
+		return models.Extra{}, err
+	}
+	if updatedExtra.ID == 0 {
+		return models.Extra{}, apperror.NotFound("extra")
+	}
+	return updatedExtra, nil
+}
+
+func (s *extraserviceImpl) DeleteExtra(ctx context.Context, id uint64) (models.Extra, error) {
+	extra, err := s.GetExtrasByID(ctx, id)
+	if err != nil {
+		return models.Extra{}, err
+	}
+
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByExtraID(ctx, id)
+	if err != nil {
+		return models.Extra{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Extra{}, newDependentsConflict("extra", id, "open booking", bookingIDs)
+	}
+
+	if err := s.extraRepo.DeleteExtrasByID(ctx, id); err != nil {
+		return models.Extra{}, err
+	}
+	return extra, nil
+}
+
+func (s *extraserviceImpl) RestoreExtra(ctx context.Context, id uint64) (models.Extra, error) {
+	extra, err := s.extraRepo.RestoreExtrasByID(ctx, id)
+	if err != nil {
+		return models.Extra{}, err
+	}
+	if extra.ID == 0 {
+		return models.Extra{}, apperror.NotFound("extra")
+	}
+	return extra, nil
+}
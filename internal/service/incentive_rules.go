@@ -0,0 +1,116 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type IncentiveRuleservice interface {
+	GetIncentiveRules(ctx context.Context, includeDeleted bool) ([]models.IncentiveRule, error)
+	GetIncentiveRulesByID(ctx context.Context, id uint64) (models.IncentiveRule, error)
+	GetCurrentIncentiveRule(ctx context.Context) (models.IncentiveRule, error)
+	CreateIncentiveRule(ctx context.Context, rule models.InputIncentiveRule) (models.IncentiveRule, error)
+	DeleteIncentiveRule(ctx context.Context, id uint64) (models.IncentiveRule, error)
+	RestoreIncentiveRule(ctx context.Context, id uint64) (models.IncentiveRule, error)
+}
+type incentiveRuleserviceImpl struct {
+	ruleRepo repository.IncentiveRulesQuery
+}
+
+func NewIncentiveRuleservice(ruleRepo repository.IncentiveRulesQuery) IncentiveRuleservice {
+	return &incentiveRuleserviceImpl{ruleRepo: ruleRepo}
+}
+
+func (s *incentiveRuleserviceImpl) GetIncentiveRules(ctx context.Context, includeDeleted bool) ([]models.IncentiveRule, error) {
+	rules, err := s.ruleRepo.GetIncentiveRules(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *incentiveRuleserviceImpl) GetIncentiveRulesByID(ctx context.Context, id uint64) (models.IncentiveRule, error) {
+	rule, err := s.ruleRepo.GetIncentiveRulesByID(ctx, id)
+	if err != nil {
+		return models.IncentiveRule{}, err
+	}
+	if rule.ID == 0 {
+		return models.IncentiveRule{}, apperror.NotFound("incentive rule")
+	}
+	return rule, nil
+}
+
+// GetCurrentIncentiveRule returns the version new bookings are rewarded
+// under.
+func (s *incentiveRuleserviceImpl) GetCurrentIncentiveRule(ctx context.Context) (models.IncentiveRule, error) {
+	rule, err := s.ruleRepo.GetCurrentIncentiveRule(ctx)
+	if err != nil {
+		return models.IncentiveRule{}, err
+	}
+	if rule.ID == 0 {
+		return models.IncentiveRule{}, apperror.NotFound("incentive rule")
+	}
+	return rule, nil
+}
+
+// CreateIncentiveRule publishes a new version of the rules, which applies to
+// every booking made from now on.
+func (s *incentiveRuleserviceImpl) CreateIncentiveRule(ctx context.Context, rule models.InputIncentiveRule) (models.IncentiveRule, error) {
+	if err := validation.Check(rule); err != nil {
+		return models.IncentiveRule{}, err
+	}
+	now := time.Now()
+	NewRule := models.IncentiveRule{}
+	NewRule.Name = rule.Name
+	NewRule.RentPercentage = rule.RentPercentage
+	NewRule.DailyFlat = rule.DailyFlat
+	NewRule.LongTripMinDays = rule.LongTripMinDays
+	NewRule.LongTripBonus = rule.LongTripBonus
+	NewRule.WeekendDailyBonus = rule.WeekendDailyBonus
+	NewRule.RatingBonusPercentage = rule.RatingBonusPercentage
+	NewRule.RatingBonusMin = rule.RatingBonusMin
+	NewRule.RatingBonusMinCount = rule.RatingBonusMinCount
+	NewRule.CreatedAt = now
+	for _, item := range rule.Tiers {
+		tier := models.IncentiveRuleTier{}
+		tier.Tier = item.Tier
+		tier.RentPercentage = item.RentPercentage
+		tier.DailyFlat = item.DailyFlat
+		tier.CreatedAt = now
+		NewRule.Tiers = append(NewRule.Tiers, tier)
+	}
+
+	createdRule, err := s.ruleRepo.CreateIncentiveRules(ctx, NewRule)
+	if err != nil {
+		return models.IncentiveRule{}, err
+	}
+	return createdRule, nil
+}
+
+// DeleteIncentiveRule retires a version. When it was the current one, the
+// latest version left takes over.
+func (s *incentiveRuleserviceImpl) DeleteIncentiveRule(ctx context.Context, id uint64) (models.IncentiveRule, error) {
+	rule, err := s.GetIncentiveRulesByID(ctx, id)
+	if err != nil {
+		return models.IncentiveRule{}, err
+	}
+	if err := s.ruleRepo.DeleteIncentiveRulesByID(ctx, id); err != nil {
+		return models.IncentiveRule{}, err
+	}
+	return rule, nil
+}
+
+func (s *incentiveRuleserviceImpl) RestoreIncentiveRule(ctx context.Context, id uint64) (models.IncentiveRule, error) {
+	rule, err := s.ruleRepo.RestoreIncentiveRulesByID(ctx, id)
+	if err != nil {
+		return models.IncentiveRule{}, err
+	}
+	if rule.ID == 0 {
+		return models.IncentiveRule{}, apperror.NotFound("incentive rule")
+	}
+	return rule, nil
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"bytes"
+	"car-rental/internal/infrastructure"
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxImageSize caps inspection photos at 10 MB.
+const maxImageSize = 10 << 20
+
+// imageTypes are the photo formats accepted, by sniffed content type.
+var imageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// damageRank orders severities so a damage can be compared with what was
+// already there at pickup.
+var damageRank = map[string]int{
+	models.DamageMinor:    1,
+	models.DamageModerate: 2,
+	models.DamageMajor:    3,
+}
+
+type Inspectionservice interface {
+	GetInspections(ctx context.Context, bookingID uint64, includeDeleted bool) ([]models.Inspection, error)
+	GetInspectionsByID(ctx context.Context, id uint64) (models.Inspection, error)
+	CreateInspection(ctx context.Context, inspection models.InputInspection) (models.Inspection, error)
+	DeleteInspection(ctx context.Context, id uint64) (models.Inspection, error)
+	RestoreInspection(ctx context.Context, id uint64) (models.Inspection, error)
+	AddInspectionImage(ctx context.Context, id uint64, damageID *uint, fileName string, size int64, content io.Reader) (models.InspectionImage, error)
+	OpenInspectionImage(ctx context.Context, id uint64, imageID uint64) (models.InspectionImage, io.ReadCloser, error)
+	CompareInspections(ctx context.Context, bookingID uint64) (models.InspectionComparison, error)
+	ChargeDamage(ctx context.Context, bookingID uint64) (models.Booking, error)
+}
+type inspectionserviceImpl struct {
+	inspectionRepo repository.InspectionsQuery
+	bookingRepo    repository.BookingsQuery
+	storage        infrastructure.FileStorage
+}
+
+func NewInspectionservice(inspectionRepo repository.InspectionsQuery, bookingRepo repository.BookingsQuery, storage infrastructure.FileStorage) Inspectionservice {
+	return &inspectionserviceImpl{inspectionRepo: inspectionRepo, bookingRepo: bookingRepo, storage: storage}
+}
+
+func (s *inspectionserviceImpl) GetInspections(ctx context.Context, bookingID uint64, includeDeleted bool) ([]models.Inspection, error) {
+	inspections, err := s.inspectionRepo.GetInspections(ctx, bookingID, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return inspections, nil
+}
+
+func (s *inspectionserviceImpl) GetInspectionsByID(ctx context.Context, id uint64) (models.Inspection, error) {
+	inspection, err := s.inspectionRepo.GetInspectionsByID(ctx, id)
+	if err != nil {
+		return models.Inspection{}, err
+	}
+	if inspection.ID == 0 {
+		return models.Inspection{}, apperror.NotFound("inspection")
+	}
+	return inspection, nil
+}
+
+// CreateInspection records the checkout inspection of a picked up booking or
+// the checkin inspection after its checkout one. Each booking has at most
+// one of each.
+func (s *inspectionserviceImpl) CreateInspection(ctx context.Context, input models.InputInspection) (models.Inspection, error) {
+	errs := validation.Collect(input)
+	booking := models.Booking{}
+	if !errs.Has("booking_id") {
+		found, err := s.bookingRepo.GetBookingsByID(ctx, uint64(input.BookingID))
+		if err != nil {
+			return models.Inspection{}, err
+		}
+		switch {
+		case found.ID == 0:
+			errs.Add("booking_id", "booking not found")
+		case found.VehicleID == nil:
+			errs.Add("booking_id", "booking has no vehicle yet, pick it up first")
+		}
+		booking = found
+	}
+	if err := errs.Err(); err != nil {
+		return models.Inspection{}, err
+	}
+
+	existing, err := s.inspectionRepo.GetInspectionByBookingID(ctx, uint64(booking.ID), input.Kind)
+	if err != nil {
+		return models.Inspection{}, err
+	}
+	if existing.ID != 0 {
+		return models.Inspection{}, apperror.Conflict(fmt.Sprintf("booking %d already has a %s inspection", booking.ID, input.Kind)).
+			WithCode("inspection_exists")
+	}
+	if input.Kind == models.InspectionCheckin {
+		checkout, err := s.inspectionRepo.GetInspectionByBookingID(ctx, uint64(booking.ID), models.InspectionCheckout)
+		if err != nil {
+			return models.Inspection{}, err
+		}
+		if checkout.ID == 0 {
+			return models.Inspection{}, apperror.Conflict(fmt.Sprintf("booking %d has no checkout inspection", booking.ID)).
+				WithCode("checkout_missing")
+		}
+		if input.Odometer < checkout.Odometer {
+			return models.Inspection{}, apperror.Validation("request is invalid",
+				pkg.FieldError{Field: "odometer", Message: fmt.Sprintf("must be at least the checkout reading of %d", checkout.Odometer)})
+		}
+	}
+
+	now := time.Now()
+	NewInspection := models.Inspection{}
+	NewInspection.BookingID = booking.ID
+	NewInspection.VehicleID = *booking.VehicleID
+	NewInspection.Kind = input.Kind
+	NewInspection.Checklist = input.Checklist
+	if NewInspection.Checklist == nil {
+		NewInspection.Checklist = map[string]bool{}
+	}
+	NewInspection.FuelLevel = *input.FuelLevel
+	NewInspection.Odometer = input.Odometer
+	NewInspection.Notes = input.Notes
+	NewInspection.InspectedAt = now
+	NewInspection.CreatedAt = now
+	for _, damage := range input.Damages {
+		NewInspection.Damages = append(NewInspection.Damages, models.InspectionDamage{
+			Location:    damage.Location,
+			Severity:    damage.Severity,
+			Description: damage.Description,
+			RepairCost:  damage.RepairCost,
+			CreatedAt:   now,
+		})
+	}
+
+	return s.inspectionRepo.CreateInspections(ctx, NewInspection)
+}
+
+func (s *inspectionserviceImpl) DeleteInspection(ctx context.Context, id uint64) (models.Inspection, error) {
+	inspection, err := s.GetInspectionsByID(ctx, id)
+	if err != nil {
+		return models.Inspection{}, err
+	}
+	if err := s.inspectionRepo.DeleteInspectionsByID(ctx, id); err != nil {
+		return models.Inspection{}, err
+	}
+	return inspection, nil
+}
+
+// RestoreInspection brings back a deleted inspection unless its booking got
+// a new one of the same kind in the meantime.
+func (s *inspectionserviceImpl) RestoreInspection(ctx context.Context, id uint64) (models.Inspection, error) {
+	deleted, err := s.inspectionRepo.GetInspectionsByIDWithDeleted(ctx, id)
+	if err != nil {
+		return models.Inspection{}, err
+	}
+	if deleted.ID == 0 {
+		return models.Inspection{}, apperror.NotFound("inspection")
+	}
+	current, err := s.inspectionRepo.GetInspectionByBookingID(ctx, uint64(deleted.BookingID), deleted.Kind)
+	if err != nil {
+		return models.Inspection{}, err
+	}
+	if current.ID != 0 && current.ID != deleted.ID {
+		return models.Inspection{}, apperror.Conflict(fmt.Sprintf("booking %d already has a %s inspection", deleted.BookingID, deleted.Kind)).
+			WithCode("inspection_exists")
+	}
+	return s.inspectionRepo.RestoreInspectionsByID(ctx, id)
+}
+
+// AddInspectionImage stores a photo for an inspection, optionally tied to
+// one of its damages. Only JPEG, PNG and WebP images up to 10 MB are taken.
+func (s *inspectionserviceImpl) AddInspectionImage(ctx context.Context, id uint64, damageID *uint, fileName string, size int64, content io.Reader) (models.InspectionImage, error) {
+	inspection, err := s.GetInspectionsByID(ctx, id)
+	if err != nil {
+		return models.InspectionImage{}, err
+	}
+
+	errs := &validation.Errors{}
+	if damageID != nil && !hasDamage(inspection, *damageID) {
+		errs.Add("damage_id", "damage not found on this inspection")
+	}
+	if size > maxImageSize {
+		errs.Add("image", "must be at most 10 MB")
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return models.InspectionImage{}, err
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	if !imageTypes[contentType] {
+		errs.Add("image", "must be a JPEG, PNG or WebP image")
+	}
+	if err := errs.Err(); err != nil {
+		return models.InspectionImage{}, err
+	}
+
+	key, err := s.storage.Save(ctx, fileName, io.MultiReader(bytes.NewReader(head), content))
+	if err != nil {
+		return models.InspectionImage{}, err
+	}
+	image := models.InspectionImage{}
+	image.InspectionID = inspection.ID
+	image.DamageID = damageID
+	image.StorageKey = key
+	image.FileName = fileName
+	image.ContentType = contentType
+	image.Size = size
+	image.CreatedAt = time.Now()
+
+	created, err := s.inspectionRepo.CreateInspectionImages(ctx, image)
+	if err != nil {
+		s.storage.Delete(ctx, key)
+		return models.InspectionImage{}, err
+	}
+	return created, nil
+}
+
+// OpenInspectionImage returns a photo and its content. The caller closes
+// the content.
+func (s *inspectionserviceImpl) OpenInspectionImage(ctx context.Context, id uint64, imageID uint64) (models.InspectionImage, io.ReadCloser, error) {
+	image, err := s.inspectionRepo.GetInspectionImagesByID(ctx, id, imageID)
+	if err != nil {
+		return models.InspectionImage{}, nil, err
+	}
+	if image.ID == 0 {
+		return models.InspectionImage{}, nil, apperror.NotFound("image")
+	}
+	content, err := s.storage.Open(ctx, image.StorageKey)
+	if err != nil {
+		return models.InspectionImage{}, nil, err
+	}
+	return image, content, nil
+}
+
+// CompareInspections sets the checkin inspection of a booking against its
+// checkout inspection. A damage found at checkin is new unless the same
+// location already had a damage at least as severe at checkout. An insured
+// customer is charged the repairs up to the excess of the plan.
+func (s *inspectionserviceImpl) CompareInspections(ctx context.Context, bookingID uint64) (models.InspectionComparison, error) {
+	booking, err := s.bookingRepo.GetBookingsByID(ctx, bookingID)
+	if err != nil {
+		return models.InspectionComparison{}, err
+	}
+	if booking.ID == 0 {
+		return models.InspectionComparison{}, apperror.NotFound("booking")
+	}
+	checkout, err := s.inspectionRepo.GetInspectionByBookingID(ctx, bookingID, models.InspectionCheckout)
+	if err != nil {
+		return models.InspectionComparison{}, err
+	}
+	checkin, err := s.inspectionRepo.GetInspectionByBookingID(ctx, bookingID, models.InspectionCheckin)
+	if err != nil {
+		return models.InspectionComparison{}, err
+	}
+	if checkout.ID == 0 || checkin.ID == 0 {
+		return models.InspectionComparison{}, apperror.Conflict(fmt.Sprintf("booking %d needs both a checkout and a checkin inspection", bookingID)).
+			WithCode("inspection_missing")
+	}
+
+	worstAtCheckout := map[string]int{}
+	for _, damage := range checkout.Damages {
+		location := damageLocation(damage.Location)
+		if damageRank[damage.Severity] > worstAtCheckout[location] {
+			worstAtCheckout[location] = damageRank[damage.Severity]
+		}
+	}
+
+	comparison := models.InspectionComparison{}
+	comparison.BookingID = booking.ID
+	comparison.Checkout = checkout
+	comparison.Checkin = checkin
+	comparison.NewDamages = []models.InspectionDamage{}
+	for _, damage := range checkin.Damages {
+		if damageRank[damage.Severity] > worstAtCheckout[damageLocation(damage.Location)] {
+			comparison.NewDamages = append(comparison.NewDamages, damage)
+			comparison.RepairCost += damage.RepairCost
+		}
+	}
+	comparison.DamageCharge = comparison.RepairCost
+	if booking.InsurancePlanID != nil && comparison.DamageCharge > booking.InsuranceExcess {
+		comparison.DamageCharge = booking.InsuranceExcess
+	}
+	comparison.MissingItems = []string{}
+	for item, ok := range checkout.Checklist {
+		if ok && !checkin.Checklist[item] {
+			comparison.MissingItems = append(comparison.MissingItems, item)
+		}
+	}
+	sort.Strings(comparison.MissingItems)
+	comparison.Distance = checkin.Odometer - checkout.Odometer
+	comparison.FuelDifference = checkin.FuelLevel - checkout.FuelLevel
+	return comparison, nil
+}
+
+// ChargeDamage bills the repair cost of the new damages found at return,
+// capped at the insurance excess, to the booking. Running it again after
+// the inspections change replaces the earlier charge, as long as the booking
+// is returned and not paid yet.
+func (s *inspectionserviceImpl) ChargeDamage(ctx context.Context, bookingID uint64) (models.Booking, error) {
+	booking, err := s.bookingRepo.GetBookingsByID(ctx, bookingID)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	switch {
+	case booking.ID == 0:
+		return models.Booking{}, apperror.NotFound("booking")
+	case booking.CancelledAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was cancelled").WithCode("booking_cancelled")
+	case booking.ReturnedAt == nil:
+		return models.Booking{}, apperror.Conflict("booking has not been returned").WithCode("booking_not_returned")
+	case booking.PaidAt != nil:
+		return models.Booking{}, apperror.Conflict("booking was already paid").WithCode("booking_paid")
+	}
+
+	comparison, err := s.CompareInspections(ctx, bookingID)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	return s.bookingRepo.SetBookingDamageCharge(ctx, bookingID, comparison.DamageCharge)
+}
+
+func hasDamage(inspection models.Inspection, damageID uint) bool {
+	for _, damage := range inspection.Damages {
+		if damage.ID == damageID {
+			return true
+		}
+	}
+	return false
+}
+
+// damageLocation normalises a location so "Front Bumper" at pickup matches
+// "front bumper " at return.
+func damageLocation(location string) string {
+	return strings.ToLower(strings.Join(strings.Fields(location), " "))
+}
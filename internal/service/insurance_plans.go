@@ -0,0 +1,125 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type InsurancePlanservice interface {
+	GetInsurancePlans(ctx context.Context, includeDeleted bool) ([]models.InsurancePlan, error)
+	GetInsurancePlansByID(ctx context.Context, id uint64) (models.InsurancePlan, error)
+	CreateInsurancePlan(ctx context.Context, plan models.InputInsurancePlan) (models.InsurancePlan, error)
+	EditInsurancePlan(ctx context.Context, id uint64, plan models.InputInsurancePlan) (models.InsurancePlan, error)
+	DeleteInsurancePlan(ctx context.Context, id uint64) (models.InsurancePlan, error)
+	RestoreInsurancePlan(ctx context.Context, id uint64) (models.InsurancePlan, error)
+}
+type insurancePlanserviceImpl struct {
+	planRepo        repository.InsurancePlansQuery
+	carCategoryRepo repository.CarCategoriesQuery
+	bookingRepo     repository.BookingsQuery
+}
+
+func NewInsurancePlanservice(planRepo repository.InsurancePlansQuery, carCategoryRepo repository.CarCategoriesQuery, bookingRepo repository.BookingsQuery) InsurancePlanservice {
+	return &insurancePlanserviceImpl{planRepo: planRepo, carCategoryRepo: carCategoryRepo, bookingRepo: bookingRepo}
+}
+
+func (s *insurancePlanserviceImpl) GetInsurancePlans(ctx context.Context, includeDeleted bool) ([]models.InsurancePlan, error) {
+	plans, err := s.planRepo.GetInsurancePlans(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+func (s *insurancePlanserviceImpl) GetInsurancePlansByID(ctx context.Context, id uint64) (models.InsurancePlan, error) {
+	plan, err := s.planRepo.GetInsurancePlansByID(ctx, id)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	if plan.ID == 0 {
+		return models.InsurancePlan{}, apperror.NotFound("insurance plan")
+	}
+	return plan, nil
+}
+
+func (s *insurancePlanserviceImpl) CreateInsurancePlan(ctx context.Context, plan models.InputInsurancePlan) (models.InsurancePlan, error) {
+	if err := validation.Check(plan); err != nil {
+		return models.InsurancePlan{}, err
+	}
+	NewPlan := models.InsurancePlan{}
+	NewPlan.Name = plan.Name
+	NewPlan.Description = plan.Description
+	NewPlan.DailyPremium = plan.DailyPremium
+	NewPlan.Excess = plan.Excess
+	NewPlan.CreatedAt = time.Now()
+
+	createdPlan, err := s.planRepo.CreateInsurancePlans(ctx, NewPlan)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	return createdPlan, nil
+}
+
+func (s *insurancePlanserviceImpl) EditInsurancePlan(ctx context.Context, id uint64, plan models.InputInsurancePlan) (models.InsurancePlan, error) {
+	if err := validation.Check(plan); err != nil {
+		return models.InsurancePlan{}, err
+	}
+	updatedPlan := models.InsurancePlan{}
+	updatedPlan.Name = plan.Name
+	updatedPlan.Description = plan.Description
+	updatedPlan.DailyPremium = plan.DailyPremium
+	updatedPlan.Excess = plan.Excess
+	updatedPlan.UpdatedAt = time.Now()
+
+	updatedPlan, err := s.planRepo.EditInsurancePlans(ctx, id, updatedPlan)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	if updatedPlan.ID == 0 {
+		return models.InsurancePlan{}, apperror.NotFound("insurance plan")
+	}
+	return updatedPlan, nil
+}
+
+func (s *insurancePlanserviceImpl) DeleteInsurancePlan(ctx context.Context, id uint64) (models.InsurancePlan, error) {
+	plan, err := s.GetInsurancePlansByID(ctx, id)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+
+	categoryIDs, err := s.carCategoryRepo.GetCarCategoryIDsByInsurancePlanID(ctx, id)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	if len(categoryIDs) > 0 {
+		return models.InsurancePlan{}, newDependentsConflict("insurance plan", id, "car category", categoryIDs)
+	}
+
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByInsurancePlanID(ctx, id)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.InsurancePlan{}, newDependentsConflict("insurance plan", id, "open booking", bookingIDs)
+	}
+
+	if err := s.planRepo.DeleteInsurancePlansByID(ctx, id); err != nil {
+		return models.InsurancePlan{}, err
+	}
+	return plan, nil
+}
+
+func (s *insurancePlanserviceImpl) RestoreInsurancePlan(ctx context.Context, id uint64) (models.InsurancePlan, error) {
+	plan, err := s.planRepo.RestoreInsurancePlansByID(ctx, id)
+	if err != nil {
+		return models.InsurancePlan{}, err
+	}
+	if plan.ID == 0 {
+		return models.InsurancePlan{}, apperror.NotFound("insurance plan")
+	}
+	return plan, nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"sort"
+	"time"
+)
+
+// Vehicles are serviced every serviceIntervalKm or serviceIntervalMonths,
+// whichever comes first.
+const (
+	serviceIntervalKm     = 10000
+	serviceIntervalMonths = 6
+)
+
+type Maintenanceservice interface {
+	GetMaintenanceRecords(ctx context.Context, vehicleID uint64, includeDeleted bool) ([]models.MaintenanceRecord, error)
+	GetMaintenanceRecordsByID(ctx context.Context, id uint64) (models.MaintenanceRecord, error)
+	CreateMaintenanceRecord(ctx context.Context, record models.InputMaintenanceRecord) (models.MaintenanceRecord, error)
+	EditMaintenanceRecord(ctx context.Context, id uint64, record models.InputMaintenanceRecord) (models.MaintenanceRecord, error)
+	DeleteMaintenanceRecord(ctx context.Context, id uint64) (models.MaintenanceRecord, error)
+	RestoreMaintenanceRecord(ctx context.Context, id uint64) (models.MaintenanceRecord, error)
+	GetDueMaintenance(ctx context.Context, withinDays int, withinKm int) ([]models.MaintenanceDue, error)
+}
+type maintenanceserviceImpl struct {
+	maintenanceRepo repository.MaintenanceQuery
+	vehicleRepo     repository.VehiclesQuery
+}
+
+func NewMaintenanceservice(maintenanceRepo repository.MaintenanceQuery, vehicleRepo repository.VehiclesQuery) Maintenanceservice {
+	return &maintenanceserviceImpl{maintenanceRepo: maintenanceRepo, vehicleRepo: vehicleRepo}
+}
+
+func (s *maintenanceserviceImpl) GetMaintenanceRecords(ctx context.Context, vehicleID uint64, includeDeleted bool) ([]models.MaintenanceRecord, error) {
+	records, err := s.maintenanceRepo.GetMaintenanceRecords(ctx, vehicleID, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (s *maintenanceserviceImpl) GetMaintenanceRecordsByID(ctx context.Context, id uint64) (models.MaintenanceRecord, error) {
+	record, err := s.maintenanceRepo.GetMaintenanceRecordsByID(ctx, id)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	if record.ID == 0 {
+		return models.MaintenanceRecord{}, apperror.NotFound("maintenance record")
+	}
+	return record, nil
+}
+
+// buildRecord validates a maintenance request and turns it into a record.
+// It also returns the vehicle the record is for.
+func (s *maintenanceserviceImpl) buildRecord(ctx context.Context, input models.InputMaintenanceRecord) (models.MaintenanceRecord, models.Vehicle, error) {
+	errs := validation.Collect(input)
+	vehicle := models.Vehicle{}
+	if !errs.Has("vehicle_id") {
+		found, err := s.vehicleRepo.GetVehiclesByID(ctx, uint64(input.VehicleID))
+		if err != nil {
+			return models.MaintenanceRecord{}, models.Vehicle{}, err
+		}
+		if found.ID == 0 {
+			errs.Add("vehicle_id", "vehicle not found")
+		}
+		vehicle = found
+	}
+	if err := errs.Err(); err != nil {
+		return models.MaintenanceRecord{}, models.Vehicle{}, err
+	}
+
+	record := models.MaintenanceRecord{}
+	record.VehicleID = input.VehicleID
+	record.Type = input.Type
+	record.PlannedStart, _ = time.Parse(models.DateLayout, input.PlannedStart)
+	record.PlannedEnd, _ = time.Parse(models.DateLayout, input.PlannedEnd)
+	if input.CompletedAt != "" {
+		completedAt, _ := time.Parse(models.DateLayout, input.CompletedAt)
+		record.CompletedAt = &completedAt
+	}
+	record.Odometer = input.Odometer
+	record.Cost = input.Cost
+	record.Workshop = input.Workshop
+	record.Notes = input.Notes
+	return record, vehicle, nil
+}
+
+// syncOdometer moves the vehicle's odometer forward to the reading taken at
+// the workshop, if that is higher.
+func (s *maintenanceserviceImpl) syncOdometer(ctx context.Context, record models.MaintenanceRecord, vehicle models.Vehicle) error {
+	if record.Odometer == nil || *record.Odometer <= vehicle.Odometer {
+		return nil
+	}
+	updated := models.Vehicle{}
+	updated.Odometer = *record.Odometer
+	updated.UpdatedAt = time.Now()
+	_, err := s.vehicleRepo.EditVehicles(ctx, uint64(vehicle.ID), updated)
+	return err
+}
+
+func (s *maintenanceserviceImpl) CreateMaintenanceRecord(ctx context.Context, input models.InputMaintenanceRecord) (models.MaintenanceRecord, error) {
+	NewRecord, vehicle, err := s.buildRecord(ctx, input)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	NewRecord.CreatedAt = time.Now()
+
+	createdRecord, err := s.maintenanceRepo.CreateMaintenanceRecords(ctx, NewRecord)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	if err := s.syncOdometer(ctx, createdRecord, vehicle); err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	return createdRecord, nil
+}
+
+func (s *maintenanceserviceImpl) EditMaintenanceRecord(ctx context.Context, id uint64, input models.InputMaintenanceRecord) (models.MaintenanceRecord, error) {
+	if _, err := s.GetMaintenanceRecordsByID(ctx, id); err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	updatedRecord, vehicle, err := s.buildRecord(ctx, input)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	updatedRecord.UpdatedAt = time.Now()
+
+	updatedRecord, err = s.maintenanceRepo.EditMaintenanceRecords(ctx, id, updatedRecord)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	if err := s.syncOdometer(ctx, updatedRecord, vehicle); err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	return updatedRecord, nil
+}
+
+func (s *maintenanceserviceImpl) DeleteMaintenanceRecord(ctx context.Context, id uint64) (models.MaintenanceRecord, error) {
+	record, err := s.GetMaintenanceRecordsByID(ctx, id)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	if err := s.maintenanceRepo.DeleteMaintenanceRecordsByID(ctx, id); err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	return record, nil
+}
+
+func (s *maintenanceserviceImpl) RestoreMaintenanceRecord(ctx context.Context, id uint64) (models.MaintenanceRecord, error) {
+	record, err := s.maintenanceRepo.RestoreMaintenanceRecordsByID(ctx, id)
+	if err != nil {
+		return models.MaintenanceRecord{}, err
+	}
+	if record.ID == 0 {
+		return models.MaintenanceRecord{}, apperror.NotFound("maintenance record")
+	}
+	return record, nil
+}
+
+// GetDueMaintenance lists the vehicles whose next service is due, or will be
+// within withinDays or withinKm, overdue ones first. A vehicle that was never
+// serviced counts from its registration at 0 km.
+func (s *maintenanceserviceImpl) GetDueMaintenance(ctx context.Context, withinDays int, withinKm int) ([]models.MaintenanceDue, error) {
+	vehicles, err := s.vehicleRepo.GetVehicles(ctx, 0, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	lastServices, err := s.maintenanceRepo.GetLastServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastByVehicle := map[uint]models.MaintenanceRecord{}
+	for _, record := range lastServices {
+		lastByVehicle[record.VehicleID] = record
+	}
+	scheduledIDs, err := s.maintenanceRepo.GetScheduledServiceVehicleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scheduled := map[uint]bool{}
+	for _, id := range scheduledIDs {
+		scheduled[id] = true
+	}
+
+	now := time.Now()
+	horizon := now.AddDate(0, 0, withinDays)
+	dues := []models.MaintenanceDue{}
+	for _, vehicle := range vehicles {
+		if vehicle.Status == models.VehicleStatusRetired {
+			continue
+		}
+		due := models.MaintenanceDue{Vehicle: vehicle, Scheduled: scheduled[vehicle.ID]}
+		since := vehicle.CreatedAt
+		if last, ok := lastByVehicle[vehicle.ID]; ok {
+			since = *last.CompletedAt
+			due.LastServiceAt = last.CompletedAt
+			if last.Odometer != nil {
+				due.LastServiceOdometer = *last.Odometer
+			}
+		}
+		due.DueAt = since.AddDate(0, serviceIntervalMonths, 0)
+		due.DueOdometer = due.LastServiceOdometer + serviceIntervalKm
+		if due.DueAt.After(horizon) && vehicle.Odometer+withinKm < due.DueOdometer {
+			continue
+		}
+		due.Overdue = !due.DueAt.After(now) || vehicle.Odometer >= due.DueOdometer
+		dues = append(dues, due)
+	}
+
+	sort.SliceStable(dues, func(i, j int) bool {
+		if dues[i].Overdue != dues[j].Overdue {
+			return dues[i].Overdue
+		}
+		return dues[i].DueAt.Before(dues[j].DueAt)
+	})
+	return dues, nil
+}
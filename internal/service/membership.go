@@ -3,29 +3,32 @@ package service
 import (
 	"car-rental/internal/models"
 	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
 	"context"
-	"errors"
 	"time"
 )
 
 type Membershipservice interface {
-	GetMemberships(ctx context.Context) ([]models.Membership, error)
+	GetMemberships(ctx context.Context, includeDeleted bool) ([]models.Membership, error)
 	GetMembershipsByID(ctx context.Context, id uint64) (models.Membership, error)
 	CreateMembership(ctx context.Context, membership models.InputMembership) (models.Membership, error)
 	EditMembership(ctx context.Context, id uint64, membership models.InputMembership) (models.Membership, error)
-	DeleteMembership(ctx context.Context, id uint64) (models.Membership, error)
+	DeleteMembership(ctx context.Context, id uint64, reassignTo *uint64) (models.Membership, error)
+	RestoreMembership(ctx context.Context, id uint64) (models.Membership, error)
 }
 type membershipserviceImpl struct {
 	membershipRepo repository.MembershipQuery
+	customerRepo   repository.CustomersQuery
 }
 
-func NewMembershipservice(membershipRepo repository.MembershipQuery) Membershipservice {
-	return &membershipserviceImpl{membershipRepo: membershipRepo}
+func NewMembershipservice(membershipRepo repository.MembershipQuery, customerRepo repository.CustomersQuery) Membershipservice {
+	return &membershipserviceImpl{membershipRepo: membershipRepo, customerRepo: customerRepo}
 }
 
 
-func (s *membershipserviceImpl) GetMemberships(ctx context.Context) ([]models.Membership, error) {
-	memberships, err := s.membershipRepo.GetMembership(ctx)
+func (s *membershipserviceImpl) GetMemberships(ctx context.Context, includeDeleted bool) ([]models.Membership, error) {
+	memberships, err := s.membershipRepo.GetMembership(ctx, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -38,12 +41,15 @@ func (s *membershipserviceImpl) GetMembershipsByID(ctx context.Context, id uint6
 		return models.Membership{}, err
 	}
 	if membership.ID == 0 {
-		return models.Membership{}, errors.New("membership not found")
+		return models.Membership{}, apperror.NotFound("membership")
 	}
 	return membership, nil
 }
 
 func (s *membershipserviceImpl) CreateMembership(ctx context.Context, membership models.InputMembership) (models.Membership, error) {
+	if err := validation.Check(membership); err != nil {
+		return models.Membership{}, err
+	}
 	NewMembership := models.Membership{}
 	NewMembership.MembershipName = membership.MembershipName
 	NewMembership.Discount = membership.Discount
@@ -57,6 +63,9 @@ func (s *membershipserviceImpl) CreateMembership(ctx context.Context, membership
 }
 
 func (s *membershipserviceImpl) EditMembership(ctx context.Context, id uint64, membership models.InputMembership) (models.Membership, error) {
+	if err := validation.Check(membership); err != nil {
+		return models.Membership{}, err
+	}
 	updatedMembership := models.Membership{}
 	updatedMembership.MembershipName = membership.MembershipName
 	updatedMembership.Discount = membership.Discount
@@ -69,14 +78,40 @@ func (s *membershipserviceImpl) EditMembership(ctx context.Context, id uint64, m
 	return updatedMembership, nil
 }
 
-func (s *membershipserviceImpl) DeleteMembership(ctx context.Context, id uint64) (models.Membership, error) {
+// DeleteMembership removes a membership. Customers holding it block the
+// delete unless reassignTo names another membership to move them to first.
+func (s *membershipserviceImpl) DeleteMembership(ctx context.Context, id uint64, reassignTo *uint64) (models.Membership, error) {
 	membership, err := s.membershipRepo.GetMembershipByID(ctx, id)
 	if err != nil {
 		return models.Membership{}, err
 	}
 	if membership.ID == 0 {
-		return models.Membership{}, nil
+		return models.Membership{}, apperror.NotFound("membership")
+	}
+
+	if reassignTo != nil {
+		target, err := s.membershipRepo.GetMembershipByID(ctx, *reassignTo)
+		if err != nil {
+			return models.Membership{}, err
+		}
+		if target.ID == 0 || target.ID == membership.ID {
+			return models.Membership{}, apperror.Validation("reassign target membership not found")
+		}
+		err = s.membershipRepo.ReassignAndDeleteMembershipByID(ctx, id, *reassignTo)
+		if err != nil {
+			return models.Membership{}, err
+		}
+		return membership, nil
+	}
+
+	customerIDs, err := s.customerRepo.GetCustomerIDsByMembershipID(ctx, id)
+	if err != nil {
+		return models.Membership{}, err
 	}
+	if len(customerIDs) > 0 {
+		return models.Membership{}, newDependentsConflict("membership", id, "customer", customerIDs)
+	}
+
 	err = s.membershipRepo.DeleteMembershipByID(ctx, id)
 	if err != nil {
 		return models.Membership{}, err
@@ -84,3 +119,14 @@ func (s *membershipserviceImpl) DeleteMembership(ctx context.Context, id uint64)
 
 	return membership, err
 }
+
+func (s *membershipserviceImpl) RestoreMembership(ctx context.Context, id uint64) (models.Membership, error) {
+	membership, err := s.membershipRepo.RestoreMembershipByID(ctx, id)
+	if err != nil {
+		return models.Membership{}, err
+	}
+	if membership.ID == 0 {
+		return models.Membership{}, apperror.NotFound("membership")
+	}
+	return membership, nil
+}
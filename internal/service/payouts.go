@@ -0,0 +1,269 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type Payoutservice interface {
+	GetPayoutPeriods(ctx context.Context, includeDeleted bool) ([]models.PayoutPeriod, error)
+	GetPayoutPeriodsByID(ctx context.Context, id uint64) (models.PayoutPeriod, error)
+	CreatePayoutPeriod(ctx context.Context, period models.InputPayoutPeriod) (models.PayoutPeriod, error)
+	DeletePayoutPeriod(ctx context.Context, id uint64) (models.PayoutPeriod, error)
+	RestorePayoutPeriod(ctx context.Context, id uint64) (models.PayoutPeriod, error)
+	GetPayoutStatement(ctx context.Context, id uint64) (models.PayoutStatement, error)
+	SettlePayoutPeriod(ctx context.Context, id uint64) (models.PayoutStatement, error)
+	GetOutstandingPayouts(ctx context.Context) ([]models.DriverOutstanding, error)
+}
+type payoutserviceImpl struct {
+	periodRepo          repository.PayoutPeriodsQuery
+	bookingRepo         repository.BookingsQuery
+	driverIncentiveRepo repository.DriversIncentiveQuery
+}
+
+func NewPayoutservice(periodRepo repository.PayoutPeriodsQuery, bookingRepo repository.BookingsQuery, driverIncentiveRepo repository.DriversIncentiveQuery) Payoutservice {
+	return &payoutserviceImpl{periodRepo: periodRepo, bookingRepo: bookingRepo, driverIncentiveRepo: driverIncentiveRepo}
+}
+
+func (s *payoutserviceImpl) GetPayoutPeriods(ctx context.Context, includeDeleted bool) ([]models.PayoutPeriod, error) {
+	periods, err := s.periodRepo.GetPayoutPeriods(ctx, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+func (s *payoutserviceImpl) GetPayoutPeriodsByID(ctx context.Context, id uint64) (models.PayoutPeriod, error) {
+	period, err := s.periodRepo.GetPayoutPeriodsByID(ctx, id)
+	if err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	if period.ID == 0 {
+		return models.PayoutPeriod{}, apperror.NotFound("payout period")
+	}
+	return period, nil
+}
+
+// CreatePayoutPeriod opens a period, which may not share a day with another
+// one.
+func (s *payoutserviceImpl) CreatePayoutPeriod(ctx context.Context, period models.InputPayoutPeriod) (models.PayoutPeriod, error) {
+	if err := validation.Check(period); err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	NewPeriod := models.PayoutPeriod{}
+	NewPeriod.StartDate, _ = time.Parse(models.DateLayout, period.StartDate)
+	NewPeriod.EndDate, _ = time.Parse(models.DateLayout, period.EndDate)
+	NewPeriod.CreatedAt = time.Now()
+
+	overlapping, err := s.periodRepo.GetOverlappingPayoutPeriods(ctx, NewPeriod.StartDate, NewPeriod.EndDate)
+	if err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	if len(overlapping) > 0 {
+		other := overlapping[0]
+		return models.PayoutPeriod{}, apperror.Conflict(fmt.Sprintf("payout period %d already covers %s to %s",
+			other.ID, other.StartDate.Format(models.DateLayout), other.EndDate.Format(models.DateLayout))).WithCode("payout_period_overlaps")
+	}
+
+	createdPeriod, err := s.periodRepo.CreatePayoutPeriods(ctx, NewPeriod)
+	if err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	return createdPeriod, nil
+}
+
+// DeletePayoutPeriod removes a period that was not settled yet.
+func (s *payoutserviceImpl) DeletePayoutPeriod(ctx context.Context, id uint64) (models.PayoutPeriod, error) {
+	period, err := s.GetPayoutPeriodsByID(ctx, id)
+	if err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	if period.SettledAt != nil {
+		return models.PayoutPeriod{}, apperror.Conflict("payout period was already settled").WithCode("payout_period_settled")
+	}
+	if err := s.periodRepo.DeletePayoutPeriodsByID(ctx, id); err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	return period, nil
+}
+
+func (s *payoutserviceImpl) RestorePayoutPeriod(ctx context.Context, id uint64) (models.PayoutPeriod, error) {
+	period, err := s.periodRepo.RestorePayoutPeriodsByID(ctx, id)
+	if err != nil {
+		return models.PayoutPeriod{}, err
+	}
+	if period.ID == 0 {
+		return models.PayoutPeriod{}, apperror.NotFound("payout period")
+	}
+	return period, nil
+}
+
+// GetPayoutStatement lists what a period pays each driver: what was paid
+// when it is settled, otherwise what is still unpaid from the finished
+// bookings that ended by its end date. There is no lower bound, so what was
+// finished only after an earlier period was settled rolls into this one.
+func (s *payoutserviceImpl) GetPayoutStatement(ctx context.Context, id uint64) (models.PayoutStatement, error) {
+	period, err := s.GetPayoutPeriodsByID(ctx, id)
+	if err != nil {
+		return models.PayoutStatement{}, err
+	}
+	bookings, incentives, err := s.payoutItems(ctx, period)
+	if err != nil {
+		return models.PayoutStatement{}, err
+	}
+	return newPayoutStatement(period, bookings, incentives), nil
+}
+
+// SettlePayoutPeriod pays out a period once it has ended. Everything on its
+// statement is marked paid in it, so no later period pays it again.
+func (s *payoutserviceImpl) SettlePayoutPeriod(ctx context.Context, id uint64) (models.PayoutStatement, error) {
+	period, err := s.GetPayoutPeriodsByID(ctx, id)
+	if err != nil {
+		return models.PayoutStatement{}, err
+	}
+	if period.SettledAt != nil {
+		return models.PayoutStatement{}, apperror.Conflict("payout period was already settled").WithCode("payout_period_settled")
+	}
+	now := time.Now()
+	if !now.After(period.EndDate.AddDate(0, 0, 1)) {
+		return models.PayoutStatement{}, apperror.Conflict("payout period ends on " + period.EndDate.Format(models.DateLayout) + " and cannot be settled before").
+			WithCode("payout_period_open")
+	}
+
+	bookings, incentives, err := s.payoutItems(ctx, period)
+	if err != nil {
+		return models.PayoutStatement{}, err
+	}
+	bookingIDs := make([]uint, len(bookings))
+	for i, booking := range bookings {
+		bookingIDs[i] = booking.ID
+	}
+	incentiveIDs := make([]uint, len(incentives))
+	for i, incentive := range incentives {
+		incentiveIDs[i] = incentive.ID
+	}
+	settled, err := s.periodRepo.SettlePayoutPeriods(ctx, id, bookingIDs, incentiveIDs, now)
+	if errors.Is(err, repository.ErrPeriodSettled) {
+		return models.PayoutStatement{}, apperror.Conflict("payout period was already settled").WithCode("payout_period_settled")
+	}
+	if err != nil {
+		return models.PayoutStatement{}, err
+	}
+	return s.GetPayoutStatement(ctx, uint64(settled.ID))
+}
+
+// GetOutstandingPayouts adds up, per driver, what was earned on finished
+// bookings and not paid yet, largest amount first.
+func (s *payoutserviceImpl) GetOutstandingPayouts(ctx context.Context) ([]models.DriverOutstanding, error) {
+	bookings, err := s.bookingRepo.GetUnpaidDriverBookings(ctx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	incentives, err := s.driverIncentiveRepo.GetUnpaidDriversIncentive(ctx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	statement := newPayoutStatement(models.PayoutPeriod{}, bookings, incentives)
+	outstanding := []models.DriverOutstanding{}
+	for _, payout := range statement.Drivers {
+		line := models.DriverOutstanding{}
+		line.DriverID = payout.DriverID
+		line.DriverName = payout.DriverName
+		line.Bookings = len(payout.Bookings)
+		line.DriverEarnings = payout.DriverEarnings
+		line.Incentives = payout.Incentives
+		line.Total = payout.Total
+		outstanding = append(outstanding, line)
+	}
+	sort.SliceStable(outstanding, func(i, j int) bool {
+		return outstanding[i].Total > outstanding[j].Total
+	})
+	return outstanding, nil
+}
+
+// payoutItems returns the bookings whose driver earnings and the incentives
+// a period pays.
+func (s *payoutserviceImpl) payoutItems(ctx context.Context, period models.PayoutPeriod) ([]models.Booking, []models.DriverIncentive, error) {
+	if period.SettledAt != nil {
+		bookings, err := s.bookingRepo.GetBookingsByDriverPayoutPeriodID(ctx, uint64(period.ID))
+		if err != nil {
+			return nil, nil, err
+		}
+		incentives, err := s.driverIncentiveRepo.GetDriversIncentiveByPayoutPeriodID(ctx, uint64(period.ID))
+		if err != nil {
+			return nil, nil, err
+		}
+		return bookings, incentives, nil
+	}
+
+	bookings, err := s.bookingRepo.GetUnpaidDriverBookings(ctx, period.EndDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	incentives, err := s.driverIncentiveRepo.GetUnpaidDriversIncentive(ctx, period.EndDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bookings, incentives, nil
+}
+
+// newPayoutStatement groups driver earnings and incentives by driver and
+// booking, in the order the drivers first appear.
+func newPayoutStatement(period models.PayoutPeriod, bookings []models.Booking, incentives []models.DriverIncentive) models.PayoutStatement {
+	statement := models.PayoutStatement{Period: period, Drivers: []models.DriverPayout{}}
+	drivers := map[uint]int{}
+	lines := map[uint]int{}
+
+	line := func(booking models.Booking) *models.PayoutBooking {
+		driverID := *booking.DriverID
+		i, ok := drivers[driverID]
+		if !ok {
+			payout := models.DriverPayout{DriverID: driverID, Bookings: []models.PayoutBooking{}}
+			if booking.Driver != nil {
+				payout.DriverName = booking.Driver.Name
+			}
+			statement.Drivers = append(statement.Drivers, payout)
+			i = len(statement.Drivers) - 1
+			drivers[driverID] = i
+		}
+		payout := &statement.Drivers[i]
+		j, ok := lines[booking.ID]
+		if !ok {
+			payout.Bookings = append(payout.Bookings, models.PayoutBooking{BookingID: booking.ID, StartRent: booking.StartRent, EndRent: booking.EndRent})
+			j = len(payout.Bookings) - 1
+			lines[booking.ID] = j
+		}
+		return &payout.Bookings[j]
+	}
+
+	for _, booking := range bookings {
+		line(booking).DriverEarnings += booking.TotalDriverCost
+	}
+	for _, incentive := range incentives {
+		if incentive.Booking.DriverID == nil {
+			continue
+		}
+		line(incentive.Booking).Incentives += incentive.Incentive
+	}
+
+	for i := range statement.Drivers {
+		payout := &statement.Drivers[i]
+		for _, booking := range payout.Bookings {
+			payout.DriverEarnings += booking.DriverEarnings
+			payout.Incentives += booking.Incentives
+		}
+		payout.Total = payout.DriverEarnings + payout.Incentives
+		statement.DriverEarnings += payout.DriverEarnings
+		statement.Incentives += payout.Incentives
+	}
+	statement.Total = statement.DriverEarnings + statement.Incentives
+	return statement
+}
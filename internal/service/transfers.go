@@ -0,0 +1,160 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type Transferservice interface {
+	GetTransfers(ctx context.Context, vehicleID uint64) ([]models.VehicleTransfer, error)
+	GetTransfersByID(ctx context.Context, id uint64) (models.VehicleTransfer, error)
+	RequestTransfer(ctx context.Context, transfer models.InputVehicleTransfer) (models.VehicleTransfer, error)
+	ShipTransfer(ctx context.Context, id uint64) (models.VehicleTransfer, error)
+	ReceiveTransfer(ctx context.Context, id uint64) (models.VehicleTransfer, error)
+	CancelTransfer(ctx context.Context, id uint64) (models.VehicleTransfer, error)
+}
+type transferserviceImpl struct {
+	transferRepo repository.TransfersQuery
+	vehicleRepo  repository.VehiclesQuery
+	branchRepo   repository.BranchesQuery
+	bookingRepo  repository.BookingsQuery
+}
+
+func NewTransferservice(transferRepo repository.TransfersQuery,
+	vehicleRepo repository.VehiclesQuery,
+	branchRepo repository.BranchesQuery,
+	bookingRepo repository.BookingsQuery) Transferservice {
+	return &transferserviceImpl{transferRepo: transferRepo,
+		vehicleRepo: vehicleRepo,
+		branchRepo:  branchRepo,
+		bookingRepo: bookingRepo,
+	}
+}
+
+func (s *transferserviceImpl) GetTransfers(ctx context.Context, vehicleID uint64) ([]models.VehicleTransfer, error) {
+	transfers, err := s.transferRepo.GetTransfers(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (s *transferserviceImpl) GetTransfersByID(ctx context.Context, id uint64) (models.VehicleTransfer, error) {
+	transfer, err := s.transferRepo.GetTransfersByID(ctx, id)
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	if transfer.ID == 0 {
+		return models.VehicleTransfer{}, apperror.NotFound("vehicle transfer")
+	}
+	return transfer, nil
+}
+
+// RequestTransfer plans moving a vehicle from the branch it is at to another
+// one. The vehicle stays available at its branch until it is shipped.
+func (s *transferserviceImpl) RequestTransfer(ctx context.Context, transfer models.InputVehicleTransfer) (models.VehicleTransfer, error) {
+	errs := validation.Collect(transfer)
+	vehicle := models.Vehicle{}
+	if !errs.Has("vehicle_id") {
+		found, err := s.vehicleRepo.GetVehiclesByID(ctx, uint64(transfer.VehicleID))
+		if err != nil {
+			return models.VehicleTransfer{}, err
+		}
+		switch {
+		case found.ID == 0:
+			errs.Add("vehicle_id", "vehicle not found")
+		case found.Status == models.VehicleStatusRetired:
+			errs.Add("vehicle_id", "vehicle is retired")
+		}
+		vehicle = found
+	}
+	if !errs.Has("to_branch_id") {
+		branch, err := s.branchRepo.GetBranchesByID(ctx, uint64(transfer.ToBranchID))
+		if err != nil {
+			return models.VehicleTransfer{}, err
+		}
+		switch {
+		case branch.ID == 0:
+			errs.Add("to_branch_id", "branch not found")
+		case vehicle.ID != 0 && branch.ID == vehicle.BranchID:
+			errs.Add("to_branch_id", "vehicle is already at "+branch.Name)
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return models.VehicleTransfer{}, err
+	}
+
+	openIDs, err := s.transferRepo.GetOpenTransferIDsByVehicleID(ctx, uint64(vehicle.ID))
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	if len(openIDs) > 0 {
+		return models.VehicleTransfer{}, newDependentsConflict("vehicle", uint64(vehicle.ID), "open transfer", openIDs)
+	}
+
+	now := time.Now()
+	NewTransfer := models.VehicleTransfer{}
+	NewTransfer.VehicleID = vehicle.ID
+	NewTransfer.FromBranchID = vehicle.BranchID
+	NewTransfer.ToBranchID = transfer.ToBranchID
+	NewTransfer.Status = models.TransferStatusRequested
+	NewTransfer.Notes = transfer.Notes
+	NewTransfer.RequestedAt = now
+	NewTransfer.CreatedAt = now
+
+	return s.transferRepo.CreateTransfers(ctx, NewTransfer)
+}
+
+// ShipTransfer sends the vehicle on its way. From now on it cannot be
+// rented at either branch until it is received.
+func (s *transferserviceImpl) ShipTransfer(ctx context.Context, id uint64) (models.VehicleTransfer, error) {
+	transfer, err := s.GetTransfersByID(ctx, id)
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	if transfer.Status != models.TransferStatusRequested {
+		return models.VehicleTransfer{}, apperror.Conflict("vehicle transfer is " + transfer.Status).WithCode("transfer_" + transfer.Status)
+	}
+
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByVehicleID(ctx, uint64(transfer.VehicleID))
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.VehicleTransfer{}, newDependentsConflict("vehicle", uint64(transfer.VehicleID), "open booking", bookingIDs)
+	}
+
+	return s.transferRepo.SetTransferStatus(ctx, id, models.TransferStatusInTransit, time.Now())
+}
+
+// ReceiveTransfer completes a shipped transfer; the vehicle now belongs to
+// the destination branch.
+func (s *transferserviceImpl) ReceiveTransfer(ctx context.Context, id uint64) (models.VehicleTransfer, error) {
+	transfer, err := s.GetTransfersByID(ctx, id)
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	if transfer.Status != models.TransferStatusInTransit {
+		return models.VehicleTransfer{}, apperror.Conflict("vehicle transfer is " + transfer.Status).WithCode("transfer_" + transfer.Status)
+	}
+	return s.transferRepo.CompleteTransfers(ctx, id, time.Now())
+}
+
+// CancelTransfer calls off a transfer that was not received yet. A shipped
+// vehicle is taken to be back at the branch it left.
+func (s *transferserviceImpl) CancelTransfer(ctx context.Context, id uint64) (models.VehicleTransfer, error) {
+	transfer, err := s.GetTransfersByID(ctx, id)
+	if err != nil {
+		return models.VehicleTransfer{}, err
+	}
+	switch transfer.Status {
+	case models.TransferStatusRequested, models.TransferStatusInTransit:
+	default:
+		return models.VehicleTransfer{}, apperror.Conflict("vehicle transfer is " + transfer.Status).WithCode("transfer_" + transfer.Status)
+	}
+	return s.transferRepo.SetTransferStatus(ctx, id, models.TransferStatusCancelled, time.Now())
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"car-rental/internal/models"
+	"car-rental/internal/repository"
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+	"car-rental/pkg/validation"
+	"context"
+	"time"
+)
+
+type Vehicleservice interface {
+	GetVehicles(ctx context.Context, carID uint64, branchID uint64, includeDeleted bool) ([]models.Vehicle, error)
+	GetVehiclesByID(ctx context.Context, id uint64) (models.Vehicle, error)
+	CreateVehicle(ctx context.Context, vehicle models.InputVehicle) (models.Vehicle, error)
+	EditVehicle(ctx context.Context, id uint64, vehicle models.InputVehicle) (models.Vehicle, error)
+	DeleteVehicle(ctx context.Context, id uint64) (models.Vehicle, error)
+	RestoreVehicle(ctx context.Context, id uint64) (models.Vehicle, error)
+}
+type vehicleserviceImpl struct {
+	vehicleRepo  repository.VehiclesQuery
+	carRepo      repository.CarsQuery
+	bookingRepo  repository.BookingsQuery
+	branchRepo   repository.BranchesQuery
+	transferRepo repository.TransfersQuery
+}
+
+func NewVehicleservice(vehicleRepo repository.VehiclesQuery,
+	carRepo repository.CarsQuery,
+	bookingRepo repository.BookingsQuery,
+	branchRepo repository.BranchesQuery,
+	transferRepo repository.TransfersQuery) Vehicleservice {
+	return &vehicleserviceImpl{vehicleRepo: vehicleRepo,
+		carRepo:      carRepo,
+		bookingRepo:  bookingRepo,
+		branchRepo:   branchRepo,
+		transferRepo: transferRepo,
+	}
+}
+
+func (s *vehicleserviceImpl) GetVehicles(ctx context.Context, carID uint64, branchID uint64, includeDeleted bool) ([]models.Vehicle, error) {
+	vehicles, err := s.vehicleRepo.GetVehicles(ctx, carID, branchID, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return vehicles, nil
+}
+
+func (s *vehicleserviceImpl) GetVehiclesByID(ctx context.Context, id uint64) (models.Vehicle, error) {
+	vehicle, err := s.vehicleRepo.GetVehiclesByID(ctx, id)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if vehicle.ID == 0 {
+		return models.Vehicle{}, apperror.NotFound("vehicle")
+	}
+	return vehicle, nil
+}
+
+// checkVehicle validates a vehicle request, including that its car and branch
+// exist and that the plate number and VIN are not registered to another
+// vehicle.
+func (s *vehicleserviceImpl) checkVehicle(ctx context.Context, id uint64, vehicle models.InputVehicle) error {
+	errs := validation.Collect(vehicle)
+	if !errs.Has("car_id") {
+		car, err := s.carRepo.GetCarsByID(ctx, uint64(vehicle.CarID))
+		if err != nil {
+			return err
+		}
+		if car.ID == 0 {
+			errs.Add("car_id", "car not found")
+		}
+	}
+	if !errs.Has("branch_id") {
+		branch, err := s.branchRepo.GetBranchesByID(ctx, uint64(vehicle.BranchID))
+		if err != nil {
+			return err
+		}
+		if branch.ID == 0 {
+			errs.Add("branch_id", "branch not found")
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return err
+	}
+
+	taken := []pkg.FieldError{}
+	byPlate, err := s.vehicleRepo.GetVehicleByPlateNumber(ctx, vehicle.PlateNumber)
+	if err != nil {
+		return err
+	}
+	if byPlate.ID != 0 && uint64(byPlate.ID) != id {
+		taken = append(taken, pkg.FieldError{Field: "plate_number", Message: "is already registered"})
+	}
+	byVIN, err := s.vehicleRepo.GetVehicleByVIN(ctx, vehicle.VIN)
+	if err != nil {
+		return err
+	}
+	if byVIN.ID != 0 && uint64(byVIN.ID) != id {
+		taken = append(taken, pkg.FieldError{Field: "vin", Message: "is already registered"})
+	}
+	if len(taken) > 0 {
+		return apperror.Conflict("vehicle is already registered", taken...).WithCode("vehicle_exists")
+	}
+	return nil
+}
+
+// syncStock recounts the active vehicles of a car into its stock.
+func (s *vehicleserviceImpl) syncStock(ctx context.Context, carID uint) error {
+	count, err := s.vehicleRepo.CountActiveVehiclesByCarID(ctx, uint64(carID))
+	if err != nil {
+		return err
+	}
+	return s.carRepo.SetCarStock(ctx, uint64(carID), count)
+}
+
+func (s *vehicleserviceImpl) CreateVehicle(ctx context.Context, vehicle models.InputVehicle) (models.Vehicle, error) {
+	if err := s.checkVehicle(ctx, 0, vehicle); err != nil {
+		return models.Vehicle{}, err
+	}
+	NewVehicle := models.Vehicle{}
+	NewVehicle.CarID = vehicle.CarID
+	NewVehicle.BranchID = vehicle.BranchID
+	NewVehicle.PlateNumber = vehicle.PlateNumber
+	NewVehicle.VIN = vehicle.VIN
+	NewVehicle.Color = vehicle.Color
+	NewVehicle.Year = vehicle.Year
+	NewVehicle.Odometer = vehicle.Odometer
+	NewVehicle.Status = vehicle.Status
+	if NewVehicle.Status == "" {
+		NewVehicle.Status = models.VehicleStatusActive
+	}
+	NewVehicle.CreatedAt = time.Now()
+
+	createdVehicle, err := s.vehicleRepo.CreateVehicles(ctx, NewVehicle)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if err := s.syncStock(ctx, createdVehicle.CarID); err != nil {
+		return models.Vehicle{}, err
+	}
+	return createdVehicle, nil
+}
+
+func (s *vehicleserviceImpl) EditVehicle(ctx context.Context, id uint64, vehicle models.InputVehicle) (models.Vehicle, error) {
+	existing, err := s.GetVehiclesByID(ctx, id)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if err := s.checkVehicle(ctx, id, vehicle); err != nil {
+		return models.Vehicle{}, err
+	}
+	if vehicle.Odometer < existing.Odometer {
+		return models.Vehicle{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "odometer", Message: "must not go below the current reading"})
+	}
+	if vehicle.BranchID != existing.BranchID {
+		return models.Vehicle{}, apperror.Validation("request is invalid",
+			pkg.FieldError{Field: "branch_id", Message: "can only change through a vehicle transfer"})
+	}
+	if vehicle.CarID != existing.CarID || vehicle.Status != existing.Status {
+		bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByVehicleID(ctx, id)
+		if err != nil {
+			return models.Vehicle{}, err
+		}
+		if len(bookingIDs) > 0 {
+			return models.Vehicle{}, newDependentsConflict("vehicle", id, "open booking", bookingIDs)
+		}
+	}
+
+	updatedVehicle := models.Vehicle{}
+	updatedVehicle.CarID = vehicle.CarID
+	updatedVehicle.PlateNumber = vehicle.PlateNumber
+	updatedVehicle.VIN = vehicle.VIN
+	updatedVehicle.Color = vehicle.Color
+	updatedVehicle.Year = vehicle.Year
+	updatedVehicle.Odometer = vehicle.Odometer
+	updatedVehicle.Status = vehicle.Status
+	updatedVehicle.UpdatedAt = time.Now()
+
+	updatedVehicle, err = s.vehicleRepo.EditVehicles(ctx, id, updatedVehicle)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if err := s.syncStock(ctx, existing.CarID); err != nil {
+		return models.Vehicle{}, err
+	}
+	if updatedVehicle.CarID != existing.CarID {
+		if err := s.syncStock(ctx, updatedVehicle.CarID); err != nil {
+			return models.Vehicle{}, err
+		}
+	}
+	return updatedVehicle, nil
+}
+
+func (s *vehicleserviceImpl) DeleteVehicle(ctx context.Context, id uint64) (models.Vehicle, error) {
+	vehicle, err := s.GetVehiclesByID(ctx, id)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+
+	bookingIDs, err := s.bookingRepo.GetOpenBookingIDsByVehicleID(ctx, id)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if len(bookingIDs) > 0 {
+		return models.Vehicle{}, newDependentsConflict("vehicle", id, "open booking", bookingIDs)
+	}
+	transferIDs, err := s.transferRepo.GetOpenTransferIDsByVehicleID(ctx, id)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if len(transferIDs) > 0 {
+		return models.Vehicle{}, newDependentsConflict("vehicle", id, "open transfer", transferIDs)
+	}
+
+	if err := s.vehicleRepo.DeleteVehiclesByID(ctx, id); err != nil {
+		return models.Vehicle{}, err
+	}
+	if err := s.syncStock(ctx, vehicle.CarID); err != nil {
+		return models.Vehicle{}, err
+	}
+	return vehicle, nil
+}
+
+func (s *vehicleserviceImpl) RestoreVehicle(ctx context.Context, id uint64) (models.Vehicle, error) {
+	vehicle, err := s.vehicleRepo.RestoreVehiclesByID(ctx, id)
+	if err != nil {
+		return models.Vehicle{}, err
+	}
+	if vehicle.ID == 0 {
+		return models.Vehicle{}, apperror.NotFound("vehicle")
+	}
+	if err := s.syncStock(ctx, vehicle.CarID); err != nil {
+		return models.Vehicle{}, err
+	}
+	return vehicle, nil
+}
@@ -5,9 +5,11 @@ import (
 
 	"car-rental/internal/handler"
 	"car-rental/internal/infrastructure"
+	"car-rental/internal/middleware"
 	"car-rental/internal/repository"
 	"car-rental/internal/router"
 	"car-rental/internal/service"
+	"car-rental/pkg/validation"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -44,56 +46,169 @@ func server() {
 	if err != nil {
 		log.Fatalf("Error loading .env file: %v", err)
 	}
+	validation.UseJSONFieldNames()
 	g := gin.Default()
 	g.Use(gin.Recovery())
+	g.Use(middleware.ErrorHandler())
 	gorm := infrastructure.NewGormPostgres()
+	bookingRepo := repository.NewBookingsQuery(gorm)
+	vehicleRepo := repository.NewVehiclesQuery(gorm)
+	branchRepo := repository.NewBranchesQuery(gorm)
+	transferRepo := repository.NewTransfersQuery(gorm)
+	driverRepo := repository.NewDriversQuery(gorm)
 
 	customersGroup := g.Group("/customers")
 	customerRepo := repository.NewCustomersQuery(gorm)
-	customersvc := service.NewCustomerService(customerRepo)
+	customersvc := service.NewCustomerService(customerRepo, bookingRepo)
 	customerHdl := handler.NewCustomerHandler(customersvc)
 	customerRouter := router.NewCustomerRouter(customersGroup, customerHdl)
 	customerRouter.Mount()
 
 	carsGroup := g.Group("/cars")
 	carRepo := repository.NewCarsQuery(gorm)
-	carsvc := service.NewCarservice(carRepo)
+	carCategoryRepo := repository.NewCarCategoriesQuery(gorm)
+	carsvc := service.NewCarservice(carRepo, bookingRepo, vehicleRepo, carCategoryRepo)
 	carHdl := handler.NewCarHandler(carsvc)
 	carRouter := router.NewCarRouter(carsGroup, carHdl)
 	carRouter.Mount()
 
+	insurancePlanRepo := repository.NewInsurancePlansQuery(gorm)
+	carCategoriesGroup := g.Group("/car-categories")
+	carCategorysvc := service.NewCarCategoryservice(carCategoryRepo, carRepo, insurancePlanRepo)
+	carCategoryHdl := handler.NewCarCategoryHandler(carCategorysvc)
+	carCategoryRouter := router.NewCarCategoryRouter(carCategoriesGroup, carCategoryHdl)
+	carCategoryRouter.Mount()
+
+	vehiclesGroup := g.Group("/vehicles")
+	vehiclesvc := service.NewVehicleservice(vehicleRepo, carRepo, bookingRepo, branchRepo, transferRepo)
+	vehicleHdl := handler.NewVehicleHandler(vehiclesvc)
+	vehicleRouter := router.NewVehicleRouter(vehiclesGroup, vehicleHdl)
+	vehicleRouter.Mount()
+
+	branchesGroup := g.Group("/branches")
+	branchsvc := service.NewBranchservice(branchRepo, vehicleRepo, driverRepo, bookingRepo, transferRepo)
+	branchHdl := handler.NewBranchHandler(branchsvc)
+	branchRouter := router.NewBranchRouter(branchesGroup, branchHdl)
+	branchRouter.Mount()
+
+	transfersGroup := g.Group("/transfers")
+	transfersvc := service.NewTransferservice(transferRepo, vehicleRepo, branchRepo, bookingRepo)
+	transferHdl := handler.NewTransferHandler(transfersvc)
+	transferRouter := router.NewTransferRouter(transfersGroup, transferHdl)
+	transferRouter.Mount()
+
+	maintenanceGroup := g.Group("/maintenance")
+	maintenanceRepo := repository.NewMaintenanceQuery(gorm)
+	maintenancesvc := service.NewMaintenanceservice(maintenanceRepo, vehicleRepo)
+	maintenanceHdl := handler.NewMaintenanceHandler(maintenancesvc)
+	maintenanceRouter := router.NewMaintenanceRouter(maintenanceGroup, maintenanceHdl)
+	maintenanceRouter.Mount()
+
 	driversGroup := g.Group("/drivers")
-	driverRepo := repository.NewDriversQuery(gorm)
-	driversvc := service.NewDriverservice(driverRepo)
+	driverDocumentRepo := repository.NewDriverDocumentsQuery(gorm)
+	driversvc := service.NewDriverservice(driverRepo, bookingRepo, branchRepo, driverDocumentRepo)
 	driverHdl := handler.NewDriverHandler(driversvc)
 	driverRouter := router.NewDriverRouter(driversGroup, driverHdl)
 	driverRouter.Mount()
 
+	driverSchedulesGroup := g.Group("/driver-schedules")
+	driverScheduleRepo := repository.NewDriverSchedulesQuery(gorm)
+	driverSchedulesvc := service.NewDriverScheduleservice(driverScheduleRepo, driverRepo)
+	driverScheduleHdl := handler.NewDriverScheduleHandler(driverSchedulesvc)
+	driverScheduleRouter := router.NewDriverScheduleRouter(driverSchedulesGroup, driverScheduleHdl)
+	driverScheduleRouter.Mount()
+
+	driverLeavesGroup := g.Group("/driver-leaves")
+	driverLeaveRepo := repository.NewDriverLeavesQuery(gorm)
+	driverLeavesvc := service.NewDriverLeaveservice(driverLeaveRepo, driverRepo)
+	driverLeaveHdl := handler.NewDriverLeaveHandler(driverLeavesvc)
+	driverLeaveRouter := router.NewDriverLeaveRouter(driverLeavesGroup, driverLeaveHdl)
+	driverLeaveRouter.Mount()
+
+	driverDocumentsGroup := g.Group("/driver-documents")
+	driverDocumentsvc := service.NewDriverDocumentservice(driverDocumentRepo, driverRepo)
+	driverDocumentHdl := handler.NewDriverDocumentHandler(driverDocumentsvc)
+	driverDocumentRouter := router.NewDriverDocumentRouter(driverDocumentsGroup, driverDocumentHdl)
+	driverDocumentRouter.Mount()
+
+	incentiveRulesGroup := g.Group("/incentive-rules")
+	incentiveRuleRepo := repository.NewIncentiveRulesQuery(gorm)
+	incentiveRulesvc := service.NewIncentiveRuleservice(incentiveRuleRepo)
+	incentiveRuleHdl := handler.NewIncentiveRuleHandler(incentiveRulesvc)
+	incentiveRuleRouter := router.NewIncentiveRuleRouter(incentiveRulesGroup, incentiveRuleHdl)
+	incentiveRuleRouter.Mount()
+
 	bookingTypesGroup := g.Group("/bookingtypes")
 	bookingTypeRepo := repository.NewBookingTypesQuery(gorm)
-	bookingTypesvc := service.NewBookingTypeservice(bookingTypeRepo)
+	bookingTypesvc := service.NewBookingTypeservice(bookingTypeRepo, bookingRepo)
 	bookingTypeHdl := handler.NewBookingTypeHandler(bookingTypesvc)
 	bookingTypeRouter := router.NewBookingTypeRouter(bookingTypesGroup, bookingTypeHdl)
 	bookingTypeRouter.Mount()
 
+	extrasGroup := g.Group("/extras")
+	extraRepo := repository.NewExtrasQuery(gorm)
+	extrasvc := service.NewExtraservice(extraRepo, bookingRepo)
+	extraHdl := handler.NewExtraHandler(extrasvc)
+	extraRouter := router.NewExtraRouter(extrasGroup, extraHdl)
+	extraRouter.Mount()
+
+	insurancePlansGroup := g.Group("/insurance-plans")
+	insurancePlansvc := service.NewInsurancePlanservice(insurancePlanRepo, carCategoryRepo, bookingRepo)
+	insurancePlanHdl := handler.NewInsurancePlanHandler(insurancePlansvc)
+	insurancePlanRouter := router.NewInsurancePlanRouter(insurancePlansGroup, insurancePlanHdl)
+	insurancePlanRouter.Mount()
+
 	driversIncentiveGroup := g.Group("/driver-incentives")
 	driverIncentiveRepo := repository.NewDriversIncentiveQuery(gorm)
 
+	companiesGroup := g.Group("/companies")
+	companyRepo := repository.NewCompaniesQuery(gorm)
+	companysvc := service.NewCompanyservice(companyRepo, carRepo, bookingRepo)
+	companyHdl := handler.NewCompanyHandler(companysvc)
+	companyRouter := router.NewCompanyRouter(companiesGroup, companyHdl)
+	companyRouter.Mount()
+
 	bookingsGroup := g.Group("/bookings")
-	bookingRepo := repository.NewBookingsQuery(gorm)
-	bookingsvc := service.NewBookingservice(bookingRepo, carRepo, customerRepo, driverRepo, driverIncentiveRepo)
-	bookingHdl := handler.NewBookingHandler(bookingsvc, customersvc, carsvc, driversvc, bookingTypesvc)
+	bookingGroupRepo := repository.NewBookingGroupsQuery(gorm)
+	bookingsvc := service.NewBookingservice(bookingRepo, carRepo, customerRepo, driverRepo, driverIncentiveRepo, bookingTypeRepo, vehicleRepo, branchRepo, carCategoryRepo, extraRepo, insurancePlanRepo, bookingGroupRepo, companyRepo, driverScheduleRepo, driverLeaveRepo, incentiveRuleRepo)
+	bookingHdl := handler.NewBookingHandler(bookingsvc)
 	bookingRouter := router.NewBookingRouter(bookingsGroup, bookingHdl)
 	bookingRouter.Mount()
 
+	bookingGroupsGroup := g.Group("/booking-groups")
+	bookingGroupHdl := handler.NewBookingGroupHandler(bookingsvc)
+	bookingGroupRouter := router.NewBookingGroupRouter(bookingGroupsGroup, bookingGroupHdl)
+	bookingGroupRouter.Mount()
+
+	inspectionsGroup := g.Group("/inspections")
+	inspectionRepo := repository.NewInspectionsQuery(gorm)
+	inspectionsvc := service.NewInspectionservice(inspectionRepo, bookingRepo, infrastructure.NewLocalStorage())
+	inspectionHdl := handler.NewInspectionHandler(inspectionsvc)
+	inspectionRouter := router.NewInspectionRouter(inspectionsGroup, inspectionHdl)
+	inspectionRouter.Mount()
+
+	bookingRatingsGroup := g.Group("/booking-ratings")
+	bookingRatingRepo := repository.NewBookingRatingsQuery(gorm)
+	bookingRatingsvc := service.NewBookingRatingservice(bookingRatingRepo, bookingRepo)
+	bookingRatingHdl := handler.NewBookingRatingHandler(bookingRatingsvc)
+	bookingRatingRouter := router.NewBookingRatingRouter(bookingRatingsGroup, bookingRatingHdl)
+	bookingRatingRouter.Mount()
+
 	driversIncentivevc := service.NewDriversIncentiveervice(driverIncentiveRepo)
 	driverIncentiveHdl := handler.NewDriverIncentiveHandler(driversIncentivevc, bookingsvc, driversvc)
 	driverIncentiveRouter := router.NewDriverIncentiveRouter(driversIncentiveGroup, driverIncentiveHdl)
 	driverIncentiveRouter.Mount()
 
+	payoutPeriodsGroup := g.Group("/payout-periods")
+	payoutPeriodRepo := repository.NewPayoutPeriodsQuery(gorm)
+	payoutsvc := service.NewPayoutservice(payoutPeriodRepo, bookingRepo, driverIncentiveRepo)
+	payoutHdl := handler.NewPayoutHandler(payoutsvc)
+	payoutRouter := router.NewPayoutRouter(payoutPeriodsGroup, payoutHdl)
+	payoutRouter.Mount()
+
 	membershipsGroup := g.Group("/memberships")
 	membershipRepo := repository.NewMembershipQuery(gorm)
-	membershipsvc := service.NewMembershipservice(membershipRepo)
+	membershipsvc := service.NewMembershipservice(membershipRepo, customerRepo)
 	membershipHdl := handler.NewMembershipHandler(membershipsvc)
 	membershipRouter := router.NewMembershipRouter(membershipsGroup, membershipHdl)
 	membershipRouter.Mount()
@@ -0,0 +1,90 @@
+// Command phonefix rewrites the phone numbers of existing customers and
+// drivers in E.164, as the API now stores them. Numbers it cannot read, and
+// customer numbers that turn out to be taken by another customer once
+// normalized, are reported and left for someone to fix by hand.
+//
+//	go run ./phonefix [-dry-run]
+package main
+
+import (
+	"car-rental/database"
+	"car-rental/pkg/validation"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+type phoneRow struct {
+	id    int
+	phone string
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report what would change without saving it")
+	flag.Parse()
+
+	database.ConnectDB()
+
+	for _, table := range []string{"customers", "drivers"} {
+		if err := fixPhones(database.DB, table, *dryRun); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// fixPhones normalizes the phone numbers of every row of table, deleted ones
+// included.
+func fixPhones(db *sql.DB, table string, dryRun bool) error {
+	rows, err := db.Query(fmt.Sprintf("SELECT id, phone FROM %s WHERE phone IS NOT NULL ORDER BY id", table))
+	if err != nil {
+		return err
+	}
+	phones := []phoneRow{}
+	for rows.Next() {
+		row := phoneRow{}
+		if err := rows.Scan(&row.id, &row.phone); err != nil {
+			rows.Close()
+			return err
+		}
+		phones = append(phones, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	fixed, skipped := 0, 0
+	for _, row := range phones {
+		phone, err := validation.NormalizePhone(row.phone)
+		if err != nil {
+			log.Printf("%s %d: %q %s, fix it by hand", table, row.id, row.phone, err)
+			skipped++
+			continue
+		}
+		if phone == row.phone {
+			continue
+		}
+		if dryRun {
+			log.Printf("%s %d: %q would become %s", table, row.id, row.phone, phone)
+			fixed++
+			continue
+		}
+		_, err = db.Exec(fmt.Sprintf("UPDATE %s SET phone = $1, updated_at = NOW() WHERE id = $2", table), phone, row.id)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			log.Printf("%s %d: %q becomes %s, which another row already has, fix it by hand", table, row.id, row.phone, phone)
+			skipped++
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		fixed++
+	}
+	log.Printf("%s: %d phone numbers normalized, %d left to fix by hand", table, fixed, skipped)
+	return nil
+}
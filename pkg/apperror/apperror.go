@@ -0,0 +1,120 @@
+// Package apperror holds the domain errors services return. Each error
+// carries a Kind that the HTTP layer maps to a status code, so services
+// never need to know about HTTP and handlers never need to inspect strings.
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"car-rental/pkg"
+)
+
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindConflict
+	KindValidation
+	KindForbidden
+	KindUnavailable
+)
+
+// Error is a domain error with a machine-readable code and optional field
+// level details.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  []pkg.FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithCode replaces the generic code of the error kind with a more specific
+// one, e.g. "car_unavailable" instead of "unavailable".
+func (e *Error) WithCode(code string) *Error {
+	e.Code = code
+	return e
+}
+
+// Wrap keeps err as the cause, for logging, without exposing it to clients.
+func (e *Error) Wrap(err error) *Error {
+	e.Err = err
+	return e
+}
+
+// Status is the HTTP status code the error is answered with.
+func (e *Error) Status() int {
+	switch e.Kind {
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict, KindUnavailable:
+		return http.StatusConflict
+	case KindValidation:
+		return http.StatusBadRequest
+	case KindForbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Response is the body the error is answered with.
+func (e *Error) Response() pkg.ErrorResponse {
+	return pkg.ErrorResponse{Code: e.Code, Message: e.Message, Errors: e.Fields}
+}
+
+// NotFound reports that the named resource does not exist.
+func NotFound(resource string) *Error {
+	return &Error{Kind: KindNotFound, Code: "not_found", Message: resource + " not found"}
+}
+
+// Conflict reports that the request clashes with the current state, such as
+// deleting a record other records still depend on.
+func Conflict(message string, fields ...pkg.FieldError) *Error {
+	return &Error{Kind: KindConflict, Code: "conflict", Message: message, Fields: fields}
+}
+
+// Validation reports that the request itself is invalid.
+func Validation(message string, fields ...pkg.FieldError) *Error {
+	return &Error{Kind: KindValidation, Code: "validation_failed", Message: message, Fields: fields}
+}
+
+// Forbidden reports that the request is understood but not allowed.
+func Forbidden(message string) *Error {
+	return &Error{Kind: KindForbidden, Code: "forbidden", Message: message}
+}
+
+// Unavailable reports that a resource exists but cannot be used for the
+// requested period, e.g. a car that is fully booked.
+func Unavailable(message string) *Error {
+	return &Error{Kind: KindUnavailable, Code: "unavailable", Message: message}
+}
+
+// As returns the domain error inside err, if there is one.
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err is a NotFound error.
+func IsNotFound(err error) bool {
+	appErr, ok := As(err)
+	return ok && appErr.Kind == KindNotFound
+}
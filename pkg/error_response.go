@@ -1,6 +1,17 @@
 package pkg
 
+// ErrorResponse is the body of every non-2xx answer. Code is a stable,
+// machine-readable identifier; Errors points at the offending fields or
+// records when there is more than one thing to report.
 type ErrorResponse struct {
-	Message string   `json:"message"`
-	Errors  []string `json:"errors,omitempty"`
+	Code    string       `json:"code,omitempty"`
+	Message string       `json:"message"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is one problem with a single field of a request, or with one
+// record related to it.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
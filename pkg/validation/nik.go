@@ -0,0 +1,113 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Provinces maps the two-digit province codes that open every NIK to the
+// province's name, following the Ministry of Home Affairs' area codes.
+var Provinces = map[string]string{
+	"11": "Aceh",
+	"12": "Sumatera Utara",
+	"13": "Sumatera Barat",
+	"14": "Riau",
+	"15": "Jambi",
+	"16": "Sumatera Selatan",
+	"17": "Bengkulu",
+	"18": "Lampung",
+	"19": "Kepulauan Bangka Belitung",
+	"21": "Kepulauan Riau",
+	"31": "DKI Jakarta",
+	"32": "Jawa Barat",
+	"33": "Jawa Tengah",
+	"34": "DI Yogyakarta",
+	"35": "Jawa Timur",
+	"36": "Banten",
+	"51": "Bali",
+	"52": "Nusa Tenggara Barat",
+	"53": "Nusa Tenggara Timur",
+	"61": "Kalimantan Barat",
+	"62": "Kalimantan Tengah",
+	"63": "Kalimantan Selatan",
+	"64": "Kalimantan Timur",
+	"65": "Kalimantan Utara",
+	"71": "Sulawesi Utara",
+	"72": "Sulawesi Tengah",
+	"73": "Sulawesi Selatan",
+	"74": "Sulawesi Tenggara",
+	"75": "Gorontalo",
+	"76": "Sulawesi Barat",
+	"81": "Maluku",
+	"82": "Maluku Utara",
+	"91": "Papua",
+	"92": "Papua Barat",
+	"93": "Papua Selatan",
+	"94": "Papua Tengah",
+	"95": "Papua Pegunungan",
+	"96": "Papua Barat Daya",
+}
+
+// NIK is what an Indonesian identity number tells about its holder. Its 16
+// digits are the province, regency and district codes, the day of birth
+// (plus 40 for women), the month and two-digit year of birth, and a serial.
+type NIK struct {
+	Province     string
+	ProvinceName string
+	Regency      string
+	District     string
+	BirthDate    time.Time
+	Female       bool
+	Serial       string
+}
+
+var nikPattern = regexp.MustCompile(`^[0-9]{16}$`)
+
+// ParseNIK checks the structure of s and decodes it. The two-digit year is
+// taken in the century that puts the birth date on or before today.
+func ParseNIK(s string, today time.Time) (NIK, error) {
+	if !nikPattern.MatchString(s) {
+		return NIK{}, errors.New("must be 16 digits")
+	}
+	nik := NIK{Province: s[0:2], Regency: s[0:4], District: s[0:6], Serial: s[12:16]}
+	name, ok := Provinces[nik.Province]
+	if !ok {
+		return NIK{}, fmt.Errorf("has an unknown province code %s", nik.Province)
+	}
+	nik.ProvinceName = name
+	if s[2:4] == "00" {
+		return NIK{}, errors.New("has no regency code")
+	}
+	if s[4:6] == "00" {
+		return NIK{}, errors.New("has no district code")
+	}
+	if nik.Serial == "0000" {
+		return NIK{}, errors.New("has no serial number")
+	}
+
+	day, _ := strconv.Atoi(s[6:8])
+	month, _ := strconv.Atoi(s[8:10])
+	year, _ := strconv.Atoi(s[10:12])
+	if day > 40 {
+		nik.Female = true
+		day -= 40
+	}
+	birthDate, ok := date(2000+year, month, day)
+	if ok && birthDate.After(today) {
+		birthDate, ok = date(1900+year, month, day)
+	}
+	if !ok {
+		return NIK{}, errors.New("has an impossible birth date")
+	}
+	nik.BirthDate = birthDate
+	return nik, nil
+}
+
+// date builds the given day, reporting false when there is no such day.
+func date(year, month, day int) (time.Time, bool) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return t, t.Year() == year && int(t.Month()) == month && t.Day() == day
+}
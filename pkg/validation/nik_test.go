@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNIK(t *testing.T) {
+	today := time.Date(2024, time.June, 15, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		nik       string
+		birthDate time.Time
+		female    bool
+		err       string
+	}{
+		{name: "male born last century", nik: "3171011505900001", birthDate: birthDay(1990, 5, 15)},
+		{name: "female adds 40 to the day", nik: "3171015505000002", birthDate: birthDay(2000, 5, 15), female: true},
+		{name: "female on the 31st", nik: "3273017112990003", birthDate: birthDay(1999, 12, 31), female: true},
+		{name: "born this century", nik: "3273012010150003", birthDate: birthDay(2015, 10, 20)},
+		{name: "born today stays in this century", nik: "3273011506240004", birthDate: birthDay(2024, 6, 15)},
+		{name: "tomorrow goes back a century", nik: "3273011606240005", birthDate: birthDay(1924, 6, 16)},
+		{name: "leap day of 2000", nik: "3273012902000006", birthDate: birthDay(2000, 2, 29)},
+		{name: "leap day of a leap year last century", nik: "3273016902960007", birthDate: birthDay(1996, 2, 29), female: true},
+
+		{name: "too short", nik: "317101150590000", err: "must be 16 digits"},
+		{name: "too long", nik: "31710115059000011", err: "must be 16 digits"},
+		{name: "legacy 13 digits", nik: "3220132938273", err: "must be 16 digits"},
+		{name: "letters", nik: "31710115059000A1", err: "must be 16 digits"},
+		{name: "spaces", nik: "3171 0115 0590 0001", err: "must be 16 digits"},
+		{name: "empty", nik: "", err: "must be 16 digits"},
+		{name: "unknown province", nik: "9971011505900001", err: "has an unknown province code 99"},
+		{name: "no regency", nik: "3100011505900001", err: "has no regency code"},
+		{name: "no district", nik: "3171001505900001", err: "has no district code"},
+		{name: "no serial", nik: "3171011505900000", err: "has no serial number"},
+		{name: "day 0", nik: "3273010001900001", err: "has an impossible birth date"},
+		{name: "day 32", nik: "3273013201900001", err: "has an impossible birth date"},
+		{name: "day 40", nik: "3273014001900001", err: "has an impossible birth date"},
+		{name: "female day 32", nik: "3273017201900001", err: "has an impossible birth date"},
+		{name: "month 0", nik: "3273011500900001", err: "has an impossible birth date"},
+		{name: "month 13", nik: "3273011513900001", err: "has an impossible birth date"},
+		{name: "30 February", nik: "3273013002900001", err: "has an impossible birth date"},
+		{name: "31 April", nik: "3273013104900001", err: "has an impossible birth date"},
+		{name: "leap day of a common year", nik: "3273012902010001", err: "has an impossible birth date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nik, err := ParseNIK(tt.nik, today)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("ParseNIK(%q) error = %v, want %q", tt.nik, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseNIK(%q) error = %v", tt.nik, err)
+			}
+			if !nik.BirthDate.Equal(tt.birthDate) {
+				t.Errorf("BirthDate = %s, want %s", nik.BirthDate.Format("2006-01-02"), tt.birthDate.Format("2006-01-02"))
+			}
+			if nik.Female != tt.female {
+				t.Errorf("Female = %t, want %t", nik.Female, tt.female)
+			}
+		})
+	}
+}
+
+func TestParseNIKCodes(t *testing.T) {
+	nik, err := ParseNIK("3171011505900123", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ParseNIK error = %v", err)
+	}
+	want := NIK{
+		Province:     "31",
+		ProvinceName: "DKI Jakarta",
+		Regency:      "3171",
+		District:     "317101",
+		BirthDate:    birthDay(1990, 5, 15),
+		Serial:       "0123",
+	}
+	if nik != want {
+		t.Errorf("ParseNIK = %+v, want %+v", nik, want)
+	}
+}
+
+func birthDay(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
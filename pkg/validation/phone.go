@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// phoneSeparators are the characters people put in phone numbers for
+// readability; they are dropped before a number is read.
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+var (
+	digitsPattern = regexp.MustCompile(`^[0-9]+$`)
+	e164Pattern   = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+)
+
+// NormalizePhone turns s into E.164, such as +6281234567890. Numbers with a
+// + or 00 prefix are taken as international; others are Indonesian, written
+// with the 0 trunk prefix, with the 62 country code, or, as mobile numbers
+// often are, without either.
+func NormalizePhone(s string) (string, error) {
+	phone := phoneSeparators.Replace(strings.TrimSpace(s))
+	switch {
+	case strings.HasPrefix(phone, "+"):
+	case strings.HasPrefix(phone, "00"):
+		phone = "+" + phone[2:]
+	case strings.HasPrefix(phone, "62"):
+		phone = "+" + phone
+	case strings.HasPrefix(phone, "0"):
+		phone = "+62" + phone[1:]
+	case strings.HasPrefix(phone, "8"):
+		phone = "+62" + phone
+	default:
+		return "", errors.New("must be an Indonesian or international phone number")
+	}
+
+	if !digitsPattern.MatchString(phone[1:]) {
+		return "", errors.New("must only hold digits, spaces, dashes and a leading +")
+	}
+	if !e164Pattern.MatchString(phone) {
+		return "", errors.New("must have 8 to 15 digits with the country code")
+	}
+	// Indonesian subscriber numbers have 8 to 12 digits and no trunk prefix.
+	if national, ok := strings.CutPrefix(phone, "+62"); ok && (len(national) < 8 || len(national) > 12 || national[0] == '0') {
+		return "", errors.New("is not a valid Indonesian phone number")
+	}
+	return phone, nil
+}
@@ -0,0 +1,71 @@
+package validation
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name  string
+		phone string
+		want  string
+		err   string
+	}{
+		{name: "trunk prefix", phone: "081234567890", want: "+6281234567890"},
+		{name: "no prefix", phone: "81234567890", want: "+6281234567890"},
+		{name: "E.164", phone: "+6281234567890", want: "+6281234567890"},
+		{name: "country code without plus", phone: "6281234567890", want: "+6281234567890"},
+		{name: "international 00 prefix", phone: "006281234567890", want: "+6281234567890"},
+		{name: "dashes", phone: "0812-3456-7890", want: "+6281234567890"},
+		{name: "spaces", phone: "+62 812 3456 7890", want: "+6281234567890"},
+		{name: "dots", phone: "0812.3456.7890", want: "+6281234567890"},
+		{name: "area code in brackets", phone: "(021) 555-1234", want: "+62215551234"},
+		{name: "surrounding whitespace", phone: "  081234567890\t", want: "+6281234567890"},
+		{name: "shortest Indonesian", phone: "0215551234", want: "+62215551234"},
+		{name: "8 digit subscriber", phone: "081234567", want: "+6281234567"},
+		{name: "12 digit subscriber", phone: "0812345678901", want: "+62812345678901"},
+		{name: "other country", phone: "+1 415 555 2671", want: "+14155552671"},
+		{name: "other country with 00", phone: "0044 20 7946 0958", want: "+442079460958"},
+
+		{name: "empty", phone: "", err: "must be an Indonesian or international phone number"},
+		{name: "words", phone: "call me", err: "must be an Indonesian or international phone number"},
+		{name: "unknown local prefix", phone: "1234567890", err: "must be an Indonesian or international phone number"},
+		{name: "letters", phone: "0812a4567890", err: "must only hold digits, spaces, dashes and a leading +"},
+		{name: "plus only", phone: "+", err: "must only hold digits, spaces, dashes and a leading +"},
+		{name: "second plus", phone: "+62+81234567890", err: "must only hold digits, spaces, dashes and a leading +"},
+		{name: "too short overall", phone: "+1234567", err: "must have 8 to 15 digits with the country code"},
+		{name: "too long overall", phone: "0812345678901234", err: "must have 8 to 15 digits with the country code"},
+		{name: "country code 0", phone: "+0123456789", err: "must have 8 to 15 digits with the country code"},
+		{name: "Indonesian too short", phone: "0812345", err: "is not a valid Indonesian phone number"},
+		{name: "Indonesian too long", phone: "08123456789012", err: "is not a valid Indonesian phone number"},
+		{name: "trunk prefix after country code", phone: "+62081234567890", err: "is not a valid Indonesian phone number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhone(tt.phone)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("NormalizePhone(%q) = %q, %v, want error %q", tt.phone, got, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizePhone(%q) error = %v", tt.phone, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizePhoneIsIdempotent(t *testing.T) {
+	for _, phone := range []string{"081234567890", "(021) 555-1234", "+1 415 555 2671"} {
+		once, err := NormalizePhone(phone)
+		if err != nil {
+			t.Fatalf("NormalizePhone(%q) error = %v", phone, err)
+		}
+		twice, err := NormalizePhone(once)
+		if err != nil || twice != once {
+			t.Errorf("NormalizePhone(%q) = %q, %v, want %q unchanged", once, twice, err, once)
+		}
+	}
+}
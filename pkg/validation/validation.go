@@ -0,0 +1,166 @@
+// Package validation collects every problem with a request before answering,
+// so clients get one response listing all offending fields instead of
+// fixing them one round trip at a time.
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"car-rental/pkg"
+	"car-rental/pkg/apperror"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Errors is the list of field problems found so far.
+type Errors struct {
+	Fields []pkg.FieldError
+}
+
+// Validator is implemented by inputs with rules that struct tags cannot
+// express, such as one field depending on another.
+type Validator interface {
+	Validate(errs *Errors)
+}
+
+// Add records a problem with field, named by its JSON path.
+func (e *Errors) Add(field, message string) {
+	e.Fields = append(e.Fields, pkg.FieldError{Field: field, Message: message})
+}
+
+// Has reports whether field already has a problem recorded, so follow-up
+// checks depending on it can be skipped.
+func (e *Errors) Has(field string) bool {
+	for _, f := range e.Fields {
+		if f.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns nil when nothing was recorded, or a single validation error
+// carrying every field problem.
+func (e *Errors) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return apperror.Validation("request is invalid", e.Fields...)
+}
+
+// Collect runs the binding tags of obj and, when it implements Validator,
+// its own rules, returning everything that failed.
+func Collect(obj any) *Errors {
+	errs := &Errors{}
+	if err := binding.Validator.ValidateStruct(obj); err != nil {
+		var fieldErrs validator.ValidationErrors
+		if errors.As(err, &fieldErrs) {
+			for _, fe := range fieldErrs {
+				errs.Add(fieldPath(fe.Namespace()), tagMessage(fe))
+			}
+		} else {
+			errs.Add("", err.Error())
+		}
+	}
+	if v, ok := obj.(Validator); ok {
+		v.Validate(errs)
+	}
+	return errs
+}
+
+// Check is Collect returning an error, for callers that only need to know
+// whether obj is valid.
+func Check(obj any) error {
+	return Collect(obj).Err()
+}
+
+// FromDecode turns a JSON decoding failure into a validation error.
+func FromDecode(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, io.EOF):
+		return apperror.Validation("request body is required")
+	case errors.As(err, &typeErr):
+		return apperror.Validation("request is invalid", pkg.FieldError{
+			Field:   typeErr.Field,
+			Message: fmt.Sprintf("must be a %s", typeErr.Type.String()),
+		})
+	default:
+		return apperror.Validation("request body is not valid JSON")
+	}
+}
+
+// UseJSONFieldNames makes the binding validator report fields by their JSON
+// name, so errors point at what the client actually sent.
+func UseJSONFieldNames() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+}
+
+// fieldPath drops the struct name validator puts in front of every path,
+// e.g. "InputBooking.start_rent" becomes "start_rent".
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func tagMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "gte":
+		return "must be at least " + fe.Param()
+	case "lt":
+		return "must be less than " + fe.Param()
+	case "lte":
+		return "must be at most " + fe.Param()
+	case "min":
+		return "must be at least " + fe.Param() + lengthUnit(fe)
+	case "max":
+		return "must be at most " + fe.Param() + lengthUnit(fe)
+	case "len":
+		return "must be exactly " + fe.Param() + lengthUnit(fe)
+	case "oneof":
+		return "must be one of " + fe.Param()
+	case "email":
+		return "must be a valid email address"
+	default:
+		return fmt.Sprintf("failed the %s rule", fe.Tag())
+	}
+}
+
+// lengthUnit names what min, max and len count for strings.
+func lengthUnit(fe validator.FieldError) string {
+	if fe.Kind() == reflect.String {
+		return " characters"
+	}
+	return ""
+}
+
+var npwpPattern = regexp.MustCompile(`^[0-9]{15}([0-9])?$`)
+
+// IsNPWP reports whether s looks like an Indonesian tax number: 15 digits, or
+// 16 in the NIK-based format, without dots or dashes.
+func IsNPWP(s string) bool {
+	return npwpPattern.MatchString(s)
+}